@@ -7,17 +7,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 )
 
 var _ sdk.AnteDecorator = AuthzLimiterDecorator{}
 
-// AuthzLimiterDecorator blocks certain msg types from being granted or executed within authz.
+// AuthzLimiterDecorator blocks certain msg types from being granted or executed within authz, or executed within hard
+// flash loans.
 type AuthzLimiterDecorator struct {
 	// disabledMsgTypes is the type urls of the msgs to block.
 	disabledMsgTypes []string
 }
 
-// NewAuthzLimiterDecorator creates a decorator to block certain msg types from being granted or executed within authz.
+// NewAuthzLimiterDecorator creates a decorator to block certain msg types from being granted or executed within authz,
+// or executed within hard flash loans.
 func NewAuthzLimiterDecorator(disabledMsgTypes ...string) AuthzLimiterDecorator {
 	return AuthzLimiterDecorator{
 		disabledMsgTypes: disabledMsgTypes,
@@ -34,10 +38,11 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 // checkForDisabledMsg iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
-// When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
-// Otherwise any msg matching the disabled types are blocked, regardless of being in an authz msg or not.
+// When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec, and hard MsgFlashLoan, are blocked, if they
+// contain unauthorized msg types. Otherwise any msg matching the disabled types are blocked, regardless of being in an
+// authz msg or not.
 //
-// This method is recursive as MsgExec's can wrap other MsgExecs.
+// This method is recursive as MsgExec's can wrap other MsgExecs, and MsgFlashLoans can be wrapped in MsgExecs.
 func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyInAuthzMsgs bool) error {
 	for _, msg := range msgs {
 		typeURL := sdk.MsgTypeURL(msg)
@@ -71,6 +76,19 @@ func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyI
 			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
 				return err
 			}
+
+		case typeURL == sdk.MsgTypeURL(&hardtypes.MsgFlashLoan{}):
+			m, ok := msg.(*hardtypes.MsgFlashLoan)
+			if !ok {
+				panic("unexpected msg type")
+			}
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
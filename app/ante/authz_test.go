@@ -16,6 +16,7 @@ import (
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/app/ante"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 )
 
 func newMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a authz.Authorization, expiration time.Time) *authz.MsgGrant {
@@ -31,6 +32,14 @@ func newMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) *authz.MsgExec {
 	return &msg
 }
 
+func newMsgFlashLoan(borrower sdk.AccAddress, msgs []sdk.Msg) *hardtypes.MsgFlashLoan {
+	msg, err := hardtypes.NewMsgFlashLoan(borrower, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6)), msgs)
+	if err != nil {
+		panic(err)
+	}
+	return &msg
+}
+
 func TestAuthzLimiterDecorator(t *testing.T) {
 	testPrivKeys, testAddresses := app.GeneratePrivKeyAddressPairs(5)
 	distantFuture := time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -206,6 +215,50 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 			checkTx:     false,
 			expectedErr: sdkerrors.ErrUnauthorized,
 		},
+		{
+			name: "when a MsgFlashLoan contains a non blocked msg, it passes",
+			msgs: []sdk.Msg{
+				newMsgFlashLoan(
+					testAddresses[0],
+					[]sdk.Msg{banktypes.NewMsgSend(
+						testAddresses[0],
+						testAddresses[3],
+						sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6)),
+					)}),
+			},
+			checkTx: false,
+		},
+		{
+			name: "when a MsgFlashLoan contains a blocked msg, it is blocked",
+			msgs: []sdk.Msg{
+				newMsgFlashLoan(
+					testAddresses[0],
+					[]sdk.Msg{
+						&evmtypes.MsgEthereumTx{},
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "a MsgFlashLoan in a MsgExec containing a blocked msg is still blocked",
+			msgs: []sdk.Msg{
+				newMsgExec(
+					testAddresses[1],
+					[]sdk.Msg{
+						newMsgFlashLoan(
+							testAddresses[0],
+							[]sdk.Msg{
+								&evmtypes.MsgEthereumTx{},
+							},
+						),
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
 	}
 
 	txConfig := app.MakeEncodingConfig().TxConfig
@@ -254,7 +254,7 @@ var (
 		hardtypes.ModuleAccountName:     {authtypes.Minter},
 		savingstypes.ModuleAccountName:  nil,
 		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
+		earntypes.ModuleAccountName:     {authtypes.Minter, authtypes.Burner},
 		kavadisttypes.FundModuleAccount: nil,
 		minttypes.ModuleName:            {authtypes.Minter},
 		communitytypes.ModuleName:       nil,
@@ -459,13 +459,15 @@ func NewApp(
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 		govAuthAddrStr,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	// transfers of tokenized earn vault shares run the earn hooks
+	shareBankKeeper := earnkeeper.NewShareBankKeeper(bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		app.loadBlockedMaccAddrs(),
 		govAuthAddrStr,
-	)
+	))
+	app.bankKeeper = shareBankKeeper
 	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -654,6 +656,28 @@ func NewApp(
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
+		&swapKeeper,
+		app.MsgServiceRouter(),
+		[]string{
+			sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			sdk.MsgTypeURL(&hardtypes.MsgDeposit{}),
+			sdk.MsgTypeURL(&hardtypes.MsgWithdraw{}),
+			sdk.MsgTypeURL(&hardtypes.MsgBorrow{}),
+			sdk.MsgTypeURL(&hardtypes.MsgRepay{}),
+			sdk.MsgTypeURL(&hardtypes.MsgLiquidate{}),
+			sdk.MsgTypeURL(&swaptypes.MsgDeposit{}),
+			sdk.MsgTypeURL(&swaptypes.MsgWithdraw{}),
+			sdk.MsgTypeURL(&swaptypes.MsgSwapExactForTokens{}),
+			sdk.MsgTypeURL(&swaptypes.MsgSwapForExactTokens{}),
+			sdk.MsgTypeURL(&earntypes.MsgDeposit{}),
+			sdk.MsgTypeURL(&earntypes.MsgWithdraw{}),
+			sdk.MsgTypeURL(&cdptypes.MsgCreateCDP{}),
+			sdk.MsgTypeURL(&cdptypes.MsgDeposit{}),
+			sdk.MsgTypeURL(&cdptypes.MsgWithdraw{}),
+			sdk.MsgTypeURL(&cdptypes.MsgDrawDebt{}),
+			sdk.MsgTypeURL(&cdptypes.MsgRepayDebt{}),
+			sdk.MsgTypeURL(&cdptypes.MsgLiquidate{}),
+		},
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
@@ -679,6 +703,8 @@ func NewApp(
 		&app.liquidKeeper,
 		&hardKeeper,
 		&savingsKeeper,
+		&swapKeeper,
+		app.pricefeedKeeper,
 		&app.distrKeeper,
 	)
 
@@ -745,6 +771,8 @@ func NewApp(
 	committeeGovRouter.
 		AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
 		AddRoute(communitytypes.RouterKey, community.NewCommunityPoolProposalHandler(app.communityKeeper)).
+		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper)).
+		AddRoute(hardtypes.RouterKey, hard.NewProposalHandler(hardKeeper)). // hard hooks aren't set yet, but reserve spends don't call them
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(&app.upgradeKeeper))
 	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
@@ -769,8 +797,10 @@ func NewApp(
 	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
 	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
-	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
+	app.savingsKeeper = *savingsKeeper.SetHooks(savingstypes.NewMultiSavingsHooks(app.incentiveKeeper.Hooks()))
+	earnKeeper.SetIncentiveKeeper(app.incentiveKeeper)
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	shareBankKeeper.SetEarnKeeper(&app.earnKeeper)
 
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
@@ -783,6 +813,8 @@ func NewApp(
 		AddRoute(kavadisttypes.RouterKey, kavadist.NewCommunityPoolMultiSpendProposalHandler(app.kavadistKeeper)).
 		AddRoute(earntypes.RouterKey, earn.NewCommunityPoolProposalHandler(app.earnKeeper)).
 		AddRoute(communitytypes.RouterKey, community.NewCommunityPoolProposalHandler(app.communityKeeper)).
+		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper)).
+		AddRoute(hardtypes.RouterKey, hard.NewProposalHandler(app.hardKeeper)).
 		AddRoute(committeetypes.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
 
 	govConfig := govtypes.DefaultConfig()
@@ -811,7 +843,7 @@ func NewApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts, authSubspace),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper, bankSubspace),
+		newBankModule(bank.NewAppModule(appCodec, shareBankKeeper.Keeper, app.accountKeeper, bankSubspace), app.bankKeeper),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper, false), // todo: confirm if this is okay to not be sealed
 		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper, stakingSubspace),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper, distrSubspace),
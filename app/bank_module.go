@@ -0,0 +1,64 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/gogoproto/grpc"
+	gogogrpc "google.golang.org/grpc"
+)
+
+// bankModule is the bank module with msgs handled by a different keeper than
+// the base keeper the module needs for its migrations. This lets transfers of
+// tokenized earn vault shares through bank msgs run the earn hooks.
+type bankModule struct {
+	bank.AppModule
+
+	msgKeeper bankkeeper.Keeper
+}
+
+// newBankModule returns a bank module that handles msgs with msgKeeper.
+func newBankModule(appModule bank.AppModule, msgKeeper bankkeeper.Keeper) bankModule {
+	return bankModule{
+		AppModule: appModule,
+		msgKeeper: msgKeeper,
+	}
+}
+
+// RegisterServices registers the bank module services, with the msg server
+// using the msg keeper.
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(bankConfigurator{
+		Configurator: cfg,
+		msgKeeper:    am.msgKeeper,
+	})
+}
+
+// bankConfigurator replaces the msg server registered by the bank module.
+type bankConfigurator struct {
+	module.Configurator
+
+	msgKeeper bankkeeper.Keeper
+}
+
+// MsgServer returns a grpc.Server that registers a msg server using the msg
+// keeper in place of the one it is given.
+func (c bankConfigurator) MsgServer() grpc.Server {
+	return bankMsgServiceRegistrar{
+		Server:    c.Configurator.MsgServer(),
+		msgKeeper: c.msgKeeper,
+	}
+}
+
+// bankMsgServiceRegistrar registers the bank msg service with a msg server
+// using the msg keeper.
+type bankMsgServiceRegistrar struct {
+	grpc.Server
+
+	msgKeeper bankkeeper.Keeper
+}
+
+// RegisterService registers the bank msg service.
+func (r bankMsgServiceRegistrar) RegisterService(sd *gogogrpc.ServiceDesc, _ interface{}) {
+	r.Server.RegisterService(sd, bankkeeper.NewMsgServerImpl(r.msgKeeper))
+}
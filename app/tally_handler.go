@@ -240,7 +240,8 @@ func (th TallyHandler) addBkavaFromSavings(ctx sdk.Context, addr sdk.AccAddress,
 	}
 }
 
-// addBkavaFromEarn adds all addr deposits of bkava in x/earn.
+// addBkavaFromEarn adds all addr deposits of bkava in x/earn, including
+// tokenized vault shares held in bank.
 func (th TallyHandler) addBkavaFromEarn(ctx sdk.Context, addr sdk.AccAddress, bkava bkavaByDenom) {
 	shares, found := th.ek.GetVaultAccountShares(ctx, addr)
 	if !found {
@@ -123,6 +123,36 @@ func (suite *tallyHandlerSuite) TestVotePower_UserOverridesValidator() {
 	suite.Equal(sdk.ZeroInt().String(), results.AbstainCount)
 }
 
+func (suite *tallyHandlerSuite) TestVotePower_TokenizedEarnSharesFollowBalance() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+	receiver := suite.createAccount()
+
+	validator := suite.delegateToNewBondedValidator(user.GetAddress(), sdkmath.NewInt(1e9))
+
+	derivatives := suite.mintDerivative(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	suite.allowBKavaEarnDeposits()
+	suite.tokenizeBKavaEarnShares()
+	suite.earnDeposit(
+		user.GetAddress(),
+		sdk.NewCoin(derivatives.Denom, sdkmath.NewInt(250e6)),
+	)
+
+	// Half of the vault shares are transferred to the receiver
+	shares := sdk.NewInt64Coin(earntypes.ShareDenom(derivatives.Denom), 125e6)
+	err := suite.app.GetBankKeeper().SendCoins(suite.ctx, user.GetAddress(), receiver.GetAddress(), sdk.NewCoins(shares))
+	suite.Require().NoError(err)
+
+	proposal := suite.createProposal()
+	suite.voteOnProposal(receiver.GetAddress(), proposal.Id, govv1beta1.OptionYes)
+
+	_, _, results := suite.tallier.Tally(suite.ctx, proposal)
+	suite.Equal(sdkmath.NewInt(125e6).String(), results.YesCount)
+	suite.Equal(sdk.ZeroInt().String(), results.NoCount)
+	suite.Equal(sdk.ZeroInt().String(), results.NoWithVetoCount)
+	suite.Equal(sdk.ZeroInt().String(), results.AbstainCount)
+}
+
 func (suite *tallyHandlerSuite) TestTallyOutcomes() {
 	suite.Run("VotedPowerBelowQuorumFails", func() {
 		suite.SetupTest()
@@ -290,6 +320,19 @@ func (suite *tallyHandlerSuite) allowBKavaEarnDeposits() {
 	sk.SetParams(suite.ctx, savingsParams)
 }
 
+func (suite *tallyHandlerSuite) tokenizeBKavaEarnShares() {
+	ek := suite.app.GetEarnKeeper()
+	earnParams := ek.GetParams(suite.ctx)
+
+	for i, vault := range earnParams.AllowedVaults {
+		if vault.Denom == liquidtypes.DefaultDerivativeDenom {
+			earnParams.AllowedVaults[i].TokenizeShares = true
+		}
+	}
+
+	ek.SetParams(suite.ctx, earnParams)
+}
+
 func (suite *tallyHandlerSuite) earnDeposit(owner sdk.AccAddress, derivative sdk.Coin) {
 	ek := suite.app.GetEarnKeeper()
 
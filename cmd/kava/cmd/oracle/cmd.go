@@ -0,0 +1,128 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	kavagrpc "github.com/kava-labs/kava/client/grpc"
+)
+
+// NewOracleCmd returns the root command for running a pricefeed oracle
+func NewOracleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle",
+		Short: "Pricefeed oracle commands",
+	}
+	cmd.AddCommand(FeedCmd())
+	return cmd
+}
+
+// FeedCmd returns a command that runs the price feeder until interrupted
+func FeedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed [config-file]",
+		Short: "Continuously post market prices from configured price sources",
+		Long: `feed runs a long-lived process that posts pricefeed prices on behalf of an oracle.
+
+Every interval it fetches each configured market's price from its sources, averages them by weight, and
+broadcasts one tx containing a MsgPostPrice per market, signed with the --from key. Markets whose sources all fail
+are skipped for that round.
+
+The config file is json:
+
+{
+  "grpc_url": "http://localhost:9090",
+  "interval": "1m",
+  "expiry": "5m",
+  "max_retries": 3,
+  "retry_delay": "2s",
+  "markets": [
+    {
+      "market_id": "kava:usd",
+      "sources": [
+        {"type": "http", "url": "https://example.com/kava", "path": "data.price", "weight": "2"},
+        {"type": "swap", "base_denom": "ukava", "quote_denom": "usdx"},
+        {"type": "static", "file": "prices.json", "key": "kava:usd"}
+      ]
+    }
+  ]
+}
+
+http sources read the number or string at the dot separated path of a json document. swap sources price the
+base denom from x/swap pool reserves, adjusted by base_decimals and quote_decimals. static sources read a json
+file mapping keys to prices, re-read every round. Source weights default to 1.`,
+		Example: `kava oracle feed oracle.json --from oracle --chain-id kava_2222-10 --gas 500000 --fees 1000ukava`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := LoadConfig(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if txf.SimulateAndExecute() {
+				return errors.New("--gas=auto is not supported, set a fixed gas limit")
+			}
+			if txf.ChainID() == "" {
+				return errors.New("--chain-id is required")
+			}
+
+			grpcClient, err := kavagrpc.NewClient(config.GrpcURL)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+			signer, err := NewSigner(
+				txf,
+				clientCtx.TxConfig,
+				grpcClient.Query.Tx,
+				grpcClient,
+				config.MaxRetries,
+				time.Duration(config.RetryDelay),
+				logger,
+			)
+			if err != nil {
+				return err
+			}
+
+			markets, err := NewMarketFeeds(config, grpcClient.Query.Swap)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			logger.Info("starting price feeder", "oracle", signer.Address(), "markets", len(markets))
+			feeder := NewFeeder(markets, signer, time.Duration(config.Interval), time.Duration(config.Expiry), logger)
+			return feeder.Run(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,220 @@
+package oracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Price source types supported by the feeder
+const (
+	SourceTypeHTTP   = "http"
+	SourceTypeSwap   = "swap"
+	SourceTypeStatic = "static"
+)
+
+// Default values for optional config fields
+const (
+	DefaultInterval   = time.Minute
+	DefaultExpiry     = 5 * time.Minute
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = 2 * time.Second
+)
+
+// Duration is a time.Duration that is read from and written to json as a
+// string such as "30s" or "5m".
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\": %w", err)
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// Config is the configuration of the price feeder, read from a json file
+type Config struct {
+	// GrpcURL is the url of the gRPC endpoint of the node to query and broadcast to
+	GrpcURL string `json:"grpc_url"`
+	// Interval is the time between posting rounds
+	Interval Duration `json:"interval"`
+	// Expiry is how long a posted price stays valid for
+	Expiry Duration `json:"expiry"`
+	// MaxRetries is the number of times a failed broadcast is retried before the round is abandoned
+	MaxRetries int `json:"max_retries"`
+	// RetryDelay is the time waited between broadcast retries
+	RetryDelay Duration `json:"retry_delay"`
+	// Markets are the markets to post prices for
+	Markets []MarketConfig `json:"markets"`
+}
+
+// MarketConfig configures the price sources of a single market
+type MarketConfig struct {
+	MarketID string         `json:"market_id"`
+	Sources  []SourceConfig `json:"sources"`
+}
+
+// SourceConfig configures a single price source. Only the fields of the
+// source's type are used.
+type SourceConfig struct {
+	Type string `json:"type"`
+	// Weight is the relative weight of the source when averaging prices, defaults to one
+	Weight sdk.Dec `json:"weight"`
+
+	// URL is the http endpoint returning a json document (http)
+	URL string `json:"url,omitempty"`
+	// Path is the dot separated path of the price in the json document, ie "data.0.price" (http)
+	Path string `json:"path,omitempty"`
+
+	// BaseDenom is the denom being priced (swap)
+	BaseDenom string `json:"base_denom,omitempty"`
+	// QuoteDenom is the denom the price is given in (swap)
+	QuoteDenom string `json:"quote_denom,omitempty"`
+	// BaseDecimals and QuoteDecimals convert pool reserves from base units (swap)
+	BaseDecimals  int64 `json:"base_decimals,omitempty"`
+	QuoteDecimals int64 `json:"quote_decimals,omitempty"`
+
+	// File is a json file mapping market ids to prices, read on every round (static)
+	File string `json:"file,omitempty"`
+	// Key is the key of the price in the file, defaults to the market id (static)
+	Key string `json:"key,omitempty"`
+}
+
+// LoadConfig reads a config from a json file, fills in defaults, and validates it
+func LoadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse config: %w", err)
+	}
+	config.SetDefaults()
+	if err := config.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	return config, nil
+}
+
+// SetDefaults fills in unset optional fields
+func (c *Config) SetDefaults() {
+	if c.Interval == 0 {
+		c.Interval = Duration(DefaultInterval)
+	}
+	if c.Expiry == 0 {
+		c.Expiry = Duration(DefaultExpiry)
+	}
+	if c.MaxRetries == 0 {
+		c.MaxRetries = DefaultMaxRetries
+	}
+	if c.RetryDelay == 0 {
+		c.RetryDelay = Duration(DefaultRetryDelay)
+	}
+	for i := range c.Markets {
+		for j := range c.Markets[i].Sources {
+			if c.Markets[i].Sources[j].Weight.IsNil() {
+				c.Markets[i].Sources[j].Weight = sdk.OneDec()
+			}
+		}
+	}
+}
+
+// Validate checks the config is usable
+func (c Config) Validate() error {
+	if c.GrpcURL == "" {
+		return errors.New("grpc_url cannot be empty")
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", time.Duration(c.Interval))
+	}
+	if c.Expiry <= c.Interval {
+		return fmt.Errorf("expiry %s must be longer than interval %s", time.Duration(c.Expiry), time.Duration(c.Interval))
+	}
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("max_retries cannot be negative, got %d", c.MaxRetries)
+	}
+	if c.RetryDelay < 0 {
+		return fmt.Errorf("retry_delay cannot be negative, got %s", time.Duration(c.RetryDelay))
+	}
+	if len(c.Markets) == 0 {
+		return errors.New("no markets configured")
+	}
+	seenMarkets := make(map[string]bool)
+	for _, market := range c.Markets {
+		if seenMarkets[market.MarketID] {
+			return fmt.Errorf("duplicated market %s", market.MarketID)
+		}
+		seenMarkets[market.MarketID] = true
+		if err := market.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the market config is usable
+func (mc MarketConfig) Validate() error {
+	if strings.TrimSpace(mc.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(mc.Sources) == 0 {
+		return fmt.Errorf("market %s has no price sources", mc.MarketID)
+	}
+	for i, source := range mc.Sources {
+		if err := source.Validate(); err != nil {
+			return fmt.Errorf("market %s source %d: %w", mc.MarketID, i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the source config is usable
+func (sc SourceConfig) Validate() error {
+	if sc.Weight.IsNil() || !sc.Weight.IsPositive() {
+		return fmt.Errorf("weight must be positive, got %s", sc.Weight)
+	}
+	switch sc.Type {
+	case SourceTypeHTTP:
+		if sc.URL == "" {
+			return errors.New("http source requires a url")
+		}
+	case SourceTypeSwap:
+		if err := sdk.ValidateDenom(sc.BaseDenom); err != nil {
+			return fmt.Errorf("invalid base denom: %w", err)
+		}
+		if err := sdk.ValidateDenom(sc.QuoteDenom); err != nil {
+			return fmt.Errorf("invalid quote denom: %w", err)
+		}
+		if sc.BaseDenom == sc.QuoteDenom {
+			return errors.New("base and quote denoms must be different")
+		}
+		if sc.BaseDecimals < 0 || sc.QuoteDecimals < 0 {
+			return errors.New("decimals cannot be negative")
+		}
+	case SourceTypeStatic:
+		if sc.File == "" {
+			return errors.New("static source requires a file")
+		}
+	default:
+		return fmt.Errorf("unknown source type %q", sc.Type)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// WeightedSource is a price source and its weight in the market price
+type WeightedSource struct {
+	Source PriceSource
+	Weight sdk.Dec
+}
+
+// MarketFeed is a market and the sources its price is computed from
+type MarketFeed struct {
+	MarketID string
+	Sources  []WeightedSource
+}
+
+// Feeder periodically computes market prices and posts them on chain
+type Feeder struct {
+	markets     []MarketFeed
+	broadcaster Broadcaster
+	interval    time.Duration
+	expiry      time.Duration
+	logger      log.Logger
+}
+
+// NewFeeder returns a new Feeder
+func NewFeeder(markets []MarketFeed, broadcaster Broadcaster, interval, expiry time.Duration, logger log.Logger) *Feeder {
+	return &Feeder{
+		markets:     markets,
+		broadcaster: broadcaster,
+		interval:    interval,
+		expiry:      expiry,
+		logger:      logger,
+	}
+}
+
+// NewMarketFeeds creates the market feeds described by a config
+func NewMarketFeeds(config Config, swapClient swaptypes.QueryClient) ([]MarketFeed, error) {
+	markets := make([]MarketFeed, 0, len(config.Markets))
+	for _, marketConfig := range config.Markets {
+		market := MarketFeed{MarketID: marketConfig.MarketID}
+		for _, sourceConfig := range marketConfig.Sources {
+			source, err := NewPriceSource(marketConfig.MarketID, sourceConfig, swapClient)
+			if err != nil {
+				return nil, err
+			}
+			market.Sources = append(market.Sources, WeightedSource{Source: source, Weight: sourceConfig.Weight})
+		}
+		markets = append(markets, market)
+	}
+	return markets, nil
+}
+
+// Run posts prices once per interval until the context is cancelled
+func (f *Feeder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.PostPrices(ctx); err != nil {
+			f.logger.Error("failed to post prices", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PostPrices computes the price of every market and posts them in a single tx.
+// Markets whose sources all fail are skipped for the round.
+func (f *Feeder) PostPrices(ctx context.Context) error {
+	expiry := time.Now().UTC().Add(f.expiry)
+	from := f.broadcaster.Address().String()
+
+	var msgs []sdk.Msg
+	for _, market := range f.markets {
+		price, err := f.marketPrice(ctx, market)
+		if err != nil {
+			f.logger.Error("skipping market", "market_id", market.MarketID, "err", err)
+			continue
+		}
+		f.logger.Info("computed price", "market_id", market.MarketID, "price", price)
+		msgs = append(msgs, pricefeedtypes.NewMsgPostPrice(from, market.MarketID, price, expiry))
+	}
+	if len(msgs) == 0 {
+		return errors.New("no market prices available")
+	}
+
+	res, err := f.broadcaster.Broadcast(ctx, msgs...)
+	if err != nil {
+		return err
+	}
+	f.logger.Info("posted prices", "markets", len(msgs), "tx_hash", res.TxHash)
+	return nil
+}
+
+// marketPrice fetches every source of a market and returns their weighted
+// average. Sources that fail are left out and the remaining weights rescaled.
+func (f *Feeder) marketPrice(ctx context.Context, market MarketFeed) (sdk.Dec, error) {
+	var prices []sdk.Dec
+	var weights []sdk.Dec
+	for i, weighted := range market.Sources {
+		price, err := weighted.Source.FetchPrice(ctx)
+		if err != nil {
+			f.logger.Error("price source failed", "market_id", market.MarketID, "source", i, "err", err)
+			continue
+		}
+		prices = append(prices, price)
+		weights = append(weights, weighted.Weight)
+	}
+	if len(prices) == 0 {
+		return sdk.Dec{}, fmt.Errorf("all %d price sources failed", len(market.Sources))
+	}
+	return WeightedAverage(prices, weights)
+}
+
+// WeightedAverage returns the average of prices weighted by weights
+func WeightedAverage(prices, weights []sdk.Dec) (sdk.Dec, error) {
+	if len(prices) == 0 || len(prices) != len(weights) {
+		return sdk.Dec{}, fmt.Errorf("expected one weight per price, got %d prices and %d weights", len(prices), len(weights))
+	}
+	total := sdk.ZeroDec()
+	totalWeight := sdk.ZeroDec()
+	for i, price := range prices {
+		total = total.Add(price.Mul(weights[i]))
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	if !totalWeight.IsPositive() {
+		return sdk.Dec{}, errors.New("total weight must be positive")
+	}
+	return total.Quo(totalWeight), nil
+}
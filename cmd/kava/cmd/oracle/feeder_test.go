@@ -0,0 +1,107 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/cmd/kava/cmd/oracle"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// mockBroadcaster records broadcast msgs instead of sending them
+type mockBroadcaster struct {
+	address sdk.AccAddress
+	msgs    [][]sdk.Msg
+}
+
+func (b *mockBroadcaster) Address() sdk.AccAddress { return b.address }
+
+func (b *mockBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) (sdk.TxResponse, error) {
+	b.msgs = append(b.msgs, msgs)
+	return sdk.TxResponse{TxHash: "hash"}, nil
+}
+
+// mockSource returns a fixed price or error
+type mockSource struct {
+	price sdk.Dec
+	err   error
+}
+
+func (s mockSource) FetchPrice(context.Context) (sdk.Dec, error) { return s.price, s.err }
+
+func TestFeeder_PostPrices(t *testing.T) {
+	priceServer := func(body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(body))
+		}))
+	}
+	exchangeA := priceServer(`{"price": "1.00"}`)
+	defer exchangeA.Close()
+	exchangeB := priceServer(`{"result": {"last": 1.60}}`)
+	defer exchangeB.Close()
+	downExchange := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer downExchange.Close()
+
+	markets := []oracle.MarketFeed{
+		{
+			MarketID: "kava:usd",
+			Sources: []oracle.WeightedSource{
+				{Source: oracle.NewHTTPSource(exchangeA.URL, "price"), Weight: sdk.NewDec(2)},
+				{Source: oracle.NewHTTPSource(exchangeB.URL, "result.last"), Weight: sdk.NewDec(1)},
+				// a failed source is left out of the average
+				{Source: oracle.NewHTTPSource(downExchange.URL, "price"), Weight: sdk.NewDec(10)},
+			},
+		},
+		{
+			MarketID: "btc:usd",
+			Sources: []oracle.WeightedSource{
+				{Source: mockSource{err: errors.New("source down")}, Weight: sdk.OneDec()},
+			},
+		},
+	}
+	broadcaster := &mockBroadcaster{address: sdk.AccAddress("oracle")}
+	feeder := oracle.NewFeeder(markets, broadcaster, time.Minute, 5*time.Minute, log.NewNopLogger())
+
+	require.NoError(t, feeder.PostPrices(context.Background()))
+
+	// markets whose sources all fail are skipped
+	require.Len(t, broadcaster.msgs, 1)
+	require.Len(t, broadcaster.msgs[0], 1)
+	msg, ok := broadcaster.msgs[0][0].(*pricefeedtypes.MsgPostPrice)
+	require.True(t, ok)
+	require.Equal(t, "kava:usd", msg.MarketID)
+	require.Equal(t, broadcaster.address.String(), msg.From)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), msg.Price)
+	require.WithinDuration(t, time.Now().Add(5*time.Minute), msg.Expiry, time.Minute)
+	require.NoError(t, msg.ValidateBasic())
+
+	// nothing is broadcast when no market has a price
+	feeder = oracle.NewFeeder(markets[1:], broadcaster, time.Minute, 5*time.Minute, log.NewNopLogger())
+	require.Error(t, feeder.PostPrices(context.Background()))
+	require.Len(t, broadcaster.msgs, 1)
+}
+
+func TestWeightedAverage(t *testing.T) {
+	price, err := oracle.WeightedAverage(
+		[]sdk.Dec{sdk.NewDec(10), sdk.NewDec(20)},
+		[]sdk.Dec{sdk.NewDec(3), sdk.NewDec(1)},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), price)
+
+	_, err = oracle.WeightedAverage(nil, nil)
+	require.Error(t, err)
+
+	_, err = oracle.WeightedAverage([]sdk.Dec{sdk.NewDec(10)}, []sdk.Dec{sdk.ZeroDec()})
+	require.Error(t, err)
+}
@@ -0,0 +1,175 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ErrBroadcastFailed is returned when a tx could not be broadcast within the retry limit
+var ErrBroadcastFailed = errors.New("failed to broadcast tx")
+
+// AccountFetcher fetches the on chain state of an account
+type AccountFetcher interface {
+	Account(addr string) (authtypes.AccountI, error)
+}
+
+// Broadcaster signs and broadcasts msgs
+type Broadcaster interface {
+	Address() sdk.AccAddress
+	Broadcast(ctx context.Context, msgs ...sdk.Msg) (sdk.TxResponse, error)
+}
+
+// Signer signs txs with a keyring key and broadcasts them over gRPC. It tracks
+// the account sequence locally so consecutive txs do not wait for a block, and
+// reloads it from the chain when the node rejects a tx for a bad sequence.
+type Signer struct {
+	txf        tx.Factory
+	txConfig   client.TxConfig
+	txClient   txtypes.ServiceClient
+	accounts   AccountFetcher
+	address    sdk.AccAddress
+	maxRetries int
+	retryDelay time.Duration
+	logger     log.Logger
+
+	accountNumber uint64
+	sequence      uint64
+	loaded        bool
+}
+
+var _ Broadcaster = (*Signer)(nil)
+
+// NewSigner returns a new Signer. The factory must have a keybase and a from name set.
+func NewSigner(
+	txf tx.Factory,
+	txConfig client.TxConfig,
+	txClient txtypes.ServiceClient,
+	accounts AccountFetcher,
+	maxRetries int,
+	retryDelay time.Duration,
+	logger log.Logger,
+) (*Signer, error) {
+	if txf.Keybase() == nil {
+		return nil, errors.New("signer requires a keyring")
+	}
+	record, err := txf.Keybase().Key(txf.FromName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key %s: %w", txf.FromName(), err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		txf:        txf.WithTxConfig(txConfig),
+		txConfig:   txConfig,
+		txClient:   txClient,
+		accounts:   accounts,
+		address:    address,
+		maxRetries: maxRetries,
+		retryDelay: retryDelay,
+		logger:     logger,
+	}, nil
+}
+
+// Address returns the address of the signing key
+func (s *Signer) Address() sdk.AccAddress {
+	return s.address
+}
+
+// Broadcast signs msgs into a single tx and broadcasts it, retrying on
+// connection errors, full mempools, and sequence mismatches.
+func (s *Signer) Broadcast(ctx context.Context, msgs ...sdk.Msg) (sdk.TxResponse, error) {
+	var lastErr error
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		if attempt > 0 {
+			s.logger.Info("retrying broadcast", "attempt", attempt, "err", lastErr)
+			select {
+			case <-ctx.Done():
+				return sdk.TxResponse{}, ctx.Err()
+			case <-time.After(s.retryDelay):
+			}
+		}
+
+		if !s.loaded {
+			if err := s.loadAccount(); err != nil {
+				lastErr = err
+				continue
+			}
+		}
+
+		txBytes, err := s.sign(msgs)
+		if err != nil {
+			// signing errors are not recoverable
+			return sdk.TxResponse{}, err
+		}
+
+		res, err := s.txClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+			TxBytes: txBytes,
+			Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+		})
+		if err != nil {
+			// could not reach the node
+			lastErr = err
+			continue
+		}
+		if res.TxResponse == nil {
+			lastErr = errors.New("empty broadcast response")
+			continue
+		}
+
+		switch res.TxResponse.Code {
+		case errorsmod.SuccessABCICode, sdkerrors.ErrTxInMempoolCache.ABCICode():
+			s.sequence++
+			return *res.TxResponse, nil
+		case sdkerrors.ErrWrongSequence.ABCICode(), sdkerrors.ErrUnauthorized.ABCICode():
+			// a tx was dropped from the mempool or another process is using the key
+			s.loaded = false
+			lastErr = fmt.Errorf("tx rejected with code %d: %s", res.TxResponse.Code, res.TxResponse.RawLog)
+		case sdkerrors.ErrMempoolIsFull.ABCICode():
+			lastErr = fmt.Errorf("tx rejected with code %d: %s", res.TxResponse.Code, res.TxResponse.RawLog)
+		default:
+			return *res.TxResponse, fmt.Errorf("tx rejected with code %d: %s", res.TxResponse.Code, res.TxResponse.RawLog)
+		}
+	}
+	return sdk.TxResponse{}, errorsmod.Wrapf(ErrBroadcastFailed, "after %d retries: %s", s.maxRetries, lastErr)
+}
+
+// loadAccount reads the account number and sequence from the chain
+func (s *Signer) loadAccount() error {
+	account, err := s.accounts.Account(s.address.String())
+	if err != nil {
+		return err
+	}
+	s.accountNumber = account.GetAccountNumber()
+	s.sequence = account.GetSequence()
+	s.loaded = true
+	return nil
+}
+
+// sign builds and signs a tx containing msgs at the current sequence
+func (s *Signer) sign(msgs []sdk.Msg) ([]byte, error) {
+	txf := s.txf.
+		WithAccountNumber(s.accountNumber).
+		WithSequence(s.sequence)
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, txf.FromName(), builder, true); err != nil {
+		return nil, err
+	}
+	return s.txConfig.TxEncoder()(builder.GetTx())
+}
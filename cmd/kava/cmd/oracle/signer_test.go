@@ -0,0 +1,124 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/cmd/kava/cmd/oracle"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// mockAccountFetcher returns an account with a settable sequence
+type mockAccountFetcher struct {
+	address       sdk.AccAddress
+	accountNumber uint64
+	sequence      uint64
+	fetches       int
+}
+
+func (f *mockAccountFetcher) Account(addr string) (authtypes.AccountI, error) {
+	f.fetches++
+	return authtypes.NewBaseAccount(f.address, nil, f.accountNumber, f.sequence), nil
+}
+
+// mockTxClient replies to broadcasts with queued responses and records the txs sent
+type mockTxClient struct {
+	txtypes.ServiceClient
+	responses []*txtypes.BroadcastTxResponse
+	errs      []error
+	txs       [][]byte
+}
+
+func (c *mockTxClient) BroadcastTx(_ context.Context, req *txtypes.BroadcastTxRequest, _ ...grpc.CallOption) (*txtypes.BroadcastTxResponse, error) {
+	c.txs = append(c.txs, req.TxBytes)
+	res, err := c.responses[0], c.errs[0]
+	c.responses, c.errs = c.responses[1:], c.errs[1:]
+	return res, err
+}
+
+func (c *mockTxClient) queue(code uint32, err error) {
+	c.responses = append(c.responses, &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{Code: code}})
+	c.errs = append(c.errs, err)
+}
+
+func TestSigner_Broadcast(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	kr := keyring.NewInMemory(encodingConfig.Marshaler)
+	record, _, err := kr.NewMnemonic("oracle", keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithKeybase(kr).
+		WithFromName("oracle").
+		WithChainID("kavatest_2221-1").
+		WithGas(200000)
+
+	accounts := &mockAccountFetcher{address: address, accountNumber: 12, sequence: 5}
+	txClient := &mockTxClient{}
+	signer, err := oracle.NewSigner(txf, encodingConfig.TxConfig, txClient, accounts, 2, time.Millisecond, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, address, signer.Address())
+
+	msg := pricefeedtypes.NewMsgPostPrice(address.String(), "kava:usd", sdk.OneDec(), time.Now().Add(time.Hour))
+
+	sequenceOf := func(txBytes []byte) uint64 {
+		decoded, err := encodingConfig.TxConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		sigs, err := decoded.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		return sigs[0].Sequence
+	}
+
+	// consecutive txs use the locally tracked sequence
+	txClient.queue(0, nil)
+	txClient.queue(0, nil)
+	_, err = signer.Broadcast(context.Background(), msg)
+	require.NoError(t, err)
+	_, err = signer.Broadcast(context.Background(), msg)
+	require.NoError(t, err)
+	require.Equal(t, 1, accounts.fetches)
+	require.Equal(t, []uint64{5, 6}, []uint64{sequenceOf(txClient.txs[0]), sequenceOf(txClient.txs[1])})
+
+	// a wrong sequence reloads the account, connection errors retry
+	accounts.sequence = 9
+	txClient.queue(sdkerrors.ErrWrongSequence.ABCICode(), nil)
+	txClient.queue(0, errors.New("connection refused"))
+	txClient.queue(0, nil)
+	_, err = signer.Broadcast(context.Background(), msg)
+	require.NoError(t, err)
+	require.Equal(t, 2, accounts.fetches)
+	require.Equal(t, uint64(7), sequenceOf(txClient.txs[2]))
+	require.Equal(t, uint64(9), sequenceOf(txClient.txs[3]))
+	require.Equal(t, uint64(9), sequenceOf(txClient.txs[4]))
+
+	// gives up after the retry limit
+	for i := 0; i < 3; i++ {
+		txClient.queue(sdkerrors.ErrMempoolIsFull.ABCICode(), nil)
+	}
+	_, err = signer.Broadcast(context.Background(), msg)
+	require.ErrorIs(t, err, oracle.ErrBroadcastFailed)
+
+	// unrecoverable errors are not retried
+	txClient.queue(sdkerrors.ErrInsufficientFee.ABCICode(), nil)
+	_, err = signer.Broadcast(context.Background(), msg)
+	require.ErrorContains(t, err, "tx rejected with code 13")
+	require.Empty(t, txClient.responses)
+}
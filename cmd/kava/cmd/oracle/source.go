@@ -0,0 +1,249 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// httpTimeout bounds the time spent waiting on a single http source
+const httpTimeout = 10 * time.Second
+
+// PriceSource fetches the latest price of a market
+type PriceSource interface {
+	FetchPrice(ctx context.Context) (sdk.Dec, error)
+}
+
+// NewPriceSource creates the price source described by a source config
+func NewPriceSource(marketID string, config SourceConfig, swapClient swaptypes.QueryClient) (PriceSource, error) {
+	switch config.Type {
+	case SourceTypeHTTP:
+		return NewHTTPSource(config.URL, config.Path), nil
+	case SourceTypeSwap:
+		if swapClient == nil {
+			return nil, errors.New("swap source requires a swap query client")
+		}
+		return NewSwapSource(swapClient, config.BaseDenom, config.QuoteDenom, config.BaseDecimals, config.QuoteDecimals), nil
+	case SourceTypeStatic:
+		key := config.Key
+		if key == "" {
+			key = marketID
+		}
+		return NewStaticSource(config.File, key), nil
+	default:
+		return nil, fmt.Errorf("unknown source type %q", config.Type)
+	}
+}
+
+// HTTPSource reads a price from a json document served over http
+type HTTPSource struct {
+	client *http.Client
+	url    string
+	path   []string
+}
+
+var _ PriceSource = HTTPSource{}
+
+// NewHTTPSource returns a new HTTPSource. The path is a dot separated list of
+// object keys and array indexes leading to the price in the document.
+func NewHTTPSource(url, path string) HTTPSource {
+	var segments []string
+	if path != "" {
+		segments = strings.Split(path, ".")
+	}
+	return HTTPSource{
+		client: &http.Client{Timeout: httpTimeout},
+		url:    url,
+		path:   segments,
+	}
+}
+
+// FetchPrice implements PriceSource
+func (s HTTPSource) FetchPrice(ctx context.Context) (sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return sdk.Dec{}, fmt.Errorf("unexpected status %s from %s", resp.Status, s.url)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to decode response from %s: %w", s.url, err)
+	}
+
+	value, err := lookupPath(document, s.path)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return parsePrice(value)
+}
+
+// lookupPath walks a decoded json document along a path of object keys and array indexes
+func lookupPath(document interface{}, path []string) (interface{}, error) {
+	value := document
+	for i, segment := range path {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			child, found := node[segment]
+			if !found {
+				return nil, fmt.Errorf("key %s not found at %s", segment, strings.Join(path[:i], "."))
+			}
+			value = child
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("invalid array index %s at %s", segment, strings.Join(path[:i], "."))
+			}
+			value = node[index]
+		default:
+			return nil, fmt.Errorf("cannot look up %s in a json %T", segment, value)
+		}
+	}
+	return value, nil
+}
+
+// parsePrice converts a json number or string into a positive price
+func parsePrice(value interface{}) (sdk.Dec, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return sdk.Dec{}, fmt.Errorf("price must be a json number or string, got %T", value)
+	}
+
+	price, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		// fall back to parsing floats with exponents, ie 1.5e-3
+		f, _, parseErr := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+		if parseErr != nil {
+			return sdk.Dec{}, fmt.Errorf("invalid price %s: %w", s, err)
+		}
+		price, err = sdk.NewDecFromStr(f.Text('f', sdk.Precision))
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("invalid price %s: %w", s, err)
+		}
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("price must be positive, got %s", price)
+	}
+	return price, nil
+}
+
+// SwapSource prices a denom from the reserves of an x/swap pool
+type SwapSource struct {
+	client        swaptypes.QueryClient
+	poolID        string
+	baseDenom     string
+	quoteDenom    string
+	baseDecimals  int64
+	quoteDecimals int64
+}
+
+var _ PriceSource = SwapSource{}
+
+// NewSwapSource returns a new SwapSource
+func NewSwapSource(client swaptypes.QueryClient, baseDenom, quoteDenom string, baseDecimals, quoteDecimals int64) SwapSource {
+	return SwapSource{
+		client:        client,
+		poolID:        swaptypes.PoolID(baseDenom, quoteDenom),
+		baseDenom:     baseDenom,
+		quoteDenom:    quoteDenom,
+		baseDecimals:  baseDecimals,
+		quoteDecimals: quoteDecimals,
+	}
+}
+
+// FetchPrice implements PriceSource
+func (s SwapSource) FetchPrice(ctx context.Context) (sdk.Dec, error) {
+	res, err := s.client.Pools(ctx, &swaptypes.QueryPoolsRequest{PoolId: s.poolID})
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to query pool %s: %w", s.poolID, err)
+	}
+	if len(res.Pools) == 0 {
+		return sdk.Dec{}, fmt.Errorf("pool %s not found", s.poolID)
+	}
+
+	reserves := res.Pools[0].Coins
+	baseReserves := reserves.AmountOf(s.baseDenom)
+	quoteReserves := reserves.AmountOf(s.quoteDenom)
+	if !baseReserves.IsPositive() || !quoteReserves.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("pool %s has no reserves", s.poolID)
+	}
+
+	price := sdk.NewDecFromInt(quoteReserves).QuoInt(baseReserves)
+	if s.baseDecimals > s.quoteDecimals {
+		price = price.MulInt(sdkmath.NewIntWithDecimal(1, int(s.baseDecimals-s.quoteDecimals)))
+	} else if s.quoteDecimals > s.baseDecimals {
+		price = price.QuoInt(sdkmath.NewIntWithDecimal(1, int(s.quoteDecimals-s.baseDecimals)))
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("pool %s price rounds to zero", s.poolID)
+	}
+	return price, nil
+}
+
+// StaticSource reads a price from a json file mapping keys to prices. The file
+// is read on every fetch so prices can be updated while the feeder runs.
+type StaticSource struct {
+	file string
+	key  string
+}
+
+var _ PriceSource = StaticSource{}
+
+// NewStaticSource returns a new StaticSource
+func NewStaticSource(file, key string) StaticSource {
+	return StaticSource{
+		file: file,
+		key:  key,
+	}
+}
+
+// FetchPrice implements PriceSource
+func (s StaticSource) FetchPrice(_ context.Context) (sdk.Dec, error) {
+	bz, err := os.ReadFile(s.file)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	var prices map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &prices); err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to parse %s: %w", s.file, err)
+	}
+	raw, found := prices[s.key]
+	if !found {
+		return sdk.Dec{}, fmt.Errorf("no price for %s in %s", s.key, s.file)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return sdk.Dec{}, err
+	}
+	return parsePrice(value)
+}
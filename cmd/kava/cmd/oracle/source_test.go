@@ -0,0 +1,174 @@
+package oracle_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/kava-labs/kava/cmd/kava/cmd/oracle"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestHTTPSource_FetchPrice(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		path        string
+		expectPrice sdk.Dec
+		expectErr   string
+	}{
+		{"number", http.StatusOK, `{"price": 1.25}`, "price", sdk.MustNewDecFromStr("1.25"), ""},
+		{"string", http.StatusOK, `{"price": "1.25"}`, "price", sdk.MustNewDecFromStr("1.25"), ""},
+		{"exponent", http.StatusOK, `{"price": 1.5e-3}`, "price", sdk.MustNewDecFromStr("0.0015"), ""},
+		{"nested array", http.StatusOK, `{"data": [{"price": "3"}, {"price": "4"}]}`, "data.1.price", sdk.MustNewDecFromStr("4"), ""},
+		{"root value", http.StatusOK, `"2.5"`, "", sdk.MustNewDecFromStr("2.5"), ""},
+		{"missing key", http.StatusOK, `{"data": {}}`, "data.price", sdk.Dec{}, "key price not found"},
+		{"bad index", http.StatusOK, `{"data": []}`, "data.0", sdk.Dec{}, "invalid array index"},
+		{"not a price", http.StatusOK, `{"price": true}`, "price", sdk.Dec{}, "price must be a json number or string"},
+		{"zero price", http.StatusOK, `{"price": 0}`, "price", sdk.Dec{}, "price must be positive"},
+		{"bad status", http.StatusInternalServerError, `{}`, "price", sdk.Dec{}, "unexpected status"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			price, err := oracle.NewHTTPSource(server.URL, tc.path).FetchPrice(context.Background())
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPrice, price)
+		})
+	}
+}
+
+func TestStaticSource_FetchPrice(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"kava:usd": "0.75", "btc:usd": 30000}`), 0o600))
+
+	price, err := oracle.NewStaticSource(file, "kava:usd").FetchPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.75"), price)
+
+	price, err = oracle.NewStaticSource(file, "btc:usd").FetchPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("30000"), price)
+
+	_, err = oracle.NewStaticSource(file, "eth:usd").FetchPrice(context.Background())
+	require.ErrorContains(t, err, "no price for eth:usd")
+
+	// the file is re-read on every fetch
+	require.NoError(t, os.WriteFile(file, []byte(`{"kava:usd": "0.8"}`), 0o600))
+	price, err = oracle.NewStaticSource(file, "kava:usd").FetchPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), price)
+}
+
+// mockSwapQueryClient returns a fixed set of pools
+type mockSwapQueryClient struct {
+	swaptypes.QueryClient
+	pools []swaptypes.PoolResponse
+}
+
+func (c mockSwapQueryClient) Pools(_ context.Context, req *swaptypes.QueryPoolsRequest, _ ...grpc.CallOption) (*swaptypes.QueryPoolsResponse, error) {
+	var pools []swaptypes.PoolResponse
+	for _, pool := range c.pools {
+		if pool.Name == req.PoolId {
+			pools = append(pools, pool)
+		}
+	}
+	return &swaptypes.QueryPoolsResponse{Pools: pools}, nil
+}
+
+func TestSwapSource_FetchPrice(t *testing.T) {
+	client := mockSwapQueryClient{
+		pools: []swaptypes.PoolResponse{
+			{
+				Name:  "ukava:usdx",
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 4_000_000), sdk.NewInt64Coin("usdx", 3_000_000)),
+			},
+			{
+				Name:  "bnb:usdx",
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("bnb", 100_000_000), sdk.NewInt64Coin("usdx", 300_000_000)),
+			},
+		},
+	}
+
+	price, err := oracle.NewSwapSource(client, "ukava", "usdx", 0, 0).FetchPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.75"), price)
+
+	// inverse pricing uses the same pool
+	price, err = oracle.NewSwapSource(client, "usdx", "ukava", 0, 0).FetchPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.333333333333333333"), price)
+
+	// bnb has 8 decimals and usdx 6
+	price, err = oracle.NewSwapSource(client, "bnb", "usdx", 8, 6).FetchPrice(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("300"), price)
+
+	_, err = oracle.NewSwapSource(client, "hard", "usdx", 0, 0).FetchPrice(context.Background())
+	require.ErrorContains(t, err, "pool hard:usdx not found")
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	validConfig := `{
+		"grpc_url": "http://localhost:9090",
+		"interval": "30s",
+		"markets": [{
+			"market_id": "kava:usd",
+			"sources": [
+				{"type": "http", "url": "http://localhost/kava", "path": "price", "weight": "2"},
+				{"type": "swap", "base_denom": "ukava", "quote_denom": "usdx"},
+				{"type": "static", "file": "prices.json"}
+			]
+		}]
+	}`
+	file := filepath.Join(dir, "valid.json")
+	require.NoError(t, os.WriteFile(file, []byte(validConfig), 0o600))
+
+	config, err := oracle.LoadConfig(file)
+	require.NoError(t, err)
+	require.Equal(t, oracle.Duration(30*time.Second), config.Interval)
+	require.Equal(t, oracle.Duration(oracle.DefaultExpiry), config.Expiry)
+	require.Equal(t, oracle.DefaultMaxRetries, config.MaxRetries)
+	require.Equal(t, sdk.MustNewDecFromStr("2"), config.Markets[0].Sources[0].Weight)
+	require.Equal(t, sdk.OneDec(), config.Markets[0].Sources[1].Weight)
+
+	invalidConfigs := map[string]string{
+		"no grpc url":       `{"markets": [{"market_id": "kava:usd", "sources": [{"type": "static", "file": "p.json"}]}]}`,
+		"bad duration":      `{"grpc_url": "http://localhost:9090", "interval": 30}`,
+		"expiry too short":  `{"grpc_url": "http://localhost:9090", "interval": "5m", "expiry": "1m", "markets": [{"market_id": "kava:usd", "sources": [{"type": "static", "file": "p.json"}]}]}`,
+		"no markets":        `{"grpc_url": "http://localhost:9090"}`,
+		"no sources":        `{"grpc_url": "http://localhost:9090", "markets": [{"market_id": "kava:usd"}]}`,
+		"duplicated market": `{"grpc_url": "http://localhost:9090", "markets": [{"market_id": "kava:usd", "sources": [{"type": "static", "file": "p.json"}]}, {"market_id": "kava:usd", "sources": [{"type": "static", "file": "p.json"}]}]}`,
+		"unknown source":    `{"grpc_url": "http://localhost:9090", "markets": [{"market_id": "kava:usd", "sources": [{"type": "ftp"}]}]}`,
+		"negative weight":   `{"grpc_url": "http://localhost:9090", "markets": [{"market_id": "kava:usd", "sources": [{"type": "static", "file": "p.json", "weight": "-1"}]}]}`,
+		"same swap denoms":  `{"grpc_url": "http://localhost:9090", "markets": [{"market_id": "kava:usd", "sources": [{"type": "swap", "base_denom": "ukava", "quote_denom": "ukava"}]}]}`,
+	}
+	for name, contents := range invalidConfigs {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(dir, "invalid.json")
+			require.NoError(t, os.WriteFile(file, []byte(contents), 0o600))
+			_, err := oracle.LoadConfig(file)
+			require.Error(t, err)
+		})
+	}
+}
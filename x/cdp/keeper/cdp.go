@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // AddCdp adds a cdp for a specific owner and collateral type
@@ -573,7 +574,7 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 		return sdk.Dec{}, pfType.IsValid()
 	}
 
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getMarketPrice(ctx, marketID, pfType)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
@@ -602,7 +603,7 @@ func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context
 		return sdk.Dec{}, pfType.IsValid()
 	}
 
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getMarketPrice(ctx, marketID, pfType)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
@@ -611,6 +612,15 @@ func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context
 	return respectiveCollateralRatio, nil
 }
 
+// getMarketPrice returns the price of a market, using the pricefeed liquidation price for
+// liquidation markets
+func (k Keeper) getMarketPrice(ctx sdk.Context, marketID string, pfType pricefeedType) (pricefeedtypes.CurrentPrice, error) {
+	if pfType == liquidation {
+		return k.pricefeedKeeper.GetLiquidationPrice(ctx, marketID)
+	}
+	return k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+}
+
 // SetMarketStatus sets the status of the input market, true means the market is up and running, false means it is down
 func (k Keeper) SetMarketStatus(ctx sdk.Context, marketID string, up bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PricefeedStatusKeyPrefix)
@@ -22,6 +22,7 @@ import (
 	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type SeizeTestSuite struct {
@@ -211,6 +212,45 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(10, xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdps_LiquidationTwap() {
+	suite.createCdps()
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	pk := suite.app.GetPriceFeedKeeper()
+	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount
+
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.True(found)
+
+	// The liquidation market is priced at its TWAP over 10 minutes
+	snapshots := pricefeedtypes.NewSnapshotParams(time.Minute, time.Hour, 10*time.Minute)
+	pfParams := pk.GetParams(suite.ctx)
+	for i, market := range pfParams.Markets {
+		if market.MarketID == p.LiquidationMarketID {
+			pfParams.Markets[i].Snapshots = &snapshots
+		}
+	}
+	pk.SetParams(suite.ctx, pfParams)
+	suite.setPrice(d("0.25"), p.LiquidationMarketID)
+
+	// A crash that lasts a single block doesn't move the TWAP
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+	suite.setPrice(d("0.2"), p.LiquidationMarketID)
+	err := suite.keeper.LiquidateCdps(suite.ctx, p.LiquidationMarketID, "xrp-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.NoError(err)
+	suite.Equal(originalXrpCollateral, bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount)
+
+	// Once the price has held for the window the cdps are liquidated
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+	err = suite.keeper.LiquidateCdps(suite.ctx, p.LiquidationMarketID, "xrp-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.NoError(err)
+
+	finalXrpCollateral := bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount
+	xrpLiquidations := int(originalXrpCollateral.Sub(finalXrpCollateral).Quo(i(10000000000)).Int64())
+	suite.Equal(10, xrpLiquidations)
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -14,7 +14,9 @@ import (
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	communitytypes "github.com/kava-labs/kava/x/community/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 var (
@@ -53,6 +55,8 @@ func init() {
 	RegisterProposalTypeCodec(communitytypes.CommunityCDPWithdrawCollateralProposal{}, "kava/CommunityCDPWithdrawCollateralProposal")
 	RegisterProposalTypeCodec(communitytypes.CommunityPoolLendWithdrawProposal{}, "kava/CommunityPoolLendWithdrawProposal")
 	RegisterProposalTypeCodec(kavadisttypes.CommunityPoolMultiSpendProposal{}, "kava/CommunityPoolMultiSpendProposal")
+	RegisterProposalTypeCodec(pricefeedtypes.PricefeedResetMarketHaltProposal{}, "kava/PricefeedResetMarketHaltProposal")
+	RegisterProposalTypeCodec(hardtypes.HardReserveSpendProposal{}, "kava/HardReserveSpendProposal")
 }
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the module.
@@ -77,6 +81,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(CommunityCDPRepayDebtPermission{}, "kava/CommunityCDPRepayDebtPermission", nil)
 	cdc.RegisterConcrete(CommunityCDPWithdrawCollateralPermission{}, "kava/CommunityCDPWithdrawCollateralPermission", nil)
 	cdc.RegisterConcrete(CommunityPoolLendWithdrawPermission{}, "kava/CommunityPoolLendWithdrawPermission", nil)
+	cdc.RegisterConcrete(PricefeedResetMarketHaltPermission{}, "kava/PricefeedResetMarketHaltPermission", nil)
+	cdc.RegisterConcrete(HardReserveSpendPermission{}, "kava/HardReserveSpendPermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "kava/MsgSubmitProposal")
@@ -115,6 +121,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&CommunityCDPRepayDebtPermission{},
 		&CommunityCDPWithdrawCollateralPermission{},
 		&CommunityPoolLendWithdrawPermission{},
+		&PricefeedResetMarketHaltPermission{},
+		&HardReserveSpendPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -132,6 +140,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&communitytypes.CommunityCDPRepayDebtProposal{},
 		&communitytypes.CommunityCDPWithdrawCollateralProposal{},
 		&communitytypes.CommunityPoolLendWithdrawProposal{},
+		&pricefeedtypes.PricefeedResetMarketHaltProposal{},
+		&hardtypes.HardReserveSpendProposal{},
 	)
 
 	registry.RegisterImplementations(
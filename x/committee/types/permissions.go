@@ -13,6 +13,8 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	communitytypes "github.com/kava-labs/kava/x/community/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -57,6 +59,8 @@ var (
 	_ Permission = CommunityCDPRepayDebtPermission{}
 	_ Permission = CommunityPoolLendWithdrawPermission{}
 	_ Permission = CommunityCDPWithdrawCollateralPermission{}
+	_ Permission = PricefeedResetMarketHaltPermission{}
+	_ Permission = HardReserveSpendPermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -92,6 +96,18 @@ func (CommunityPoolLendWithdrawPermission) Allows(_ sdk.Context, _ ParamKeeper,
 	return ok
 }
 
+// Allows implement permission interface for PricefeedResetMarketHaltPermission.
+func (PricefeedResetMarketHaltPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*pricefeedtypes.PricefeedResetMarketHaltProposal)
+	return ok
+}
+
+// Allows implement permission interface for HardReserveSpendPermission.
+func (HardReserveSpendPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*hardtypes.HardReserveSpendProposal)
+	return ok
+}
+
 // Allows implement permission interface for ParamsChangePermission.
 func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
 	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
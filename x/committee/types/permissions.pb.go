@@ -248,6 +248,80 @@ func (m *CommunityPoolLendWithdrawPermission) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolLendWithdrawPermission proto.InternalMessageInfo
 
+// PricefeedResetMarketHaltPermission allows submission of PricefeedResetMarketHaltProposal
+type PricefeedResetMarketHaltPermission struct {
+}
+
+func (m *PricefeedResetMarketHaltPermission) Reset()         { *m = PricefeedResetMarketHaltPermission{} }
+func (m *PricefeedResetMarketHaltPermission) String() string { return proto.CompactTextString(m) }
+func (*PricefeedResetMarketHaltPermission) ProtoMessage()    {}
+func (*PricefeedResetMarketHaltPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{6}
+}
+func (m *PricefeedResetMarketHaltPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricefeedResetMarketHaltPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricefeedResetMarketHaltPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricefeedResetMarketHaltPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricefeedResetMarketHaltPermission.Merge(m, src)
+}
+func (m *PricefeedResetMarketHaltPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricefeedResetMarketHaltPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricefeedResetMarketHaltPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricefeedResetMarketHaltPermission proto.InternalMessageInfo
+
+// HardReserveSpendPermission allows submission of HardReserveSpendProposal
+type HardReserveSpendPermission struct {
+}
+
+func (m *HardReserveSpendPermission) Reset()         { *m = HardReserveSpendPermission{} }
+func (m *HardReserveSpendPermission) String() string { return proto.CompactTextString(m) }
+func (*HardReserveSpendPermission) ProtoMessage()    {}
+func (*HardReserveSpendPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdfaf7be16465ae4, []int{7}
+}
+func (m *HardReserveSpendPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HardReserveSpendPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HardReserveSpendPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HardReserveSpendPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HardReserveSpendPermission.Merge(m, src)
+}
+func (m *HardReserveSpendPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *HardReserveSpendPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_HardReserveSpendPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HardReserveSpendPermission proto.InternalMessageInfo
+
 // ParamsChangePermission allows any parameter or sub parameter change proposal.
 type ParamsChangePermission struct {
 	AllowedParamsChanges AllowedParamsChanges `protobuf:"bytes,1,rep,name=allowed_params_changes,json=allowedParamsChanges,proto3,castrepeated=AllowedParamsChanges" json:"allowed_params_changes"`
@@ -257,7 +331,7 @@ func (m *ParamsChangePermission) Reset()         { *m = ParamsChangePermission{}
 func (m *ParamsChangePermission) String() string { return proto.CompactTextString(m) }
 func (*ParamsChangePermission) ProtoMessage()    {}
 func (*ParamsChangePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{6}
+	return fileDescriptor_bdfaf7be16465ae4, []int{8}
 }
 func (m *ParamsChangePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +383,7 @@ func (m *AllowedParamsChange) Reset()         { *m = AllowedParamsChange{} }
 func (m *AllowedParamsChange) String() string { return proto.CompactTextString(m) }
 func (*AllowedParamsChange) ProtoMessage()    {}
 func (*AllowedParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{7}
+	return fileDescriptor_bdfaf7be16465ae4, []int{9}
 }
 func (m *AllowedParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +454,7 @@ func (m *SubparamRequirement) Reset()         { *m = SubparamRequirement{} }
 func (m *SubparamRequirement) String() string { return proto.CompactTextString(m) }
 func (*SubparamRequirement) ProtoMessage()    {}
 func (*SubparamRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{8}
+	return fileDescriptor_bdfaf7be16465ae4, []int{10}
 }
 func (m *SubparamRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,6 +511,8 @@ func init() {
 	proto.RegisterType((*CommunityCDPRepayDebtPermission)(nil), "kava.committee.v1beta1.CommunityCDPRepayDebtPermission")
 	proto.RegisterType((*CommunityCDPWithdrawCollateralPermission)(nil), "kava.committee.v1beta1.CommunityCDPWithdrawCollateralPermission")
 	proto.RegisterType((*CommunityPoolLendWithdrawPermission)(nil), "kava.committee.v1beta1.CommunityPoolLendWithdrawPermission")
+	proto.RegisterType((*PricefeedResetMarketHaltPermission)(nil), "kava.committee.v1beta1.PricefeedResetMarketHaltPermission")
+	proto.RegisterType((*HardReserveSpendPermission)(nil), "kava.committee.v1beta1.HardReserveSpendPermission")
 	proto.RegisterType((*ParamsChangePermission)(nil), "kava.committee.v1beta1.ParamsChangePermission")
 	proto.RegisterType((*AllowedParamsChange)(nil), "kava.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "kava.committee.v1beta1.SubparamRequirement")
@@ -447,39 +523,41 @@ func init() {
 }
 
 var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x1b, 0xb3, 0x88, 0x3b, 0xe2, 0xb2, 0x64, 0x4b, 0xc9, 0x86, 0x35, 0x2d, 0xf5, 0x12,
-	0x28, 0x9b, 0x50, 0xc5, 0xcb, 0xde, 0xda, 0xae, 0x78, 0xf1, 0x50, 0xb2, 0x8a, 0xe0, 0x25, 0x4c,
-	0x9a, 0x31, 0x0d, 0x3b, 0xc9, 0xc4, 0x79, 0x27, 0xed, 0x16, 0x04, 0xbf, 0x82, 0x5f, 0x43, 0xcf,
-	0x7e, 0x88, 0xc5, 0xd3, 0x1e, 0x3d, 0xa9, 0xb4, 0x1f, 0xc3, 0x8b, 0xe4, 0x6f, 0x03, 0x1b, 0x72,
-	0x9b, 0x79, 0xf3, 0xfc, 0xde, 0xc9, 0x33, 0x2f, 0x83, 0x8c, 0x6b, 0xbc, 0xc2, 0xd6, 0x82, 0x85,
-	0x61, 0x20, 0x04, 0x21, 0xd6, 0x6a, 0xec, 0x12, 0x81, 0xc7, 0x56, 0x4c, 0x78, 0x18, 0x00, 0x04,
-	0x2c, 0x02, 0x33, 0xe6, 0x4c, 0x30, 0xa5, 0x97, 0x92, 0x66, 0x45, 0x9a, 0x05, 0xa9, 0x9d, 0x2e,
-	0x18, 0x84, 0x0c, 0x9c, 0x8c, 0xb2, 0xf2, 0x4d, 0x1e, 0xd1, 0xba, 0x3e, 0xf3, 0x59, 0x5e, 0x4f,
-	0x57, 0x79, 0x75, 0xd8, 0x47, 0x4f, 0x5e, 0x33, 0x6f, 0x5e, 0x1d, 0x70, 0x71, 0xf4, 0xf3, 0xc7,
-	0x39, 0xda, 0xef, 0x87, 0x23, 0x74, 0x7a, 0xc5, 0x3e, 0x8a, 0x35, 0xe6, 0xe4, 0x5d, 0xec, 0x73,
-	0xec, 0x91, 0x16, 0x78, 0x80, 0x8e, 0xde, 0x92, 0x1b, 0xd1, 0x42, 0x8c, 0x51, 0x7f, 0xc6, 0xc2,
-	0x30, 0x89, 0x02, 0xb1, 0x99, 0x5d, 0xce, 0x6d, 0x12, 0xe3, 0xcd, 0x25, 0x71, 0xdb, 0x22, 0x17,
-	0xc8, 0xa8, 0x47, 0xde, 0x07, 0x62, 0xe9, 0x71, 0xbc, 0x9e, 0x31, 0x4a, 0xb1, 0x20, 0x1c, 0xd3,
-	0x96, 0xec, 0x4b, 0xf4, 0xac, 0xca, 0xce, 0x19, 0xa3, 0x6f, 0x48, 0xe4, 0x95, 0x0d, 0x5a, 0x62,
-	0xdf, 0x24, 0xd4, 0x9b, 0x63, 0x8e, 0x43, 0x98, 0x2d, 0x71, 0xe4, 0xd7, 0x94, 0x95, 0x2f, 0xa8,
-	0x87, 0x29, 0x65, 0x6b, 0xe2, 0x39, 0x71, 0x46, 0x38, 0x8b, 0x0c, 0x01, 0x55, 0x1a, 0xc8, 0xc6,
-	0xe3, 0xe7, 0x23, 0xb3, 0x79, 0x34, 0xe6, 0x24, 0x4f, 0xd5, 0xdb, 0x4e, 0xcf, 0x6e, 0x7f, 0xf7,
-	0x3b, 0xdf, 0xff, 0xf4, 0xbb, 0x0d, 0x1f, 0xc1, 0xee, 0xe2, 0x86, 0xea, 0xbd, 0x7f, 0xfd, 0x27,
-	0xa1, 0x93, 0x86, 0xb8, 0xa2, 0xa1, 0x47, 0x90, 0xb8, 0x10, 0xe3, 0x05, 0x51, 0xa5, 0x81, 0x64,
-	0x1c, 0xda, 0xd5, 0x5e, 0x39, 0x46, 0xf2, 0x35, 0xd9, 0xa8, 0x0f, 0xb2, 0x72, 0xba, 0x54, 0x26,
-	0xe8, 0x29, 0x04, 0x91, 0x4f, 0x89, 0x03, 0x89, 0x9b, 0x89, 0x39, 0xa5, 0x26, 0x16, 0x82, 0x83,
-	0x2a, 0x0f, 0x64, 0xe3, 0xd0, 0xd6, 0x72, 0xe8, 0xaa, 0x60, 0x8a, 0x73, 0x27, 0x29, 0xa1, 0x00,
-	0x3a, 0x0b, 0x13, 0x2a, 0x82, 0xaa, 0x03, 0x38, 0x9c, 0x7c, 0x4a, 0x02, 0x4e, 0x42, 0x12, 0x09,
-	0x50, 0x0f, 0xda, 0xef, 0xa7, 0xec, 0x69, 0xef, 0x33, 0xd3, 0x83, 0xf4, 0x7e, 0x6c, 0x2d, 0x6b,
-	0x5b, 0x7e, 0x87, 0x1a, 0x00, 0xc3, 0xcf, 0xe8, 0xa4, 0x21, 0x58, 0x0a, 0x4a, 0x7b, 0xc1, 0x63,
-	0x24, 0xaf, 0x30, 0x2d, 0x95, 0x57, 0x98, 0xa6, 0xca, 0xa5, 0xe2, 0xde, 0x59, 0x08, 0x5e, 0x0d,
-	0xb4, 0x50, 0x2e, 0xa0, 0xca, 0x59, 0x08, 0x5e, 0xcc, 0x62, 0xfa, 0xea, 0x76, 0xab, 0x4b, 0x77,
-	0x5b, 0x5d, 0xfa, 0xbb, 0xd5, 0xa5, 0xaf, 0x3b, 0xbd, 0x73, 0xb7, 0xd3, 0x3b, 0xbf, 0x76, 0x7a,
-	0xe7, 0xc3, 0xc8, 0x0f, 0xc4, 0x32, 0x71, 0x53, 0x4f, 0x2b, 0x15, 0x3e, 0xa7, 0xd8, 0x85, 0x6c,
-	0x65, 0xdd, 0xd4, 0x5e, 0xb8, 0xd8, 0xc4, 0x04, 0xdc, 0x87, 0xd9, 0x5b, 0x7c, 0xf1, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0xe8, 0xa8, 0x0a, 0x00, 0x04, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6a, 0xdb, 0x4e,
+	0x10, 0xc7, 0xad, 0x9f, 0xc3, 0x8f, 0x66, 0x4b, 0x43, 0x50, 0x8c, 0x71, 0x44, 0x2a, 0x1b, 0xf7,
+	0x62, 0x70, 0x63, 0xe1, 0xfe, 0xb9, 0xe4, 0x66, 0x3b, 0xa5, 0x39, 0xb4, 0x60, 0xe4, 0x96, 0x42,
+	0x2f, 0x62, 0x64, 0x4d, 0x64, 0xe1, 0x95, 0x56, 0xdd, 0x5d, 0xd9, 0x31, 0x14, 0xfa, 0x0a, 0x7d,
+	0x8d, 0xf6, 0xdc, 0x87, 0x08, 0x3d, 0xe5, 0xd8, 0x53, 0x5b, 0xec, 0xc7, 0xe8, 0xa5, 0xe8, 0xaf,
+	0x0d, 0x35, 0xba, 0xcd, 0xcc, 0x7e, 0xbe, 0xb3, 0xfb, 0x9d, 0x41, 0x22, 0x9d, 0x39, 0x2c, 0xc0,
+	0x98, 0x32, 0xdf, 0xf7, 0xa4, 0x44, 0x34, 0x16, 0x7d, 0x1b, 0x25, 0xf4, 0x8d, 0x10, 0xb9, 0xef,
+	0x09, 0xe1, 0xb1, 0x40, 0xf4, 0x42, 0xce, 0x24, 0x53, 0xeb, 0x31, 0xd9, 0x2b, 0xc8, 0x5e, 0x46,
+	0x6a, 0xa7, 0x53, 0x26, 0x7c, 0x26, 0xac, 0x84, 0x32, 0xd2, 0x24, 0x95, 0x68, 0x35, 0x97, 0xb9,
+	0x2c, 0xad, 0xc7, 0x51, 0x5a, 0x6d, 0x37, 0xc9, 0x83, 0x97, 0xcc, 0x19, 0x17, 0x17, 0x5c, 0x1c,
+	0x7d, 0xff, 0x76, 0x4e, 0xb6, 0x79, 0xbb, 0x4b, 0x4e, 0x27, 0xec, 0x5a, 0x2e, 0x81, 0xe3, 0xdb,
+	0xd0, 0xe5, 0xe0, 0x60, 0x09, 0xdc, 0x22, 0x47, 0x6f, 0xf0, 0x46, 0x96, 0x10, 0x7d, 0xd2, 0x1c,
+	0x31, 0xdf, 0x8f, 0x02, 0x4f, 0xae, 0x46, 0x97, 0x63, 0x13, 0x43, 0x58, 0x5d, 0xa2, 0x5d, 0x26,
+	0xb9, 0x20, 0x9d, 0x5d, 0xc9, 0x3b, 0x4f, 0xce, 0x1c, 0x0e, 0xcb, 0x11, 0xa3, 0x14, 0x24, 0x72,
+	0xa0, 0x25, 0xda, 0xe7, 0xe4, 0x51, 0xa1, 0x1d, 0x33, 0x46, 0x5f, 0x61, 0xe0, 0xe4, 0x0d, 0x4a,
+	0x64, 0xcf, 0x48, 0x7b, 0xcc, 0xbd, 0x29, 0x5e, 0x23, 0x3a, 0x26, 0x0a, 0x94, 0xaf, 0x81, 0xcf,
+	0x51, 0x5e, 0x01, 0x2d, 0x7b, 0xe8, 0x63, 0xa2, 0x5d, 0x01, 0x4f, 0x04, 0x7c, 0x81, 0x93, 0x10,
+	0x83, 0xb2, 0xc1, 0x7e, 0x51, 0x48, 0x7d, 0x0c, 0x1c, 0x7c, 0x31, 0x9a, 0x41, 0xe0, 0xee, 0x8c,
+	0x55, 0xfd, 0x44, 0xea, 0x40, 0x29, 0x5b, 0xa2, 0x63, 0x85, 0x09, 0x61, 0x4d, 0x13, 0x44, 0x34,
+	0x94, 0x56, 0xb5, 0x73, 0xff, 0x49, 0xb7, 0xb7, 0x7f, 0xfd, 0xbd, 0x41, 0xaa, 0xda, 0x6d, 0x3b,
+	0x3c, 0xbb, 0xfd, 0xd9, 0xac, 0x7c, 0xfd, 0xd5, 0xac, 0xed, 0x39, 0x14, 0x66, 0x0d, 0xf6, 0x54,
+	0xff, 0x79, 0xeb, 0x1f, 0x85, 0x9c, 0xec, 0x91, 0xab, 0x1a, 0xb9, 0x27, 0x22, 0x5b, 0x84, 0x30,
+	0xc5, 0x86, 0xd2, 0x52, 0x3a, 0x87, 0x66, 0x91, 0xab, 0xc7, 0xa4, 0x3a, 0xc7, 0x55, 0xe3, 0xbf,
+	0xa4, 0x1c, 0x87, 0xea, 0x80, 0x3c, 0x14, 0x5e, 0xe0, 0x52, 0xb4, 0x44, 0x64, 0x27, 0xc6, 0xac,
+	0xdc, 0x26, 0x48, 0xc9, 0x45, 0xa3, 0xda, 0xaa, 0x76, 0x0e, 0x4d, 0x2d, 0x85, 0x26, 0x19, 0x93,
+	0xdd, 0x3b, 0x88, 0x09, 0x55, 0x90, 0x33, 0x3f, 0xa2, 0xd2, 0x2b, 0x3a, 0x08, 0x8b, 0xe3, 0x87,
+	0xc8, 0xe3, 0xe8, 0x63, 0x20, 0x45, 0xe3, 0xa0, 0x7c, 0x3e, 0x79, 0x4f, 0x73, 0xab, 0x19, 0x1e,
+	0xc4, 0xf3, 0x31, 0xb5, 0xa4, 0x6d, 0x7e, 0x2e, 0x76, 0x00, 0xd1, 0xfe, 0x48, 0x4e, 0xf6, 0x08,
+	0x73, 0x83, 0xca, 0xd6, 0xe0, 0x31, 0xa9, 0x2e, 0x80, 0xe6, 0x96, 0x17, 0x40, 0x63, 0xcb, 0xb9,
+	0xc5, 0xad, 0x67, 0x29, 0x79, 0xb1, 0xd0, 0xcc, 0x72, 0x06, 0x15, 0x9e, 0xa5, 0xe4, 0xd9, 0x2e,
+	0x86, 0x2f, 0x6e, 0xd7, 0xba, 0x72, 0xb7, 0xd6, 0x95, 0xdf, 0x6b, 0x5d, 0xf9, 0xbc, 0xd1, 0x2b,
+	0x77, 0x1b, 0xbd, 0xf2, 0x63, 0xa3, 0x57, 0xde, 0x77, 0x5d, 0x4f, 0xce, 0x22, 0x3b, 0xf6, 0x69,
+	0xc4, 0x86, 0xcf, 0x29, 0xd8, 0x22, 0x89, 0x8c, 0x9b, 0x9d, 0xbf, 0x88, 0x5c, 0x85, 0x28, 0xec,
+	0xff, 0x93, 0xef, 0xfd, 0xe9, 0xdf, 0x01, 0x00, 0xb8, 0xa5, 0x7d, 0x2b, 0x64, 0x04, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -620,6 +698,52 @@ func (m *CommunityPoolLendWithdrawPermission) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *PricefeedResetMarketHaltPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricefeedResetMarketHaltPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricefeedResetMarketHaltPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *HardReserveSpendPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HardReserveSpendPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HardReserveSpendPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ParamsChangePermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -828,6 +952,24 @@ func (m *CommunityPoolLendWithdrawPermission) Size() (n int) {
 	return n
 }
 
+func (m *PricefeedResetMarketHaltPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HardReserveSpendPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ParamsChangePermission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1201,6 +1343,106 @@ func (m *CommunityPoolLendWithdrawPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PricefeedResetMarketHaltPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricefeedResetMarketHaltPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricefeedResetMarketHaltPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HardReserveSpendPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardReserveSpendPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardReserveSpendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ParamsChangePermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
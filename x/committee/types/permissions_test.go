@@ -12,6 +12,8 @@ import (
 
 	"github.com/kava-labs/kava/x/committee/types"
 	communitytypes "github.com/kava-labs/kava/x/community/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 func TestPackPermissions_Success(t *testing.T) {
@@ -154,6 +156,81 @@ func TestCommunityCDPWithdrawCollateralPermission_Allows(t *testing.T) {
 	}
 }
 
+func TestPricefeedResetMarketHaltPermission_Allows(t *testing.T) {
+	permission := types.PricefeedResetMarketHaltPermission{}
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name: "allowed for correct proposal",
+			proposal: pricefeedtypes.NewPricefeedResetMarketHaltProposal(
+				"resume bnb market",
+				"resumes the halted bnb:usd market",
+				"bnb:usd",
+			),
+			allowed: true,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name: "fails for wrong proposal",
+			proposal: newTestParamsChangeProposalWithChanges([]paramsproposal.ParamChange{
+				{Subspace: "pricefeed", Key: "Markets", Value: `test`},
+			}),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestHardReserveSpendPermission_Allows(t *testing.T) {
+	permission := types.HardReserveSpendPermission{}
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name: "allowed for correct proposal",
+			proposal: hardtypes.NewHardReserveSpendProposal(
+				"spend reserves",
+				"sends hard reserves to the community pool",
+				nil,
+				sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000)),
+			),
+			allowed: true,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+		{
+			name: "fails for wrong proposal",
+			proposal: newTestParamsChangeProposalWithChanges([]paramsproposal.ParamChange{
+				{Subspace: "hard", Key: "MoneyMarkets", Value: `test`},
+			}),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
 func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	testPermission := types.ParamsChangePermission{
 		AllowedParamsChanges: types.AllowedParamsChanges{
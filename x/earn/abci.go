@@ -0,0 +1,28 @@
+package earn
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/keeper"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// BeginBlocker charges vault fees that are due at the fee checkpoint interval
+// and records vault share price snapshots
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ApplyVaultFeeCheckpoints(ctx)
+	k.RecordVaultSharePriceSnapshots(ctx)
+}
+
+// EndBlocker fulfils queued withdrawal claims that the vault strategies have
+// the liquidity to pay
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.FulfillWithdrawalClaims(ctx)
+}
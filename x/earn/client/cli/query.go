@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +37,10 @@ func GetQueryCmd() *cobra.Command {
 		queryVaultCmd(),
 		queryDepositsCmd(),
 		queryTotalSupplyCmd(),
+		queryVaultFeesCmd(),
+		queryVaultHistoryCmd(),
+		queryVaultApyCmd(),
+		queryWithdrawalClaimsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -206,3 +211,140 @@ func queryTotalSupplyCmd() *cobra.Command {
 		},
 	}
 }
+
+func queryVaultFeesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "vault-fees",
+		Short:   "get the fees of an earn vault",
+		Long:    "Get the fee parameters, high-water mark and accrued fee shares of an earn vault by denom.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s vault-fees usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultFeesRequest(args[0])
+			res, err := queryClient.VaultFees(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryVaultHistoryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "vault-history [denom] [window]",
+		Short:   "get the share price history of an earn vault",
+		Long:    "Get the share price snapshots of an earn vault recorded within a window ending at the current block time.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s q %[2]s vault-history usdx 720h`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultHistoryRequest(args[0], window)
+			res, err := queryClient.VaultHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryVaultApyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "vault-apy [denom] [window]",
+		Short:   "get the realized APY of an earn vault",
+		Long:    "Get the share price change of an earn vault over a window ending at the current block time, annualized without compounding.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s q %[2]s vault-apy usdx 720h`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultApyRequest(args[0], window)
+			res, err := queryClient.VaultApy(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryWithdrawalClaimsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-claims",
+		Short: "get queued earn vault withdrawal claims",
+		Long:  "Get queued earn vault withdrawal claims for all or specific accounts and vaults.",
+		Args:  cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s q %[2]s withdrawal-claims
+%[1]s q %[2]s withdrawal-claims --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --denom usdx
+%[1]s q %[2]s withdrawal-claims --denom usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryWithdrawalClaimsRequest(ownerBech, denom, pageReq)
+			res, err := queryClient.WithdrawalClaims(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawal-claims")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for withdrawal claims by owner address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for withdrawal claims by vault denom")
+
+	return cmd
+}
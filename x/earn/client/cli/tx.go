@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -16,6 +17,11 @@ import (
 	"github.com/kava-labs/kava/x/earn/types"
 )
 
+// flags for cli transactions
+const (
+	flagBeneficiary = "beneficiary"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	earnTxCmd := &cobra.Command{
@@ -29,6 +35,12 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdRebalanceVault(),
+		getCmdRequestWithdraw(),
+		getCmdClaimWithdraw(),
+		getCmdAddAllowedDepositor(),
+		getCmdRemoveAllowedDepositor(),
+		getCmdTransferVaultManager(),
 	}
 
 	for _, cmd := range cmds {
@@ -41,11 +53,12 @@ func GetTxCmd() *cobra.Command {
 }
 
 func getCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [amount] [strategy]",
 		Short: "deposit coins to an earn vault",
 		Example: fmt.Sprintf(
-			`%s tx %s deposit 10000000ukava hard --from <key>`,
+			`%[1]s tx %[2]s deposit 10000000ukava hard --from <key>
+%[1]s tx %[2]s deposit 10000000ukava hard --beneficiary kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`,
 			version.AppName, types.ModuleName,
 		),
 		Args: cobra.ExactArgs(2),
@@ -67,6 +80,12 @@ func getCmdDeposit() *cobra.Command {
 
 			signer := clientCtx.GetFromAddress()
 			msg := types.NewMsgDeposit(signer.String(), amount, strategy)
+			beneficiary, err := cmd.Flags().GetString(flagBeneficiary)
+			if err != nil {
+				return err
+			}
+			msg.Beneficiary = beneficiary
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -74,14 +93,19 @@ func getCmdDeposit() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
+	cmd.Flags().String(flagBeneficiary, "", "(optional) address credited with the deposit, defaults to the sender")
+
+	return cmd
 }
 
 func getCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [amount] [strategy]",
 		Short: "withdraw coins from an earn vault",
 		Example: fmt.Sprintf(
-			`%s tx %s withdraw 10000000ukava hard --from <key>`,
+			`%[1]s tx %[2]s withdraw 10000000ukava hard --from <key>
+%[1]s tx %[2]s withdraw 10000000ukava hard --beneficiary kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`,
 			version.AppName, types.ModuleName,
 		),
 		Args: cobra.ExactArgs(2),
@@ -103,6 +127,120 @@ func getCmdWithdraw() *cobra.Command {
 
 			fromAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgWithdraw(fromAddr.String(), amount, strategy)
+			beneficiary, err := cmd.Flags().GetString(flagBeneficiary)
+			if err != nil {
+				return err
+			}
+			msg.Beneficiary = beneficiary
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagBeneficiary, "", "(optional) address receiving the withdrawn coins, defaults to the sender")
+
+	return cmd
+}
+
+func getCmdRebalanceVault() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebalance-vault [denom]",
+		Short: "move an earn vault's funds back to the target weights of its strategies",
+		Example: fmt.Sprintf(
+			`%s tx %s rebalance-vault usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgRebalanceVault(signer.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdAddAllowedDepositor() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-allowed-depositor [denom] [depositor]",
+		Short: "allow an account to deposit to a private earn vault you manage",
+		Example: fmt.Sprintf(
+			`%s tx %s add-allowed-depositor usdx kava1... --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			manager := clientCtx.GetFromAddress()
+			msg := types.NewMsgAddAllowedDepositor(manager.String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdRemoveAllowedDepositor() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-allowed-depositor [denom] [depositor]",
+		Short: "stop an account from depositing to a private earn vault you manage",
+		Example: fmt.Sprintf(
+			`%s tx %s remove-allowed-depositor usdx kava1... --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			manager := clientCtx.GetFromAddress()
+			msg := types.NewMsgRemoveAllowedDepositor(manager.String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdTransferVaultManager() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-vault-manager [denom] [new-manager]",
+		Short: "transfer the manager role of a private earn vault you manage",
+		Example: fmt.Sprintf(
+			`%s tx %s transfer-vault-manager usdx kava1... --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			manager := clientCtx.GetFromAddress()
+			msg := types.NewMsgTransferVaultManager(manager.String(), args[0], args[1])
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -113,6 +251,73 @@ func getCmdWithdraw() *cobra.Command {
 }
 
 // GetCmdSubmitCommunityPoolDepositProposal implements the command to submit a community-pool deposit proposal
+func getCmdRequestWithdraw() *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-withdraw [amount] [strategy]",
+		Short: "queue a withdrawal of coins from an earn vault, to be claimed once the vault has enough liquidity",
+		Example: fmt.Sprintf(
+			`%s tx %s request-withdraw 10000000ukava hard --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			strategy := types.NewStrategyTypeFromString(args[1])
+			if !strategy.IsValid() {
+				return fmt.Errorf("invalid strategy type: %s", args[1])
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgRequestWithdraw(fromAddr.String(), amount, strategy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdClaimWithdraw() *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-withdraw [claim-id]",
+		Short: "pay out a fulfilled earn vault withdrawal claim",
+		Example: fmt.Sprintf(
+			`%s tx %s claim-withdraw 1 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			claimID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid claim id: %w", err)
+			}
+
+			owner := clientCtx.GetFromAddress()
+			msg := types.NewMsgClaimWithdraw(owner.String(), claimID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 func GetCmdSubmitCommunityPoolDepositProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "community-pool-deposit [proposal-file]",
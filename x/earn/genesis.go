@@ -20,6 +20,9 @@ func InitGenesis(
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
 	}
 
+	// Params are set first as they determine which vaults have tokenized shares
+	k.SetParams(ctx, gs.Params)
+
 	// Total of all vault share records, vault record total supply should equal this
 	vaultTotalShares := types.NewVaultShares()
 
@@ -38,19 +41,35 @@ func InitGenesis(
 			panic(fmt.Sprintf("invalid vault record: %s", err))
 		}
 
-		if !vaultRecord.TotalShares.Amount.Equal(vaultTotalShares.AmountOf(vaultRecord.TotalShares.Denom)) {
+		// Tokenized shares are held as share coins in bank genesis
+		ownedShares := vaultTotalShares.AmountOf(vaultRecord.TotalShares.Denom).
+			Add(k.GetVaultShareSupply(ctx, vaultRecord.TotalShares.Denom))
+
+		if !vaultRecord.TotalShares.Amount.Equal(ownedShares) {
 			panic(fmt.Sprintf(
 				"invalid vault record total supply for %s, got %s but sum of vault shares is %s",
 				vaultRecord.TotalShares.Denom,
 				vaultRecord.TotalShares.Amount,
-				vaultTotalShares.AmountOf(vaultRecord.TotalShares.Denom),
+				ownedShares,
 			))
 		}
 
 		k.SetVaultRecord(ctx, vaultRecord)
 	}
 
-	k.SetParams(ctx, gs.Params)
+	for _, vaultFeeRecord := range gs.VaultFeeRecords {
+		k.SetVaultFeeRecord(ctx, vaultFeeRecord)
+	}
+
+	for _, snapshot := range gs.VaultSharePriceSnapshots {
+		k.SetVaultSharePriceSnapshot(ctx, snapshot)
+	}
+
+	for _, claim := range gs.WithdrawalClaims {
+		k.AddWithdrawalClaim(ctx, claim)
+	}
+
+	k.SetNextWithdrawalClaimID(ctx, gs.NextWithdrawalClaimID)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -58,6 +77,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	vaultRecords := k.GetAllVaultRecords(ctx)
 	vaultShareRecords := k.GetAllVaultShareRecords(ctx)
+	vaultFeeRecords := k.GetAllVaultFeeRecords(ctx)
+	vaultSharePriceSnapshots := k.GetAllVaultSharePriceSnapshots(ctx)
+	withdrawalClaims := k.GetAllWithdrawalClaims(ctx)
+	nextWithdrawalClaimID := k.GetNextWithdrawalClaimID(ctx)
 
-	return types.NewGenesisState(params, vaultRecords, vaultShareRecords)
+	return types.NewGenesisState(
+		params,
+		vaultRecords,
+		vaultShareRecords,
+		vaultFeeRecords,
+		vaultSharePriceSnapshots,
+		withdrawalClaims,
+		nextWithdrawalClaimID,
+	)
 }
@@ -2,6 +2,7 @@ package earn_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/earn"
@@ -26,6 +27,7 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 					nil,
 				),
 			},
+			RebalanceThreshold: types.DefaultRebalanceThreshold,
 		},
 		types.VaultRecords{
 			{
@@ -35,6 +37,10 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 			},
 		},
 		types.VaultShareRecords{},
+		types.VaultFeeRecords{},
+		types.VaultSharePriceSnapshots{},
+		types.WithdrawalClaims{},
+		types.DefaultNextWithdrawalClaimID,
 	)
 
 	suite.Panics(func() {
@@ -65,6 +71,7 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 					[]sdk.AccAddress{suite.AccountKeeper.GetModuleAddress("distribution")},
 				),
 			},
+			RebalanceThreshold: types.DefaultRebalanceThreshold,
 		},
 		types.VaultRecords{
 			types.VaultRecord{
@@ -90,6 +97,24 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 				),
 			},
 		},
+		types.VaultFeeRecords{
+			types.NewVaultFeeRecord("usdx", sdk.OneDec(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec()),
+		},
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot("usdx", sdk.OneDec(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewVaultSharePriceSnapshot("usdx", sdk.MustNewDecFromStr("1.01"), time.Date(2022, 1, 1, 6, 0, 0, 0, time.UTC)),
+		},
+		types.WithdrawalClaims{
+			types.NewWithdrawalClaim(1, depositor_1, sdk.NewInt64Coin("usdx", 1000), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			{
+				ID:          2,
+				Owner:       depositor_2,
+				Amount:      sdk.NewInt64Coin("usdx", 2000),
+				RequestTime: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				Fulfilled:   true,
+			},
+		},
+		3,
 	)
 
 	earn.InitGenesis(suite.Ctx, suite.Keeper, suite.AccountKeeper, state)
@@ -106,6 +131,9 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	suite.Equal(state.VaultShareRecords[0], shareRecord1)
 	suite.Equal(state.VaultShareRecords[1], shareRecord2)
 
+	// Only unfulfilled claims are pending
+	suite.Equal(sdk.NewInt(1000), suite.Keeper.GetPendingWithdrawals(suite.Ctx, "usdx"))
+
 	exportedState := earn.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -133,6 +161,7 @@ func (suite *genesisTestSuite) Test_Marshall() {
 					[]sdk.AccAddress{suite.AccountKeeper.GetModuleAddress("distribution")},
 				),
 			},
+			RebalanceThreshold: types.DefaultRebalanceThreshold,
 		},
 		types.VaultRecords{
 			types.VaultRecord{
@@ -158,6 +187,24 @@ func (suite *genesisTestSuite) Test_Marshall() {
 				),
 			},
 		},
+		types.VaultFeeRecords{
+			types.NewVaultFeeRecord("usdx", sdk.OneDec(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec()),
+		},
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot("usdx", sdk.OneDec(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			types.NewVaultSharePriceSnapshot("usdx", sdk.MustNewDecFromStr("1.01"), time.Date(2022, 1, 1, 6, 0, 0, 0, time.UTC)),
+		},
+		types.WithdrawalClaims{
+			types.NewWithdrawalClaim(1, depositor_1, sdk.NewInt64Coin("usdx", 1000), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			{
+				ID:          2,
+				Owner:       depositor_2,
+				Amount:      sdk.NewInt64Coin("usdx", 2000),
+				RequestTime: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				Fulfilled:   true,
+			},
+		},
+		3,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
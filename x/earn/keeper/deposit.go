@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/earn/types"
@@ -15,6 +16,19 @@ func (k *Keeper) Deposit(
 	depositor sdk.AccAddress,
 	amount sdk.Coin,
 	depositStrategy types.StrategyType,
+) error {
+	return k.DepositFor(ctx, depositor, depositor, amount, depositStrategy)
+}
+
+// DepositFor adds the provided amount from a depositor to a vault, crediting
+// the vault shares to the beneficiary. The vault is specified by the denom in
+// the amount.
+func (k *Keeper) DepositFor(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	beneficiary sdk.AccAddress,
+	amount sdk.Coin,
+	depositStrategy types.StrategyType,
 ) error {
 	// Get AllowedVault, if not found (not a valid vault), return error
 	allowedVault, found := k.GetAllowedVault(ctx, amount.Denom)
@@ -32,26 +46,27 @@ func (k *Keeper) Deposit(
 	}
 
 	// Check if account can deposit -- this checks if the vault is private
-	// and if so, if the depositor is in the AllowedDepositors list
-	if !allowedVault.IsAccountAllowed(depositor) {
+	// and if so, if the beneficiary is in the AllowedDepositors list
+	if !allowedVault.IsAccountAllowed(beneficiary) {
 		return types.ErrAccountDepositNotAllowed
 	}
 
+	// Compound rewards before fees are charged on the vault value and shares
+	// are issued at it
+	k.compoundVaultRewards(ctx, allowedVault)
+
+	// Charge fees before issuing shares, as fee shares modify the VaultRecord
+	if err := k.AccrueVaultFees(ctx, amount.Denom); err != nil {
+		return err
+	}
+
 	// Check if VaultRecord exists, create if not exist
 	vaultRecord, found := k.GetVaultRecord(ctx, amount.Denom)
 	if !found {
-		// Create a new VaultRecord with 0 supply
+		// Create a new VaultRecord with 0 supply, fixing the form shares are
+		// issued in until the vault is empty again
 		vaultRecord = types.NewVaultRecord(amount.Denom, sdk.ZeroDec())
-	}
-
-	// Get the strategy for the vault
-	// NOTE: Currently always uses the first one, AllowedVaults are currently
-	// only valid with 1 and only 1 strategy so this is safe.
-	// If/When multiple strategies are supported and users can specify specific
-	// strategies, shares should be issued per-strategy instead of per-vault.
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return err
+		vaultRecord.TokenizeShares = allowedVault.TokenizeShares
 	}
 
 	// Transfer amount to module account
@@ -64,48 +79,67 @@ func (k *Keeper) Deposit(
 		return err
 	}
 
-	// Get VaultShareRecord for account, create if account has no deposits.
-	// This can still be found if the account has deposits for other vaults.
-	vaultShareRecord, found := k.GetVaultShareRecord(ctx, depositor)
-	if !found {
-		// Create a new empty VaultShareRecord with 0 supply
-		vaultShareRecord = types.NewVaultShareRecord(depositor, types.NewVaultShares())
-	}
-
 	shares, err := k.ConvertToShares(ctx, amount)
 	if err != nil {
 		return fmt.Errorf("failed to convert assets to shares: %w", err)
 	}
 
-	isNew := vaultShareRecord.Shares.AmountOf(amount.Denom).IsZero()
+	// Deposit to the most underweight of the vault's strategies. Shares are
+	// issued per-vault, so the strategy the depositor chose only needs to be
+	// one of the vault's strategies.
+	shares, err = k.depositSharesToStrategies(ctx, allowedVault, amount, shares)
+	if err != nil {
+		return err
+	}
+
+	// Tokenized shares are issued in whole units
+	if allowedVault.TokenizeShares {
+		shares.Amount = shares.Amount.TruncateDec()
+		if shares.Amount.IsZero() {
+			return types.ErrInsufficientAmount
+		}
+	}
+
+	accCurrentShares := k.getAccountVaultShares(ctx, beneficiary, amount.Denom)
+
+	isNew := accCurrentShares.IsZero()
 	if !isNew {
 		// If deposits for this vault already exists, call hook with user's existing shares
-		k.BeforeVaultDepositModified(ctx, amount.Denom, depositor, vaultShareRecord.Shares.AmountOf(amount.Denom))
+		k.BeforeVaultDepositModified(ctx, amount.Denom, beneficiary, accCurrentShares)
 	}
 
 	// Increment VaultRecord total shares and account shares
 	vaultRecord.TotalShares = vaultRecord.TotalShares.Add(shares)
-	vaultShareRecord.Shares = vaultShareRecord.Shares.Add(shares)
-
-	// Update VaultRecord and VaultShareRecord
 	k.SetVaultRecord(ctx, vaultRecord)
-	k.SetVaultShareRecord(ctx, vaultShareRecord)
 
-	if isNew {
-		// If first deposit in this vault
-		k.AfterVaultDepositCreated(ctx, amount.Denom, depositor, shares.Amount)
+	if allowedVault.TokenizeShares {
+		// Tokenized shares are held by the beneficiary as share coins
+		if err := k.mintShareCoins(ctx, beneficiary, shares); err != nil {
+			return err
+		}
+	} else {
+		// Get VaultShareRecord for account, create if account has no deposits.
+		// This can still be found if the account has deposits for other vaults.
+		vaultShareRecord, found := k.GetVaultShareRecord(ctx, beneficiary)
+		if !found {
+			// Create a new empty VaultShareRecord with 0 supply
+			vaultShareRecord = types.NewVaultShareRecord(beneficiary, types.NewVaultShares())
+		}
+
+		vaultShareRecord.Shares = vaultShareRecord.Shares.Add(shares)
+		k.SetVaultShareRecord(ctx, vaultShareRecord)
 	}
 
-	// Deposit to the strategy
-	if err := strategy.Deposit(ctx, amount); err != nil {
-		return err
+	if isNew {
+		// If first deposit in this vault
+		k.AfterVaultDepositCreated(ctx, amount.Denom, beneficiary, shares.Amount)
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeVaultDeposit,
 			sdk.NewAttribute(types.AttributeKeyVaultDenom, amount.Denom),
-			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, beneficiary.String()),
 			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.Amount.String()),
 		),
@@ -114,6 +148,45 @@ func (k *Keeper) Deposit(
 	return nil
 }
 
+// depositSharesToStrategies deposits the amount to the vault's strategies and
+// returns the shares issued for it. Vaults with strategies that pay swap costs
+// issue shares for the vault value the deposit added, so the depositor pays
+// for their own slippage instead of the vault's other depositors.
+func (k *Keeper) depositSharesToStrategies(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	amount sdk.Coin,
+	shares types.VaultShare,
+) (types.VaultShare, error) {
+	if !hasSwapCosts(allowedVault) {
+		return shares, k.depositToStrategies(ctx, allowedVault, amount)
+	}
+
+	valueBefore, err := k.GetVaultTotalValue(ctx, amount.Denom)
+	if err != nil {
+		return types.VaultShare{}, err
+	}
+
+	if err := k.depositToStrategies(ctx, allowedVault, amount); err != nil {
+		return types.VaultShare{}, err
+	}
+
+	valueAfter, err := k.GetVaultTotalValue(ctx, amount.Denom)
+	if err != nil {
+		return types.VaultShare{}, err
+	}
+
+	added := valueAfter.Amount.Sub(valueBefore.Amount)
+	if !added.IsPositive() {
+		return types.VaultShare{}, errorsmod.Wrapf(types.ErrInsufficientAmount, "%s adds no value to the vault", amount)
+	}
+	if added.LT(amount.Amount) {
+		shares.Amount = shares.Amount.MulInt(added).QuoInt(amount.Amount)
+	}
+
+	return shares, nil
+}
+
 // DepositFromModuleAccount adds the provided amount from a depositor module
 // account to a vault. The vault is specified by the denom in the amount.
 func (k *Keeper) DepositFromModuleAccount(
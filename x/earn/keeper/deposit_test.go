@@ -168,6 +168,39 @@ func (suite *depositTestSuite) TestDeposit_PrivateVault() {
 	suite.Require().NoError(err, "private vault should allow deposits from allowed addresses")
 }
 
+func (suite *depositTestSuite) TestDepositFor() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	sender := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	beneficiary := suite.CreateAccount(sdk.NewCoins(), 1)
+
+	suite.CreateVault(
+		vaultDenom,
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD},
+		true,
+		[]sdk.AccAddress{beneficiary.GetAddress()},
+	)
+
+	// private vaults check the account receiving the shares
+	err := suite.Keeper.DepositFor(suite.Ctx, beneficiary.GetAddress(), sender.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrAccountDepositNotAllowed)
+
+	err = suite.Keeper.DepositFor(suite.Ctx, sender.GetAddress(), beneficiary.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(sender.GetAddress(), sdk.NewCoins(startBalance.Sub(depositAmount)))
+	suite.AccountBalanceEqual(beneficiary.GetAddress(), sdk.NewCoins())
+
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, sender.GetAddress())
+	suite.Require().False(found)
+
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, beneficiary.GetAddress())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecFromInt(depositAmount.Amount), shares.AmountOf(vaultDenom))
+}
+
 func (suite *depositTestSuite) TestDeposit_bKava() {
 	vaultDenom := "bkava"
 	coinDenom := testutil.TestBkavaDenoms[0]
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// AccrueVaultFees charges the fees a vault has accrued since they were last
+// charged by minting vault shares to the fee recipient. It must be called
+// before a vault's shares are issued or redeemed so depositors pay fees on
+// the value the vault had while they held shares.
+func (k *Keeper) AccrueVaultFees(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found || allowedVault.Fees == nil {
+		return nil
+	}
+
+	record, found := k.GetVaultFeeRecord(ctx, denom)
+	vaultRecord, vaultFound := k.GetVaultRecord(ctx, denom)
+	if !vaultFound {
+		// Empty vaults don't accrue fees, and shares are next issued at the
+		// initial share price of 1
+		accruedFeeShares := sdk.ZeroDec()
+		if found {
+			accruedFeeShares = record.AccruedFeeShares
+		}
+		k.SetVaultFeeRecord(ctx, types.NewVaultFeeRecord(denom, sdk.OneDec(), ctx.BlockTime(), accruedFeeShares))
+		return nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		// Fees added to an existing vault accrue from the current share price
+		sharePrice := sdk.NewDecFromInt(totalValue.Amount).Quo(vaultRecord.TotalShares.Amount)
+		k.SetVaultFeeRecord(ctx, types.NewVaultFeeRecord(denom, sharePrice, ctx.BlockTime(), sdk.ZeroDec()))
+		return nil
+	}
+
+	feeShares, highWaterMark := calculateVaultFees(
+		*allowedVault.Fees,
+		record,
+		vaultRecord.TotalShares.Amount,
+		totalValue.Amount,
+		ctx.BlockTime(),
+	)
+
+	record.HighWaterMark = highWaterMark
+	record.LastAccrualTime = ctx.BlockTime()
+
+	// Tokenized shares are issued in whole units, the fractional remainder is
+	// not charged
+	if allowedVault.TokenizeShares {
+		feeShares = feeShares.TruncateDec()
+	}
+
+	if feeShares.IsPositive() {
+		if err := k.mintFeeShares(ctx, allowedVault, vaultRecord, types.NewVaultShare(denom, feeShares)); err != nil {
+			return err
+		}
+		record.AccruedFeeShares = record.AccruedFeeShares.Add(feeShares)
+	}
+
+	k.SetVaultFeeRecord(ctx, record)
+
+	return nil
+}
+
+// ApplyVaultFeeCheckpoints charges the fees of every vault with fees that
+// haven't been charged within the fee checkpoint interval, so fee recipients
+// are paid even when a vault has no deposits or withdrawals.
+func (k *Keeper) ApplyVaultFeeCheckpoints(ctx sdk.Context) {
+	for _, allowedVault := range k.GetAllowedVaults(ctx) {
+		if allowedVault.Fees == nil {
+			continue
+		}
+
+		record, found := k.GetVaultFeeRecord(ctx, allowedVault.Denom)
+		if found && ctx.BlockTime().Before(record.LastAccrualTime.Add(types.VaultFeeCheckpointInterval)) {
+			continue
+		}
+
+		// Strategy errors, such as an unpriced pool, skip the vault until the
+		// next checkpoint instead of halting the chain
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.AccrueVaultFees(cacheCtx, allowedVault.Denom); err != nil {
+			k.Logger(ctx).Error("failed to accrue vault fees", "denom", allowedVault.Denom, "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// GetPendingVaultFeeShares returns the shares that will be minted to the fee
+// recipient when the vault's fees are next charged.
+func (k *Keeper) GetPendingVaultFeeShares(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found || allowedVault.Fees == nil {
+		return sdk.ZeroDec(), nil
+	}
+
+	record, found := k.GetVaultFeeRecord(ctx, denom)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	feeShares, _ := calculateVaultFees(
+		*allowedVault.Fees,
+		record,
+		vaultRecord.TotalShares.Amount,
+		totalValue.Amount,
+		ctx.BlockTime(),
+	)
+
+	if allowedVault.TokenizeShares {
+		feeShares = feeShares.TruncateDec()
+	}
+
+	return feeShares, nil
+}
+
+// mintFeeShares issues vault shares to the fee recipient.
+func (k *Keeper) mintFeeShares(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	vaultRecord types.VaultRecord,
+	shares types.VaultShare,
+) error {
+	recipient := allowedVault.Fees.Recipient
+
+	accCurrentShares := k.getAccountVaultShares(ctx, recipient, shares.Denom)
+
+	isNew := accCurrentShares.IsZero()
+	if !isNew {
+		k.BeforeVaultDepositModified(ctx, shares.Denom, recipient, accCurrentShares)
+	}
+
+	vaultRecord.TotalShares = vaultRecord.TotalShares.Add(shares)
+	k.SetVaultRecord(ctx, vaultRecord)
+
+	if allowedVault.TokenizeShares {
+		if err := k.mintShareCoins(ctx, recipient, shares); err != nil {
+			return err
+		}
+	} else {
+		vaultShareRecord, found := k.GetVaultShareRecord(ctx, recipient)
+		if !found {
+			vaultShareRecord = types.NewVaultShareRecord(recipient, types.NewVaultShares())
+		}
+
+		vaultShareRecord.Shares = vaultShareRecord.Shares.Add(shares)
+		k.SetVaultShareRecord(ctx, vaultShareRecord)
+	}
+
+	if isNew {
+		k.AfterVaultDepositCreated(ctx, shares.Denom, recipient, shares.Amount)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultFee,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, shares.Denom),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// calculateVaultFees returns the shares to mint to the fee recipient for the
+// fees accrued since the last accrual time, and the vault's new high-water
+// mark.
+//
+// The management fee is charged on the vault value for the time elapsed, which
+// is capped at a year per accrual. The performance fee is charged on the share
+// price gain above the high-water mark. Minting s shares gives the recipient a
+// fraction f of the vault value when s = totalShares * f / (1 - f).
+func calculateVaultFees(
+	fees types.VaultFees,
+	record types.VaultFeeRecord,
+	totalShares sdk.Dec,
+	totalValue sdkmath.Int,
+	blockTime time.Time,
+) (sdk.Dec, sdk.Dec) {
+	if !totalShares.IsPositive() || !totalValue.IsPositive() {
+		return sdk.ZeroDec(), record.HighWaterMark
+	}
+
+	sharePrice := sdk.NewDecFromInt(totalValue).Quo(totalShares)
+
+	elapsed := int64(blockTime.Sub(record.LastAccrualTime).Seconds())
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if elapsed > types.SecondsPerYear {
+		elapsed = types.SecondsPerYear
+	}
+	feeFraction := fees.ManagementFee.MulInt64(elapsed).QuoInt64(types.SecondsPerYear)
+
+	if sharePrice.GT(record.HighWaterMark) {
+		gain := sharePrice.Sub(record.HighWaterMark).Quo(sharePrice)
+		feeFraction = feeFraction.Add(fees.PerformanceFee.Mul(gain))
+	}
+
+	highWaterMark := sdk.MaxDec(record.HighWaterMark, sharePrice.Mul(sdk.OneDec().Sub(feeFraction)))
+
+	feeShares := totalShares.Mul(feeFraction).QuoTruncate(sdk.OneDec().Sub(feeFraction))
+
+	return feeShares, highWaterMark
+}
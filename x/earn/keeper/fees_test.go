@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+const feeVaultDenom = "usdx"
+
+type feesTestSuite struct {
+	testutil.Suite
+
+	recipient sdk.AccAddress
+}
+
+func (suite *feesTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+
+	suite.recipient = suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+}
+
+func TestFeesTestSuite(t *testing.T) {
+	suite.Run(t, new(feesTestSuite))
+}
+
+func (suite *feesTestSuite) createVault(managementFee, performanceFee string) {
+	vault := types.NewAllowedVault(feeVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.Fees = types.NewVaultFees(
+		sdk.MustNewDecFromStr(managementFee),
+		sdk.MustNewDecFromStr(performanceFee),
+		suite.recipient,
+	)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+}
+
+func (suite *feesTestSuite) deposit(amount int64, index int) sdk.AccAddress {
+	depositAmount := sdk.NewInt64Coin(feeVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), index)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	return acc.GetAddress()
+}
+
+// setVaultGain scales the value of the vault's hard deposit by the factor
+func (suite *feesTestSuite) setVaultGain(factor string) {
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, feeVaultDenom, sdk.MustNewDecFromStr(factor))
+}
+
+func (suite *feesTestSuite) recipientValueEqual(expected int64) {
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, suite.recipient)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(feeVaultDenom, expected), value)
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_ManagementFee() {
+	suite.createVault("0.1", "0")
+	depositor := suite.deposit(1000, 2)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.SecondsPerYear / 2 * time.Second))
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	// Half of the 10% annual fee is charged on the vault value, rounded down
+	suite.recipientValueEqual(49)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(feeVaultDenom, 1000)))
+
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, depositor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(feeVaultDenom, 950), value)
+
+	// Share price dropped below 1 so the high-water mark is unchanged
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.OneDec(), record.HighWaterMark)
+	suite.Require().Equal(suite.Ctx.BlockTime(), record.LastAccrualTime)
+
+	vaultRecord, found := suite.Keeper.GetVaultRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(vaultRecord.TotalShares.Amount.Sub(sdk.NewDec(1000)), record.AccruedFeeShares)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultFee,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, feeVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyRecipient, suite.recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, record.AccruedFeeShares.String()),
+	))
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_PerformanceFee() {
+	suite.createVault("0", "0.2")
+	suite.deposit(1000, 2)
+
+	// Vault value rises to 1500
+	suite.setVaultGain("1.5")
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	// 20% of the 500 gain
+	suite.recipientValueEqual(100)
+
+	// Share price after fees becomes the new high-water mark
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.4"), record.HighWaterMark)
+
+	// No further fees are charged until the share price passes the high-water mark
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	suite.recipientValueEqual(100)
+
+	pending, err := suite.Keeper.GetPendingVaultFeeShares(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(pending.IsZero())
+}
+
+func (suite *feesTestSuite) TestAccrueVaultFees_NoFees() {
+	suite.CreateVault(feeVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.deposit(1000, 2)
+	suite.setVaultGain("1.5")
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().False(found)
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(feeVaultDenom, sdk.NewDec(1000))))
+}
+
+func (suite *feesTestSuite) TestDeposit_ChargesFeesFirst() {
+	suite.createVault("0", "0.2")
+	suite.deposit(1000, 2)
+	suite.setVaultGain("1.5")
+
+	// Second depositor shares are issued after fees, so they don't pay fees
+	// on gains made before their deposit
+	depositor := suite.deposit(1400, 3)
+
+	suite.recipientValueEqual(100)
+
+	// Deposit value is rounded down when converting shares to assets
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, depositor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(feeVaultDenom, 1399), value)
+}
+
+func (suite *feesTestSuite) TestApplyVaultFeeCheckpoints() {
+	suite.createVault("0.1", "0")
+	suite.deposit(1000, 2)
+
+	// Fees are not charged before the checkpoint interval
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.VaultFeeCheckpointInterval - time.Second))
+	suite.Keeper.ApplyVaultFeeCheckpoints(suite.Ctx)
+
+	_, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, suite.recipient)
+	suite.Require().False(found)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	suite.Keeper.ApplyVaultFeeCheckpoints(suite.Ctx)
+
+	_, found = suite.Keeper.GetVaultAccountShares(suite.Ctx, suite.recipient)
+	suite.Require().True(found)
+
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.Ctx.BlockTime(), record.LastAccrualTime)
+	suite.Require().True(record.AccruedFeeShares.IsPositive())
+}
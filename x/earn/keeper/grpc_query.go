@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -76,6 +78,8 @@ func (s queryServer) Vaults(
 			vaultRecordsErr = fmt.Errorf("vault record not found for vault record denom %s", record.TotalShares.Denom)
 			return true
 		}
+		// Shares keep the form they were issued in while the vault has deposits
+		allowedVault.TokenizeShares = record.TokenizeShares
 
 		totalValue, err := s.keeper.GetVaultTotalValue(sdkCtx, record.TotalShares.Denom)
 		if err != nil {
@@ -89,8 +93,10 @@ func (s queryServer) Vaults(
 			Strategies:        allowedVault.Strategies,
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
+			Manager:           allowedVault.Manager.String(),
 			TotalShares:       record.TotalShares.Amount.String(),
 			TotalValue:        totalValue.Amount,
+			ShareDenom:        vaultShareDenom(allowedVault, record.TotalShares.Denom),
 		})
 
 		// Mark this allowed vault as visited
@@ -121,9 +127,11 @@ func (s queryServer) Vaults(
 			Strategies:        allowedVault.Strategies,
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
+			Manager:           allowedVault.Manager.String(),
 			// No shares, no value
 			TotalShares: sdk.ZeroDec().String(),
 			TotalValue:  sdk.ZeroInt(),
+			ShareDenom:  vaultShareDenom(allowedVault, denom),
 		})
 	}
 
@@ -178,8 +186,10 @@ func (s queryServer) Vault(
 		Strategies:        allowedVault.Strategies,
 		IsPrivateVault:    allowedVault.IsPrivateVault,
 		AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
+		Manager:           allowedVault.Manager.String(),
 		TotalShares:       vaultRecord.TotalShares.Amount.String(),
 		TotalValue:        totalValue.Amount,
+		ShareDenom:        vaultShareDenom(allowedVault, vaultRecord.TotalShares.Denom),
 	}
 
 	return &types.QueryVaultResponse{
@@ -228,6 +238,7 @@ func (s queryServer) getAggregateBkavaVault(
 			Strategies:        allowedVault.Strategies,
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
+			Manager:           allowedVault.Manager.String(),
 			// Empty for shares, as adding up all shares is not useful information
 			TotalShares: "0",
 			TotalValue:  vaultValue.Amount,
@@ -336,6 +347,166 @@ func (s queryServer) TotalSupply(
 	}, vaultRecordErr
 }
 
+// VaultFees implements the gRPC service handler for querying the fees of a
+// vault.
+func (s queryServer) VaultFees(
+	ctx context.Context,
+	req *types.QueryVaultFeesRequest,
+) (*types.QueryVaultFeesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	allowedVault, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	record, found := s.keeper.GetVaultFeeRecord(sdkCtx, req.Denom)
+	if !found {
+		// Fees have not accrued yet, shares are issued at the initial price
+		record = types.NewVaultFeeRecord(req.Denom, sdk.OneDec(), sdkCtx.BlockTime(), sdk.ZeroDec())
+	}
+
+	pendingFeeShares, err := s.keeper.GetPendingVaultFeeShares(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVaultFeesResponse{
+		Fees:             allowedVault.Fees,
+		HighWaterMark:    record.HighWaterMark,
+		LastAccrualTime:  record.LastAccrualTime,
+		AccruedFeeShares: record.AccruedFeeShares,
+		PendingFeeShares: pendingFeeShares,
+	}, nil
+}
+
+// VaultHistory implements the gRPC service handler for querying the share
+// price history of a vault.
+func (s queryServer) VaultHistory(
+	ctx context.Context,
+	req *types.QueryVaultHistoryRequest,
+) (*types.QueryVaultHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.Window <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive")
+	}
+
+	if _, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom); !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	return &types.QueryVaultHistoryResponse{
+		Snapshots: s.keeper.GetVaultSharePriceSnapshots(sdkCtx, req.Denom, req.Window),
+	}, nil
+}
+
+// VaultApy implements the gRPC service handler for querying the realized
+// yield of a vault.
+func (s queryServer) VaultApy(
+	ctx context.Context,
+	req *types.QueryVaultApyRequest,
+) (*types.QueryVaultApyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.Window <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive")
+	}
+
+	if _, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom); !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	apy, start, end, err := s.keeper.GetVaultApy(sdkCtx, req.Denom, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVaultApyResponse{
+		Apy:   apy,
+		Start: start,
+		End:   end,
+	}, nil
+}
+
+// WithdrawalClaims implements the gRPC service handler for querying queued
+// withdrawal claims.
+func (s queryServer) WithdrawalClaims(
+	ctx context.Context,
+	req *types.QueryWithdrawalClaimsRequest,
+) (*types.QueryWithdrawalClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid address")
+		}
+	}
+
+	store := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.WithdrawalClaimKeyPrefix)
+
+	claims := types.WithdrawalClaims{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, shouldAccumulate bool) (bool, error) {
+		var claim types.WithdrawalClaim
+		if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+			return false, err
+		}
+
+		if owner != nil && !claim.Owner.Equals(owner) {
+			return false, nil
+		}
+
+		if req.Denom != "" && claim.Amount.Denom != req.Denom {
+			return false, nil
+		}
+
+		if shouldAccumulate {
+			claims = append(claims, claim)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalClaimsResponse{
+		Claims:     claims,
+		Pagination: pageRes,
+	}, nil
+}
+
 // getOneAccountOneVaultDeposit returns deposits for a specific vault and a specific
 // account
 func (s queryServer) getOneAccountOneVaultDeposit(
@@ -347,7 +518,7 @@ func (s queryServer) getOneAccountOneVaultDeposit(
 		return nil, status.Error(codes.InvalidArgument, "Invalid address")
 	}
 
-	shareRecord, found := s.keeper.GetVaultShareRecord(ctx, depositor)
+	accountShares, found := s.keeper.GetVaultAccountShares(ctx, depositor)
 	if !found {
 		return &types.QueryDepositsResponse{
 			Deposits: []types.DepositResponse{
@@ -393,7 +564,7 @@ func (s queryServer) getOneAccountOneVaultDeposit(
 				Depositor: depositor.String(),
 				// Only respond with requested denom shares
 				Shares: types.NewVaultShares(
-					types.NewVaultShare(req.Denom, shareRecord.Shares.AmountOf(req.Denom)),
+					types.NewVaultShare(req.Denom, accountShares.AmountOf(req.Denom)),
 				),
 				Value: sdk.NewCoins(value),
 			},
@@ -413,7 +584,7 @@ func (s queryServer) getOneAccountBkavaVaultDeposit(
 		return nil, status.Error(codes.InvalidArgument, "Invalid address")
 	}
 
-	shareRecord, found := s.keeper.GetVaultShareRecord(ctx, depositor)
+	accountShares, found := s.keeper.GetVaultAccountShares(ctx, depositor)
 	if !found {
 		return &types.QueryDepositsResponse{
 			Deposits: []types.DepositResponse{
@@ -429,7 +600,7 @@ func (s queryServer) getOneAccountBkavaVaultDeposit(
 	}
 
 	// Get all account deposit values to add up bkava
-	totalAccountValue, err := getAccountTotalValue(ctx, s.keeper, depositor, shareRecord.Shares)
+	totalAccountValue, err := getAccountTotalValue(ctx, s.keeper, depositor, accountShares)
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +625,7 @@ func (s queryServer) getOneAccountBkavaVaultDeposit(
 				Depositor: depositor.String(),
 				// Only respond with requested denom shares
 				Shares: types.NewVaultShares(
-					types.NewVaultShare(req.Denom, shareRecord.Shares.AmountOf(req.Denom)),
+					types.NewVaultShare(req.Denom, accountShares.AmountOf(req.Denom)),
 				),
 				Value: sdk.NewCoins(stakedValue),
 			},
@@ -475,7 +646,7 @@ func (s queryServer) getOneAccountAllDeposits(
 
 	deposits := []types.DepositResponse{}
 
-	accountShare, found := s.keeper.GetVaultShareRecord(ctx, depositor)
+	accountShares, found := s.keeper.GetVaultAccountShares(ctx, depositor)
 	if !found {
 		return &types.QueryDepositsResponse{
 			Deposits:   []types.DepositResponse{},
@@ -483,7 +654,7 @@ func (s queryServer) getOneAccountAllDeposits(
 		}, nil
 	}
 
-	value, err := getAccountTotalValue(ctx, s.keeper, depositor, accountShare.Shares)
+	value, err := getAccountTotalValue(ctx, s.keeper, depositor, accountShares)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -510,7 +681,7 @@ func (s queryServer) getOneAccountAllDeposits(
 		}
 
 		var filteredShares types.VaultShares
-		for _, share := range accountShare.Shares {
+		for _, share := range accountShares {
 			// Remove non-bkava coins from shares as they are used to
 			// determine which value is mapped to which denom
 			// These should be in the same order as valueInStakedTokens
@@ -522,12 +693,12 @@ func (s queryServer) getOneAccountAllDeposits(
 		}
 
 		value = valueInStakedTokens
-		accountShare.Shares = filteredShares
+		accountShares = filteredShares
 	}
 
 	deposits = append(deposits, types.DepositResponse{
 		Depositor: depositor.String(),
-		Shares:    accountShare.Shares,
+		Shares:    accountShares,
 		Value:     value,
 	})
 
@@ -567,3 +738,14 @@ func addressSliceToStringSlice(addresses []sdk.AccAddress) []string {
 
 	return strings
 }
+
+// vaultShareDenom returns the share denom of a vault if its shares are
+// tokenized, or an empty string otherwise.
+func vaultShareDenom(allowedVault types.AllowedVault, denom string) string {
+	// The aggregate bkava vault has no shares of its own
+	if !allowedVault.TokenizeShares || denom == bkavaDenom {
+		return ""
+	}
+
+	return types.ShareDenom(denom)
+}
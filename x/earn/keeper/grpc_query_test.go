@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -830,6 +831,132 @@ func (suite *grpcQueryTestSuite) TestTotalSupply() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestTokenizedVault() {
+	vault := types.NewAllowedVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.TokenizeShares = true
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	depositAmount := sdk.NewInt64Coin("usdx", 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Vault(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultRequest("usdx"))
+	suite.Require().NoError(err)
+	suite.Require().Equal("erc/earn-usdx-share", res.Vault.ShareDenom)
+
+	// Deposits include shares held as share coins
+	depositsRes, err := suite.queryClient.Deposits(
+		sdk.WrapSDKContext(suite.Ctx),
+		&types.QueryDepositsRequest{
+			Depositor: acc.GetAddress().String(),
+			Denom:     "usdx",
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		[]types.DepositResponse{
+			{
+				Depositor: acc.GetAddress().String(),
+				Shares:    types.NewVaultShares(types.NewVaultShare("usdx", sdk.NewDec(1000))),
+				Value:     sdk.NewCoins(depositAmount),
+			},
+		},
+		depositsRes.Deposits,
+	)
+}
+
+func (suite *grpcQueryTestSuite) TestVaultFees() {
+	recipient := suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+	fees := types.NewVaultFees(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.2"), recipient)
+
+	vault := types.NewAllowedVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.Fees = fees
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	depositAmount := sdk.NewInt64Coin("usdx", 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// Vault value rises to 1500, so a 100 fee is pending
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, "usdx", sdk.MustNewDecFromStr("1.5"))
+
+	res, err := suite.queryClient.VaultFees(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultFeesRequest("usdx"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(fees, res.Fees)
+	suite.Require().Equal(sdk.OneDec(), res.HighWaterMark)
+	suite.Require().Equal(suite.Ctx.BlockTime(), res.LastAccrualTime)
+	suite.Require().True(res.AccruedFeeShares.IsZero())
+	suite.Require().True(res.PendingFeeShares.IsPositive())
+
+	err = suite.Keeper.AccrueVaultFees(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.VaultFees(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultFeesRequest("usdx"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.4"), res.HighWaterMark)
+	suite.Require().True(res.AccruedFeeShares.IsPositive())
+	suite.Require().True(res.PendingFeeShares.IsZero())
+}
+
+func (suite *grpcQueryTestSuite) TestVaultFees_NotFound() {
+	_, err := suite.queryClient.VaultFees(sdk.WrapSDKContext(suite.Ctx), types.NewQueryVaultFeesRequest("usdx"))
+	suite.Require().Error(err)
+	suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault not found with specified denom"))
+}
+
+func (suite *grpcQueryTestSuite) TestVaultHistoryAndApy() {
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin("usdx", 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+	start := suite.Ctx.BlockTime()
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(types.SecondsPerYear / 10 * time.Second))
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, "usdx", sdk.MustNewDecFromStr("1.02"))
+
+	// Query with the updated block time
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.Keeper))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	historyRes, err := suite.queryClient.VaultHistory(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryVaultHistoryRequest("usdx", 60*24*time.Hour),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		types.VaultSharePriceSnapshots{types.NewVaultSharePriceSnapshot("usdx", sdk.OneDec(), start)},
+		historyRes.Snapshots,
+	)
+
+	apyRes, err := suite.queryClient.VaultApy(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryVaultApyRequest("usdx", 60*24*time.Hour),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.2"), apyRes.Apy)
+	suite.Require().Equal(start, apyRes.Start.Time)
+	suite.Require().Equal(suite.Ctx.BlockTime(), apyRes.End.Time)
+
+	_, err = suite.queryClient.VaultApy(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryVaultApyRequest("usdx", 0),
+	)
+	suite.Require().ErrorIs(err, status.Errorf(codes.InvalidArgument, "window must be positive"))
+
+	_, err = suite.queryClient.VaultHistory(
+		sdk.WrapSDKContext(suite.Ctx),
+		types.NewQueryVaultHistoryRequest("busd", time.Hour),
+	)
+	suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault not found with specified denom"))
+}
+
 // createUnbondedValidator creates an unbonded validator with the given amount of self-delegation.
 func (suite *grpcQueryTestSuite) createUnbondedValidator(address sdk.ValAddress, selfDelegation sdk.Coin, minSelfDelegation sdkmath.Int) error {
 	msg, err := stakingtypes.NewMsgCreateValidator(
@@ -903,3 +1030,62 @@ func (suite *grpcQueryTestSuite) slashValidator(address sdk.ValAddress, slashFra
 func (suite *grpcQueryTestSuite) bondDenom() string {
 	return suite.App.GetStakingKeeper().BondDenom(suite.Ctx)
 }
+
+func (suite *grpcQueryTestSuite) TestWithdrawalClaims() {
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.CreateVault("busd", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	startBalance := sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("busd", 1000))
+	acc1 := suite.CreateAccount(startBalance, 0).GetAddress()
+	acc2 := suite.CreateAccount(startBalance, 1).GetAddress()
+
+	for _, acc := range []sdk.AccAddress{acc1, acc2} {
+		for _, coin := range startBalance {
+			err := suite.Keeper.Deposit(suite.Ctx, acc, coin, types.STRATEGY_TYPE_HARD)
+			suite.Require().NoError(err)
+		}
+	}
+
+	claim1, err := suite.Keeper.RequestWithdraw(suite.Ctx, acc1, sdk.NewInt64Coin("usdx", 100), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	claim2, err := suite.Keeper.RequestWithdraw(suite.Ctx, acc2, sdk.NewInt64Coin("usdx", 200), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	claim3, err := suite.Keeper.RequestWithdraw(suite.Ctx, acc1, sdk.NewInt64Coin("busd", 300), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Run("all", func() {
+		res, err := suite.queryClient.WithdrawalClaims(
+			context.Background(),
+			types.NewQueryWithdrawalClaimsRequest("", "", nil),
+		)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.WithdrawalClaims{claim1, claim2, claim3}, res.Claims)
+	})
+
+	suite.Run("owner", func() {
+		res, err := suite.queryClient.WithdrawalClaims(
+			context.Background(),
+			types.NewQueryWithdrawalClaimsRequest(acc1.String(), "", nil),
+		)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.WithdrawalClaims{claim1, claim3}, res.Claims)
+	})
+
+	suite.Run("owner and denom", func() {
+		res, err := suite.queryClient.WithdrawalClaims(
+			context.Background(),
+			types.NewQueryWithdrawalClaimsRequest(acc1.String(), "usdx", nil),
+		)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.WithdrawalClaims{claim1}, res.Claims)
+	})
+
+	suite.Run("invalid owner", func() {
+		_, err := suite.queryClient.WithdrawalClaims(
+			context.Background(),
+			types.NewQueryWithdrawalClaimsRequest("invalid", "", nil),
+		)
+		suite.Require().Error(err)
+		suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -537,3 +537,51 @@ func (suite *hookTestSuite) TestHooks_HookOrdering() {
 	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
 	suite.Require().NoError(err)
 }
+
+func (suite *hookTestSuite) TestHooks_DepositForAndWithdrawTo() {
+	suite.Keeper.ClearHooks()
+	earnHooks := mocks.NewEarnHooks(suite.T())
+	suite.Keeper.SetHooks(earnHooks)
+
+	vaultDenom := "usdx"
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	sender := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 1000)), 0)
+	beneficiary := suite.CreateAccount(sdk.NewCoins(), 1)
+
+	// hooks are called for the beneficiary that owns the shares
+	earnHooks.On(
+		"AfterVaultDepositCreated",
+		suite.Ctx,
+		vaultDenom,
+		beneficiary.GetAddress(),
+		sdk.NewDecFromInt(depositAmount.Amount),
+	).Once()
+	err := suite.Keeper.DepositFor(
+		suite.Ctx,
+		sender.GetAddress(),
+		beneficiary.GetAddress(),
+		depositAmount,
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().NoError(err)
+
+	// withdrawing to another account calls hooks for the share owner
+	earnHooks.On(
+		"BeforeVaultDepositModified",
+		suite.Ctx,
+		vaultDenom,
+		beneficiary.GetAddress(),
+		sdk.NewDecFromInt(depositAmount.Amount),
+	).Once()
+	_, err = suite.Keeper.WithdrawTo(
+		suite.Ctx,
+		beneficiary.GetAddress(),
+		sender.GetAddress(),
+		depositAmount,
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().NoError(err)
+}
@@ -79,9 +79,10 @@ func VaultSharesInvariant(k Keeper) sdk.Invariant {
 		totalShares := make(map[string]vaultShares)
 
 		k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+			// Tokenized shares are owned through bank balances
 			totalShares[record.TotalShares.Denom] = vaultShares{
 				totalShares:      record.TotalShares,
-				totalSharesOwned: types.NewVaultShare(record.TotalShares.Denom, sdk.ZeroDec()),
+				totalSharesOwned: types.NewVaultShare(record.TotalShares.Denom, k.GetVaultShareSupply(ctx, record.TotalShares.Denom)),
 			}
 
 			return false
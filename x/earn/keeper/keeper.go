@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -19,8 +21,11 @@ type Keeper struct {
 	liquidKeeper  types.LiquidKeeper
 
 	// Keepers used for strategies
-	hardKeeper    types.HardKeeper
-	savingsKeeper types.SavingsKeeper
+	hardKeeper      types.HardKeeper
+	savingsKeeper   types.SavingsKeeper
+	swapKeeper      types.SwapKeeper
+	pricefeedKeeper types.PricefeedKeeper
+	incentiveKeeper types.IncentiveKeeper
 
 	// Keeper for community pool transfers
 	distKeeper types.DistributionKeeper
@@ -36,6 +41,8 @@ func NewKeeper(
 	liquidKeeper types.LiquidKeeper,
 	hardKeeper types.HardKeeper,
 	savingsKeeper types.SavingsKeeper,
+	swapKeeper types.SwapKeeper,
+	pricefeedKeeper types.PricefeedKeeper,
 	distKeeper types.DistributionKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
@@ -43,18 +50,27 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		liquidKeeper:  liquidKeeper,
-		hardKeeper:    hardKeeper,
-		savingsKeeper: savingsKeeper,
-		distKeeper:    distKeeper,
+		key:             key,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		liquidKeeper:    liquidKeeper,
+		hardKeeper:      hardKeeper,
+		savingsKeeper:   savingsKeeper,
+		swapKeeper:      swapKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		distKeeper:      distKeeper,
 	}
 }
 
+// SetIncentiveKeeper sets the incentive keeper used to claim the swap LP
+// strategy's rewards. It is set after creation as the incentive keeper depends
+// on the earn keeper.
+func (k *Keeper) SetIncentiveKeeper(incentiveKeeper types.IncentiveKeeper) {
+	k.incentiveKeeper = incentiveKeeper
+}
+
 // SetHooks adds hooks to the keeper.
 func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 	if k.hooks != nil {
@@ -68,3 +84,8 @@ func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 func (k *Keeper) ClearHooks() {
 	k.hooks = nil
 }
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
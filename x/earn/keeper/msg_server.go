@@ -29,7 +29,12 @@ func (m msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	if err := m.keeper.Deposit(ctx, depositor, msg.Amount, msg.Strategy); err != nil {
+	beneficiary, err := getBeneficiary(depositor, msg.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.DepositFor(ctx, depositor, beneficiary, msg.Amount, msg.Strategy); err != nil {
 		return nil, err
 	}
 
@@ -53,7 +58,12 @@ func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	_, err = m.keeper.Withdraw(ctx, from, msg.Amount, msg.Strategy)
+	recipient, err := getBeneficiary(from, msg.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.keeper.WithdrawTo(ctx, from, recipient, msg.Amount, msg.Strategy)
 	if err != nil {
 		return nil, err
 	}
@@ -68,3 +78,172 @@ func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+// RebalanceVault handles MsgRebalanceVault messages
+func (m msgServer) RebalanceVault(goCtx context.Context, msg *types.MsgRebalanceVault) (*types.MsgRebalanceVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RebalanceVault(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, signer.String()),
+		),
+	)
+
+	return &types.MsgRebalanceVaultResponse{}, nil
+}
+
+// RequestWithdraw handles MsgRequestWithdraw messages
+func (m msgServer) RequestWithdraw(goCtx context.Context, msg *types.MsgRequestWithdraw) (*types.MsgRequestWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := m.keeper.RequestWithdraw(ctx, from, msg.Amount, msg.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgRequestWithdrawResponse{ClaimID: claim.ID}, nil
+}
+
+// ClaimWithdraw handles MsgClaimWithdraw messages
+func (m msgServer) ClaimWithdraw(goCtx context.Context, msg *types.MsgClaimWithdraw) (*types.MsgClaimWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.keeper.ClaimWithdraw(ctx, owner, msg.ClaimID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgClaimWithdrawResponse{}, nil
+}
+
+// AddAllowedDepositor handles MsgAddAllowedDepositor messages
+func (m msgServer) AddAllowedDepositor(goCtx context.Context, msg *types.MsgAddAllowedDepositor) (*types.MsgAddAllowedDepositorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.AddAllowedDepositor(ctx, manager, msg.Denom, depositor); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, manager.String()),
+		),
+	)
+
+	return &types.MsgAddAllowedDepositorResponse{}, nil
+}
+
+// RemoveAllowedDepositor handles MsgRemoveAllowedDepositor messages
+func (m msgServer) RemoveAllowedDepositor(goCtx context.Context, msg *types.MsgRemoveAllowedDepositor) (*types.MsgRemoveAllowedDepositorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RemoveAllowedDepositor(ctx, manager, msg.Denom, depositor); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, manager.String()),
+		),
+	)
+
+	return &types.MsgRemoveAllowedDepositorResponse{}, nil
+}
+
+// TransferVaultManager handles MsgTransferVaultManager messages
+func (m msgServer) TransferVaultManager(goCtx context.Context, msg *types.MsgTransferVaultManager) (*types.MsgTransferVaultManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	newManager, err := sdk.AccAddressFromBech32(msg.NewManager)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.TransferVaultManager(ctx, manager, msg.Denom, newManager); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, manager.String()),
+		),
+	)
+
+	return &types.MsgTransferVaultManagerResponse{}, nil
+}
+
+// getBeneficiary returns the optional beneficiary of a message, defaulting to
+// the signer
+func getBeneficiary(signer sdk.AccAddress, beneficiary string) (sdk.AccAddress, error) {
+	if beneficiary == "" {
+		return signer, nil
+	}
+	return sdk.AccAddressFromBech32(beneficiary)
+}
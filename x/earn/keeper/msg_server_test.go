@@ -122,6 +122,7 @@ func (suite *msgServerTestSuite) TestWithdraw() {
 			types.EventTypeVaultWithdraw,
 			sdk.NewAttribute(types.AttributeKeyVaultDenom, depositAmount.Denom),
 			sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, acc.GetAddress().String()),
 			sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDecFromInt(depositAmount.Amount).String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, depositAmount.Amount.String()),
 		),
@@ -137,3 +138,136 @@ func (suite *msgServerTestSuite) TestWithdraw() {
 		),
 	)
 }
+
+func (suite *msgServerTestSuite) TestDepositAndWithdraw_Beneficiary() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	amount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	payer := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	owner := suite.CreateAccount(sdk.NewCoins(), 1)
+	recipient := suite.CreateAccount(sdk.NewCoins(), 2)
+
+	depositMsg := types.NewMsgDeposit(payer.GetAddress().String(), amount, types.STRATEGY_TYPE_HARD)
+	depositMsg.Beneficiary = owner.GetAddress().String()
+	_, err := suite.msgServer.Deposit(sdk.WrapSDKContext(suite.Ctx), depositMsg)
+	suite.Require().NoError(err)
+
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, owner.GetAddress())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecFromInt(amount.Amount), shares.AmountOf(vaultDenom))
+
+	withdrawMsg := types.NewMsgWithdraw(owner.GetAddress().String(), amount, types.STRATEGY_TYPE_HARD)
+	withdrawMsg.Beneficiary = recipient.GetAddress().String()
+	_, err = suite.msgServer.Withdraw(sdk.WrapSDKContext(suite.Ctx), withdrawMsg)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(payer.GetAddress(), sdk.NewCoins(startBalance.Sub(amount)))
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins())
+	suite.AccountBalanceEqual(recipient.GetAddress(), sdk.NewCoins(amount))
+}
+
+func (suite *msgServerTestSuite) TestRebalanceVault() {
+	vaultDenom := "usdx"
+	suite.CreateWeightedVault(
+		vaultDenom,
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+		[]sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5")},
+	)
+
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// Any account can rebalance a vault
+	signer := suite.CreateAccount(sdk.NewCoins(), 1)
+	msg := types.NewMsgRebalanceVault(signer.GetAddress().String(), vaultDenom)
+	_, err = suite.msgServer.RebalanceVault(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 50)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 50)))
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, signer.GetAddress().String()),
+		),
+	)
+}
+
+func (suite *msgServerTestSuite) TestRequestAndClaimWithdraw() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	msgRequest := types.NewMsgRequestWithdraw(acc.GetAddress().String(), depositAmount, types.STRATEGY_TYPE_HARD)
+	res, err := suite.msgServer.RequestWithdraw(sdk.WrapSDKContext(suite.Ctx), msgRequest)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.ClaimID)
+
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+
+	msgClaim := types.NewMsgClaimWithdraw(acc.GetAddress().String(), res.ClaimID)
+	_, err = suite.msgServer.ClaimWithdraw(sdk.WrapSDKContext(suite.Ctx), msgClaim)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(depositAmount))
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, acc.GetAddress().String()),
+		),
+	)
+}
+
+func (suite *msgServerTestSuite) TestManageAllowedDepositors() {
+	vaultDenom := "usdx"
+	manager := suite.CreateAccount(sdk.NewCoins(), 0).GetAddress()
+	depositor := suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+	newManager := suite.CreateAccount(sdk.NewCoins(), 2).GetAddress()
+
+	vault := types.NewAllowedVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, true, []sdk.AccAddress{manager})
+	vault.Manager = manager
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	msgAdd := types.NewMsgAddAllowedDepositor(manager.String(), vaultDenom, depositor.String())
+	_, err := suite.msgServer.AddAllowedDepositor(sdk.WrapSDKContext(suite.Ctx), msgAdd)
+	suite.Require().NoError(err)
+
+	msgRemove := types.NewMsgRemoveAllowedDepositor(manager.String(), vaultDenom, manager.String())
+	_, err = suite.msgServer.RemoveAllowedDepositor(sdk.WrapSDKContext(suite.Ctx), msgRemove)
+	suite.Require().NoError(err)
+
+	msgTransfer := types.NewMsgTransferVaultManager(manager.String(), vaultDenom, newManager.String())
+	_, err = suite.msgServer.TransferVaultManager(sdk.WrapSDKContext(suite.Ctx), msgTransfer)
+	suite.Require().NoError(err)
+
+	allowedVault, found := suite.Keeper.GetAllowedVault(suite.Ctx, vaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal([]sdk.AccAddress{depositor}, allowedVault.AllowedDepositors)
+	suite.Require().Equal(newManager, allowedVault.Manager)
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, manager.String()),
+		),
+	)
+}
@@ -50,13 +50,26 @@ func (k Keeper) getAllowedVaultRaw(
 // given denom. If the denom starts with "bkava-" where it will return the
 // "bkava" AllowedVault. Otherwise, it will return the exact match for the
 // corresponding AllowedVault denom.
+//
+// While the vault has deposits, TokenizeShares is the value the vault had when
+// its record was created, rather than the current params.
 func (k *Keeper) GetAllowedVault(
 	ctx sdk.Context,
 	vaultDenom string,
 ) (types.AllowedVault, bool) {
+	allowedDenom := vaultDenom
 	if strings.HasPrefix(vaultDenom, bkavaPrefix) {
-		return k.getAllowedVaultRaw(ctx, bkavaDenom)
+		allowedDenom = bkavaDenom
 	}
 
-	return k.getAllowedVaultRaw(ctx, vaultDenom)
+	allowedVault, found := k.getAllowedVaultRaw(ctx, allowedDenom)
+	if !found {
+		return types.AllowedVault{}, false
+	}
+
+	if vaultRecord, found := k.GetVaultRecord(ctx, vaultDenom); found {
+		allowedVault.TokenizeShares = vaultRecord.TokenizeShares
+	}
+
+	return allowedVault, true
 }
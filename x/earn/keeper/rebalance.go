@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// strategyAllocation is the value a vault holds in one of its strategies and
+// the strategy's target weight.
+type strategyAllocation struct {
+	strategy Strategy
+	value    sdkmath.Int
+	weight   sdk.Dec
+}
+
+// excess returns how much the allocation's value is above its target share of
+// the total vault value. It is negative if the strategy is underweight.
+func (a strategyAllocation) excess(total sdkmath.Int) sdkmath.Int {
+	return a.value.Sub(a.weight.MulInt(total).TruncateInt())
+}
+
+// getStrategyAllocations returns the vault's allocation to each of its
+// strategies and the total vault value.
+func (k *Keeper) getStrategyAllocations(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	denom string,
+) ([]strategyAllocation, sdkmath.Int, error) {
+	weights := allowedVault.TargetWeights()
+	allocations := make([]strategyAllocation, len(allowedVault.Strategies))
+	total := sdk.ZeroInt()
+
+	for i, strategyType := range allowedVault.Strategies {
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return nil, sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidVaultStrategy, err.Error())
+		}
+
+		// Denom can be different from allowedVault.Denom for bkava
+		value, err := strategy.GetEstimatedTotalAssets(ctx, denom)
+		if err != nil {
+			return nil, sdkmath.Int{}, err
+		}
+
+		allocations[i] = strategyAllocation{
+			strategy: strategy,
+			value:    value.Amount,
+			weight:   weights[i],
+		}
+		total = total.Add(value.Amount)
+	}
+
+	return allocations, total, nil
+}
+
+// depositToStrategies deposits the amount to the vault's most underweight
+// strategy, once the amount is added to the vault value.
+func (k *Keeper) depositToStrategies(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	amount sdk.Coin,
+) error {
+	allocations, total, err := k.getStrategyAllocations(ctx, allowedVault, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	newTotal := total.Add(amount.Amount)
+	target := allocations[0]
+	for _, allocation := range allocations[1:] {
+		if allocation.excess(newTotal).LT(target.excess(newTotal)) {
+			target = allocation
+		}
+	}
+
+	return target.strategy.Deposit(ctx, amount)
+}
+
+// withdrawFromStrategies withdraws the amount from the vault's most overweight
+// strategies, once the amount is removed from the vault value. Strategies are
+// withdrawn from in turn if the most overweight holds less than the amount, or
+// can't pay it.
+func (k *Keeper) withdrawFromStrategies(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	amount sdk.Coin,
+) error {
+	allocations, total, err := k.getStrategyAllocations(ctx, allowedVault, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	newTotal := sdkmath.MaxInt(total.Sub(amount.Amount), sdk.ZeroInt())
+	remaining := amount.Amount
+	failed := make([]bool, len(allocations))
+	var strategyErr error
+	for remaining.IsPositive() {
+		target := -1
+		for i, allocation := range allocations {
+			if failed[i] || !allocation.value.IsPositive() {
+				continue
+			}
+			if target == -1 || allocation.excess(newTotal).GT(allocations[target].excess(newTotal)) {
+				target = i
+			}
+		}
+		if target == -1 {
+			if strategyErr != nil {
+				return errorsmod.Wrapf(types.ErrInsufficientValue, "vault strategies can't pay %s: %s", amount, strategyErr)
+			}
+			return errorsmod.Wrapf(types.ErrInsufficientValue, "vault strategies hold less than %s", amount)
+		}
+
+		withdrawAmount := sdkmath.MinInt(remaining, allocations[target].value)
+		if err := k.withdrawFromStrategy(ctx, allocations[target].strategy, sdk.NewCoin(amount.Denom, withdrawAmount)); err != nil {
+			// Other strategies may still pay, such as when the swap LP
+			// strategy hits its slippage limit
+			failed[target] = true
+			strategyErr = err
+			continue
+		}
+
+		allocations[target].value = allocations[target].value.Sub(withdrawAmount)
+		remaining = remaining.Sub(withdrawAmount)
+	}
+
+	return nil
+}
+
+// withdrawFromStrategy withdraws the amount from a strategy, discarding any
+// state changes if it fails.
+func (k *Keeper) withdrawFromStrategy(ctx sdk.Context, strategy Strategy, amount sdk.Coin) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := strategy.Withdraw(cacheCtx, amount); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// RebalanceVault moves a vault's funds between its strategies back to their
+// target weights. It returns an error unless the share of the vault value in
+// a strategy has drifted from its target weight by more than the rebalance
+// threshold.
+func (k *Keeper) RebalanceVault(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	// Compound rewards and charge fees before the vault value is measured, as
+	// deposits and withdrawals do
+	k.compoundVaultRewards(ctx, allowedVault)
+	if err := k.AccrueVaultFees(ctx, denom); err != nil {
+		return err
+	}
+
+	allocations, total, err := k.getStrategyAllocations(ctx, allowedVault, denom)
+	if err != nil {
+		return err
+	}
+
+	if !total.IsPositive() {
+		return errorsmod.Wrapf(types.ErrVaultBalanced, "vault %s is empty", denom)
+	}
+
+	threshold := k.GetParams(ctx).RebalanceThreshold
+	maxDrift := sdk.ZeroDec()
+	for _, allocation := range allocations {
+		drift := sdk.NewDecFromInt(allocation.value).QuoInt(total).Sub(allocation.weight).Abs()
+		maxDrift = sdk.MaxDec(maxDrift, drift)
+	}
+
+	var underweight []strategyAllocation
+	for _, allocation := range allocations {
+		if allocation.excess(total).IsNegative() {
+			underweight = append(underweight, allocation)
+		}
+	}
+
+	if maxDrift.LTE(threshold) || len(underweight) == 0 {
+		return errorsmod.Wrapf(types.ErrVaultBalanced, "drift %s <= threshold %s", maxDrift, threshold)
+	}
+
+	// Withdraw the excess from overweight strategies to the module account,
+	// then deposit it to the underweight strategies. Strategies that can't pay
+	// their excess are left overweight.
+	moved := sdk.ZeroInt()
+	var strategyErr error
+	for _, allocation := range allocations {
+		excess := allocation.excess(total)
+		if !excess.IsPositive() {
+			continue
+		}
+
+		if err := k.withdrawFromStrategy(ctx, allocation.strategy, sdk.NewCoin(denom, excess)); err != nil {
+			strategyErr = err
+			continue
+		}
+		moved = moved.Add(excess)
+	}
+
+	if !moved.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "overweight strategies can't pay their excess: %s", strategyErr)
+	}
+
+	remaining := moved
+	for i, allocation := range underweight {
+		// The last strategy also receives any excess left by rounding the
+		// target values down.
+		depositAmount := sdkmath.MinInt(allocation.excess(total).Neg(), remaining)
+		if i == len(underweight)-1 {
+			depositAmount = remaining
+		}
+		if !depositAmount.IsPositive() {
+			continue
+		}
+
+		if err := allocation.strategy.Deposit(ctx, sdk.NewCoin(denom, depositAmount)); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(depositAmount)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, moved.String()),
+		),
+	)
+
+	return nil
+}
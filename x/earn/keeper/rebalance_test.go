@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const weightedVaultDenom = "usdx"
+
+type rebalanceTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *rebalanceTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestRebalanceTestSuite(t *testing.T) {
+	suite.Run(t, new(rebalanceTestSuite))
+}
+
+func (suite *rebalanceTestSuite) createVault(hardWeight, savingsWeight string) {
+	suite.CreateWeightedVault(
+		weightedVaultDenom,
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+		[]sdk.Dec{sdk.MustNewDecFromStr(hardWeight), sdk.MustNewDecFromStr(savingsWeight)},
+	)
+}
+
+func (suite *rebalanceTestSuite) TestDeposit_MostUnderweight() {
+	suite.createVault("0.7", "0.3")
+
+	depositAmount := sdk.NewInt64Coin(weightedVaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount)), 0)
+
+	// hard is 70 short of its target, savings 30
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(depositAmount))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins())
+
+	// hard is 40 short of its target, savings 60
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(depositAmount))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(depositAmount))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(weightedVaultDenom, 200)))
+}
+
+func (suite *rebalanceTestSuite) TestDeposit_StrategyNotInVault() {
+	suite.createVault("0.7", "0.3")
+
+	depositAmount := sdk.NewInt64Coin(weightedVaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultStrategy)
+}
+
+func (suite *rebalanceTestSuite) TestWithdraw_MostOverweight() {
+	suite.createVault("0.7", "0.3")
+
+	depositAmount := sdk.NewInt64Coin(weightedVaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount)), 0)
+
+	// Deposits go to hard, then savings
+	for i := 0; i < 2; i++ {
+		err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+		suite.Require().NoError(err)
+	}
+
+	// savings is 55 over its target, hard 5 short
+	_, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(weightedVaultDenom, 50), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(depositAmount))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(weightedVaultDenom, 50)))
+
+	// hard is 79 over its target and is emptied before the rest is withdrawn
+	// from savings
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(weightedVaultDenom, 120), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins())
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(weightedVaultDenom, 30)))
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(weightedVaultDenom, 170)))
+}
+
+func (suite *rebalanceTestSuite) TestRebalanceVault() {
+	suite.createVault("0.5", "0.5")
+
+	depositAmount := sdk.NewInt64Coin(weightedVaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.HardDepositAmountEqual(sdk.NewCoins(depositAmount))
+
+	err = suite.Keeper.RebalanceVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().NoError(err)
+
+	half := sdk.NewInt64Coin(weightedVaultDenom, 50)
+	suite.HardDepositAmountEqual(sdk.NewCoins(half))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(half))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, weightedVaultDenom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, half.Amount.String()),
+		),
+	)
+
+	// A balanced vault can't be rebalanced again
+	err = suite.Keeper.RebalanceVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().ErrorIs(err, types.ErrVaultBalanced)
+}
+
+func (suite *rebalanceTestSuite) TestRebalanceVault_AccruesFees() {
+	suite.createVault("0.5", "0.5")
+
+	recipient := suite.CreateAccount(sdk.NewCoins(), 9).GetAddress()
+	vault, found := suite.Keeper.GetAllowedVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().True(found)
+	vault.Fees = types.NewVaultFees(sdk.MustNewDecFromStr("0.1"), sdk.ZeroDec(), recipient)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	depositAmount := sdk.NewInt64Coin(weightedVaultDenom, 1000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.SecondsPerYear / 2 * time.Second))
+
+	// Fees are charged on the vault value before funds are moved
+	err = suite.Keeper.RebalanceVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, weightedVaultDenom)
+	suite.Require().True(found)
+	suite.Require().True(record.AccruedFeeShares.IsPositive())
+	suite.Require().Equal(suite.Ctx.BlockTime(), record.LastAccrualTime)
+}
+
+func (suite *rebalanceTestSuite) TestRebalanceVault_WithinThreshold() {
+	suite.createVault("0.5", "0.5")
+
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(weightedVaultDenom, 1000)), 0)
+
+	// 520 in hard and 480 in savings drifts 0.02 from the targets
+	for _, amount := range []int64{480, 480, 40} {
+		err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(weightedVaultDenom, amount), types.STRATEGY_TYPE_HARD)
+		suite.Require().NoError(err)
+	}
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(weightedVaultDenom, 520)))
+
+	err := suite.Keeper.RebalanceVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().ErrorIs(err, types.ErrVaultBalanced)
+}
+
+func (suite *rebalanceTestSuite) TestRebalanceVault_Invalid() {
+	err := suite.Keeper.RebalanceVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	suite.createVault("0.5", "0.5")
+
+	err = suite.Keeper.RebalanceVault(suite.Ctx, weightedVaultDenom)
+	suite.Require().ErrorIs(err, types.ErrVaultBalanced)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// ShareBankKeeper wraps the bank keeper to run the earn hooks when tokenized
+// vault shares are transferred, so the incentive claims of the sender and
+// receiver stay in step with the share coins they hold.
+type ShareBankKeeper struct {
+	bankkeeper.Keeper
+
+	earnKeeper *Keeper
+}
+
+var _ bankkeeper.Keeper = &ShareBankKeeper{}
+
+// NewShareBankKeeper returns a new ShareBankKeeper wrapping a bank keeper.
+func NewShareBankKeeper(bankKeeper bankkeeper.Keeper) *ShareBankKeeper {
+	return &ShareBankKeeper{
+		Keeper: bankKeeper,
+	}
+}
+
+// SetEarnKeeper sets the earn keeper used to look up tokenized vaults and run
+// the earn hooks. It is set after creation as the earn keeper depends on the
+// bank keeper.
+func (k *ShareBankKeeper) SetEarnKeeper(earnKeeper *Keeper) {
+	k.earnKeeper = earnKeeper
+}
+
+// SendCoins transfers coins between accounts, syncing share coin holders.
+func (k *ShareBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.transferShareCoins(ctx, amt, []sdk.AccAddress{fromAddr, toAddr}, func() error {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// InputOutputCoins performs a multi-send, syncing share coin holders.
+func (k *ShareBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var amt sdk.Coins
+	var accs []sdk.AccAddress
+	for _, input := range inputs {
+		amt = amt.Add(input.Coins...)
+		accs = append(accs, sdk.MustAccAddressFromBech32(input.Address))
+	}
+	for _, output := range outputs {
+		accs = append(accs, sdk.MustAccAddressFromBech32(output.Address))
+	}
+
+	return k.transferShareCoins(ctx, amt, accs, func() error {
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	})
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account to an
+// account, syncing share coin holders.
+func (k *ShareBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	accs := []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), recipientAddr}
+	return k.transferShareCoins(ctx, amt, accs, func() error {
+		return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module
+// account, syncing share coin holders.
+func (k *ShareBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	accs := []sdk.AccAddress{senderAddr, authtypes.NewModuleAddress(recipientModule)}
+	return k.transferShareCoins(ctx, amt, accs, func() error {
+		return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+// SendCoinsFromModuleToModule transfers coins between module accounts, syncing
+// share coin holders.
+func (k *ShareBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context,
+	senderModule string,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	accs := []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule)}
+	return k.transferShareCoins(ctx, amt, accs, func() error {
+		return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+// transferShareCoins runs send, which moves amt between the accounts. If amt
+// includes tokenized vault shares, each account's incentive claim is synced
+// with the shares it held before the transfer, and started for accounts that
+// hold shares after it.
+func (k *ShareBankKeeper) transferShareCoins(
+	ctx sdk.Context,
+	amt sdk.Coins,
+	accs []sdk.AccAddress,
+	send func() error,
+) error {
+	if k.earnKeeper == nil {
+		return send()
+	}
+
+	var vaultDenoms []string
+	for _, coin := range amt {
+		vaultDenom, ok := types.ParseShareDenom(coin.Denom)
+		if ok && k.earnKeeper.isVaultTokenized(ctx, vaultDenom) {
+			vaultDenoms = append(vaultDenoms, vaultDenom)
+		}
+	}
+	if len(vaultDenoms) == 0 {
+		return send()
+	}
+
+	for _, vaultDenom := range vaultDenoms {
+		for _, acc := range accs {
+			shares := k.earnKeeper.getAccountVaultShares(ctx, acc, vaultDenom)
+			k.earnKeeper.BeforeVaultDepositModified(ctx, vaultDenom, acc, shares)
+		}
+	}
+
+	if err := send(); err != nil {
+		return err
+	}
+
+	for _, vaultDenom := range vaultDenoms {
+		for _, acc := range accs {
+			shares := k.earnKeeper.getAccountVaultShares(ctx, acc, vaultDenom)
+			if shares.IsPositive() {
+				k.earnKeeper.AfterVaultDepositCreated(ctx, vaultDenom, acc, shares)
+			}
+		}
+	}
+
+	return nil
+}
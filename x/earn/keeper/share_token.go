@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// isVaultTokenized returns true if the shares of the vault with the denom are
+// held as bank coins of the vault share denom.
+func (k *Keeper) isVaultTokenized(ctx sdk.Context, denom string) bool {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	return found && allowedVault.TokenizeShares
+}
+
+// getAccountVaultShares returns the shares of a single vault owned by an
+// account, whether held in its VaultShareRecord or as share coins.
+func (k *Keeper) getAccountVaultShares(ctx sdk.Context, acc sdk.AccAddress, denom string) sdk.Dec {
+	if k.isVaultTokenized(ctx, denom) {
+		balance := k.bankKeeper.GetBalance(ctx, acc, types.ShareDenom(denom))
+		return sdk.NewDecFromInt(balance.Amount)
+	}
+
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, acc)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	return vaultShareRecord.Shares.AmountOf(denom)
+}
+
+// getAccountShareCoins returns the tokenized vault shares held by an account
+// in bank.
+func (k *Keeper) getAccountShareCoins(ctx sdk.Context, acc sdk.AccAddress) types.VaultShares {
+	shares := types.NewVaultShares()
+
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, acc) {
+		vaultDenom, ok := types.ParseShareDenom(coin.Denom)
+		if !ok || !k.isVaultTokenized(ctx, vaultDenom) {
+			continue
+		}
+
+		shares = shares.Add(types.NewVaultShare(vaultDenom, sdk.NewDecFromInt(coin.Amount)))
+	}
+
+	return shares
+}
+
+// mintShareCoins issues vault shares to an account as share coins.
+func (k *Keeper) mintShareCoins(ctx sdk.Context, acc sdk.AccAddress, shares types.VaultShare) error {
+	coins := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(shares.Denom), shares.Amount.TruncateInt()))
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, acc, coins)
+}
+
+// burnShareCoins redeems share coins held by an account.
+func (k *Keeper) burnShareCoins(ctx sdk.Context, acc sdk.AccAddress, shares types.VaultShare) error {
+	coins := sdk.NewCoins(sdk.NewCoin(types.ShareDenom(shares.Denom), shares.Amount.TruncateInt()))
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// GetVaultShareSupply returns the total tokenized shares of a vault held in
+// bank.
+func (k *Keeper) GetVaultShareSupply(ctx sdk.Context, denom string) sdk.Dec {
+	if !k.isVaultTokenized(ctx, denom) {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(k.bankKeeper.GetSupply(ctx, types.ShareDenom(denom)).Amount)
+}
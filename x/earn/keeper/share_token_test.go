@@ -0,0 +1,273 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/keeper"
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+)
+
+const tokenizedVaultDenom = "usdx"
+
+type shareTokenTestSuite struct {
+	testutil.Suite
+
+	shareDenom string
+}
+
+func (suite *shareTokenTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	vault := types.NewAllowedVault(tokenizedVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.TokenizeShares = true
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+	suite.shareDenom = types.ShareDenom(tokenizedVaultDenom)
+}
+
+func TestShareTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(shareTokenTestSuite))
+}
+
+func (suite *shareTokenTestSuite) deposit(amount int64, index int) sdk.AccAddress {
+	depositAmount := sdk.NewInt64Coin(tokenizedVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), index)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	return acc.GetAddress()
+}
+
+func (suite *shareTokenTestSuite) accountSharesEqual(acc sdk.AccAddress, expected sdk.Dec) {
+	shares, _ := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc)
+	suite.Require().Equal(expected, shares.AmountOf(tokenizedVaultDenom))
+}
+
+func (suite *shareTokenTestSuite) invariantHolds() {
+	message, broken := keeper.VaultSharesInvariant(suite.Keeper)(suite.Ctx)
+	suite.Require().False(broken, message)
+}
+
+func (suite *shareTokenTestSuite) TestDeposit_MintsShareCoins() {
+	depositor := suite.deposit(1000, 0)
+
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins(sdk.NewInt64Coin(suite.shareDenom, 1000)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(tokenizedVaultDenom, sdk.NewDec(1000))))
+	suite.accountSharesEqual(depositor, sdk.NewDec(1000))
+
+	// Shares are held in bank instead of the share record
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, depositor)
+	suite.Require().False(found)
+
+	suite.Require().Equal(sdk.NewDec(1000), suite.Keeper.GetVaultShareSupply(suite.Ctx, tokenizedVaultDenom))
+	suite.invariantHolds()
+}
+
+func (suite *shareTokenTestSuite) TestDeposit_TruncatesShares() {
+	suite.deposit(1000, 0)
+
+	// Share price of 1.1 issues 90.9 shares for 100 tokens
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, tokenizedVaultDenom, sdk.MustNewDecFromStr("1.1"))
+	depositor := suite.deposit(100, 1)
+
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins(sdk.NewInt64Coin(suite.shareDenom, 90)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(tokenizedVaultDenom, sdk.NewDec(1090))))
+	suite.invariantHolds()
+
+	// Deposits worth less than a share are rejected
+	amount := sdk.NewInt64Coin(tokenizedVaultDenom, 1)
+	acc := suite.CreateAccount(sdk.NewCoins(amount), 2)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), amount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrInsufficientAmount)
+}
+
+func (suite *shareTokenTestSuite) TestWithdraw_BurnsShareCoins() {
+	depositor := suite.deposit(1000, 0)
+
+	withdrawn, err := suite.Keeper.Withdraw(
+		suite.Ctx,
+		depositor,
+		sdk.NewInt64Coin(tokenizedVaultDenom, 400),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(tokenizedVaultDenom, 400), withdrawn)
+
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins(
+		sdk.NewInt64Coin(tokenizedVaultDenom, 400),
+		sdk.NewInt64Coin(suite.shareDenom, 600),
+	))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(tokenizedVaultDenom, sdk.NewDec(600))))
+	suite.invariantHolds()
+}
+
+func (suite *shareTokenTestSuite) TestWithdraw_RoundsSharesUp() {
+	depositor := suite.deposit(1000, 0)
+
+	// Share price of 1.1 needs 90.9 shares for 100 tokens
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, tokenizedVaultDenom, sdk.MustNewDecFromStr("1.1"))
+
+	_, err := suite.Keeper.Withdraw(
+		suite.Ctx,
+		depositor,
+		sdk.NewInt64Coin(tokenizedVaultDenom, 100),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins(
+		sdk.NewInt64Coin(tokenizedVaultDenom, 100),
+		sdk.NewInt64Coin(suite.shareDenom, 909),
+	))
+	suite.invariantHolds()
+}
+
+func (suite *shareTokenTestSuite) setTokenizeShares(tokenizeShares bool) {
+	vault := types.NewAllowedVault(tokenizedVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.TokenizeShares = tokenizeShares
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+}
+
+func (suite *shareTokenTestSuite) TestTokenizeSharesChange_WaitsForEmptyVault() {
+	depositor := suite.deposit(1000, 0)
+
+	// Shares stay tokenized while the vault has deposits
+	suite.setTokenizeShares(false)
+
+	other := suite.deposit(500, 1)
+	suite.AccountBalanceEqual(other, sdk.NewCoins(sdk.NewInt64Coin(suite.shareDenom, 500)))
+	suite.accountSharesEqual(depositor, sdk.NewDec(1000))
+	suite.invariantHolds()
+
+	for _, acc := range []sdk.AccAddress{depositor, other} {
+		shares, _ := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc)
+		_, err := suite.Keeper.Withdraw(
+			suite.Ctx,
+			acc,
+			sdk.NewCoin(tokenizedVaultDenom, shares.AmountOf(tokenizedVaultDenom).TruncateInt()),
+			types.STRATEGY_TYPE_HARD,
+		)
+		suite.Require().NoError(err)
+	}
+
+	// Once the vault is empty, new deposits use the current params
+	depositor = suite.deposit(1000, 2)
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins())
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, depositor)
+	suite.Require().True(found)
+
+	// Untokenized shares aren't stranded by turning tokenization on
+	suite.setTokenizeShares(true)
+
+	suite.accountSharesEqual(depositor, sdk.NewDec(1000))
+	other = suite.deposit(500, 3)
+	suite.AccountBalanceEqual(other, sdk.NewCoins())
+	suite.invariantHolds()
+}
+
+func (suite *shareTokenTestSuite) setEarnRewardIndex(index string) {
+	suite.App.GetIncentiveKeeper().SetEarnRewardIndexes(suite.Ctx, tokenizedVaultDenom, incentivetypes.RewardIndexes{
+		incentivetypes.NewRewardIndex("hard", sdk.MustNewDecFromStr(index)),
+	})
+}
+
+func (suite *shareTokenTestSuite) earnRewardEqual(acc sdk.AccAddress, expected sdk.Coins) {
+	claim, found := suite.App.GetIncentiveKeeper().GetSynchronizedEarnClaim(suite.Ctx, acc)
+	suite.Require().True(found)
+	suite.Require().Equal(expected, claim.Reward)
+}
+
+func (suite *shareTokenTestSuite) TestTransfer_SyncsEarnClaims() {
+	suite.setEarnRewardIndex("0.1")
+	depositor := suite.deposit(1000, 0)
+	receiver := suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+
+	// 1 hard per share accrues before the transfer, and another after it
+	suite.setEarnRewardIndex("1.1")
+
+	msgServer := bankkeeper.NewMsgServerImpl(suite.BankKeeper)
+	_, err := msgServer.Send(
+		sdk.WrapSDKContext(suite.Ctx),
+		banktypes.NewMsgSend(depositor, receiver, sdk.NewCoins(sdk.NewInt64Coin(suite.shareDenom, 300))),
+	)
+	suite.Require().NoError(err)
+
+	suite.setEarnRewardIndex("2.1")
+
+	suite.accountSharesEqual(depositor, sdk.NewDec(700))
+	suite.accountSharesEqual(receiver, sdk.NewDec(300))
+	suite.earnRewardEqual(depositor, sdk.NewCoins(sdk.NewInt64Coin("hard", 1000+700)))
+	suite.earnRewardEqual(receiver, sdk.NewCoins(sdk.NewInt64Coin("hard", 300)))
+}
+
+func (suite *shareTokenTestSuite) TestWithdraw_AfterTransfer() {
+	depositor := suite.deposit(1000, 0)
+	receiver := suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+
+	err := suite.BankKeeper.SendCoins(
+		suite.Ctx,
+		depositor,
+		receiver,
+		sdk.NewCoins(sdk.NewInt64Coin(suite.shareDenom, 300)),
+	)
+	suite.Require().NoError(err)
+
+	suite.accountSharesEqual(depositor, sdk.NewDec(700))
+	suite.accountSharesEqual(receiver, sdk.NewDec(300))
+
+	// The depositor can no longer withdraw the transferred shares
+	_, err = suite.Keeper.Withdraw(
+		suite.Ctx,
+		depositor,
+		sdk.NewInt64Coin(tokenizedVaultDenom, 701),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	withdrawn, err := suite.Keeper.Withdraw(
+		suite.Ctx,
+		receiver,
+		sdk.NewInt64Coin(tokenizedVaultDenom, 300),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(tokenizedVaultDenom, 300), withdrawn)
+
+	suite.AccountBalanceEqual(receiver, sdk.NewCoins(sdk.NewInt64Coin(tokenizedVaultDenom, 300)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(tokenizedVaultDenom, sdk.NewDec(700))))
+	suite.invariantHolds()
+}
+
+func (suite *shareTokenTestSuite) TestAccrueVaultFees_MintsShareCoins() {
+	recipient := suite.CreateAccount(sdk.NewCoins(), 9).GetAddress()
+
+	vault, found := suite.Keeper.GetAllowedVault(suite.Ctx, tokenizedVaultDenom)
+	suite.Require().True(found)
+	vault.Fees = types.NewVaultFees(sdk.MustNewDecFromStr("0.1"), sdk.ZeroDec(), recipient)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	suite.deposit(1000, 0)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.SecondsPerYear / 2 * time.Second))
+
+	err := suite.Keeper.AccrueVaultFees(suite.Ctx, tokenizedVaultDenom)
+	suite.Require().NoError(err)
+
+	// 5% of the vault is 52.6 shares, only whole shares are minted
+	suite.AccountBalanceEqual(recipient, sdk.NewCoins(sdk.NewInt64Coin(suite.shareDenom, 52)))
+
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, tokenizedVaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(52), record.AccruedFeeShares)
+	suite.invariantHolds()
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// VaultSharePriceSnapshot -- vault share price history
+
+// SetVaultSharePriceSnapshot stores a share price snapshot in its slot of the
+// vault's ring buffer, replacing the snapshot that previously occupied the
+// slot.
+func (k *Keeper) SetVaultSharePriceSnapshot(ctx sdk.Context, snapshot types.VaultSharePriceSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultSnapshotKeyPrefix)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.VaultSnapshotKey(snapshot.Denom, types.VaultSnapshotSlot(snapshot.Time)), bz)
+}
+
+// IterateVaultSharePriceSnapshots iterates over the stored share price
+// snapshots of a vault and performs a callback function.
+func (k Keeper) IterateVaultSharePriceSnapshots(
+	ctx sdk.Context,
+	denom string,
+	cb func(snapshot types.VaultSharePriceSnapshot) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultSnapshotKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.VaultSnapshotsKey(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VaultSharePriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllVaultSharePriceSnapshots returns all share price snapshots from the
+// store.
+func (k Keeper) GetAllVaultSharePriceSnapshots(ctx sdk.Context) types.VaultSharePriceSnapshots {
+	var snapshots types.VaultSharePriceSnapshots
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultSnapshotKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VaultSharePriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// GetVaultSharePriceSnapshots returns the share price snapshots of a vault
+// recorded within the window ending at the current block time, sorted from
+// oldest to newest.
+func (k Keeper) GetVaultSharePriceSnapshots(
+	ctx sdk.Context,
+	denom string,
+	window time.Duration,
+) types.VaultSharePriceSnapshots {
+	// Slots are only overwritten when a new snapshot is recorded, so they can
+	// hold snapshots older than the retention period
+	if window > types.VaultSnapshotRetention {
+		window = types.VaultSnapshotRetention
+	}
+	cutoff := ctx.BlockTime().Add(-window)
+
+	snapshots := types.VaultSharePriceSnapshots{}
+	k.IterateVaultSharePriceSnapshots(ctx, denom, func(snapshot types.VaultSharePriceSnapshot) bool {
+		if !snapshot.Time.Before(cutoff) {
+			snapshots = append(snapshots, snapshot)
+		}
+		return false
+	})
+	snapshots.SortByTime()
+
+	return snapshots
+}
+
+// GetVaultSharePrice returns the current value of a single share of a vault.
+func (k *Keeper) GetVaultSharePrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		return sdk.Dec{}, types.ErrVaultRecordNotFound
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.NewDecFromInt(totalValue.Amount).Quo(vaultRecord.TotalShares.Amount), nil
+}
+
+// GetVaultApy returns the realized annual yield of a vault between the oldest
+// share price snapshot within the window and the current share price.
+func (k *Keeper) GetVaultApy(
+	ctx sdk.Context,
+	denom string,
+	window time.Duration,
+) (sdk.Dec, types.VaultSharePriceSnapshot, types.VaultSharePriceSnapshot, error) {
+	snapshots := k.GetVaultSharePriceSnapshots(ctx, denom, window)
+	if len(snapshots) == 0 || !snapshots[0].Time.Before(ctx.BlockTime()) {
+		return sdk.Dec{}, types.VaultSharePriceSnapshot{}, types.VaultSharePriceSnapshot{},
+			errorsmod.Wrapf(types.ErrNoVaultSnapshot, "%s within %s", denom, window)
+	}
+
+	sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, types.VaultSharePriceSnapshot{}, types.VaultSharePriceSnapshot{}, err
+	}
+
+	start := snapshots[0]
+	end := types.NewVaultSharePriceSnapshot(denom, sharePrice, ctx.BlockTime())
+
+	apy, err := types.Apy(start, end)
+	if err != nil {
+		return sdk.Dec{}, types.VaultSharePriceSnapshot{}, types.VaultSharePriceSnapshot{}, err
+	}
+
+	return apy, start, end, nil
+}
+
+// RecordVaultSharePriceSnapshots stores the current share price of every vault
+// with deposits if no snapshot has been recorded yet in the current snapshot
+// period.
+func (k *Keeper) RecordVaultSharePriceSnapshots(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultSnapshotKeyPrefix)
+	slot := types.VaultSnapshotSlot(ctx.BlockTime())
+	period := types.VaultSnapshotPeriod(ctx.BlockTime())
+
+	for _, vaultRecord := range k.GetAllVaultRecords(ctx) {
+		denom := vaultRecord.TotalShares.Denom
+
+		if bz := store.Get(types.VaultSnapshotKey(denom, slot)); bz != nil {
+			var existing types.VaultSharePriceSnapshot
+			k.cdc.MustUnmarshal(bz, &existing)
+			if types.VaultSnapshotPeriod(existing.Time) == period {
+				continue
+			}
+		}
+
+		// Vaults that can't be valued, such as with an unpriced pool, are
+		// skipped until the next block
+		sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to record vault share price", "denom", denom, "err", err)
+			continue
+		}
+		if !sharePrice.IsPositive() {
+			continue
+		}
+
+		k.SetVaultSharePriceSnapshot(ctx, types.NewVaultSharePriceSnapshot(denom, sharePrice, ctx.BlockTime()))
+	}
+}
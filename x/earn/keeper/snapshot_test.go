@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+const snapshotVaultDenom = "usdx"
+
+type snapshotTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *snapshotTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+
+	suite.CreateVault(snapshotVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+}
+
+func TestSnapshotTestSuite(t *testing.T) {
+	suite.Run(t, new(snapshotTestSuite))
+}
+
+func (suite *snapshotTestSuite) deposit(amount int64) {
+	depositAmount := sdk.NewInt64Coin(snapshotVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+}
+
+// setSharePrice sets the vault value per share by scaling its hard deposit
+func (suite *snapshotTestSuite) setSharePrice(price string) {
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, snapshotVaultDenom, sdk.MustNewDecFromStr(price))
+}
+
+func (suite *snapshotTestSuite) advanceTime(d time.Duration) {
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(d))
+}
+
+func (suite *snapshotTestSuite) TestRecordVaultSharePriceSnapshots() {
+	// Vaults without deposits have no share price
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+	suite.Require().Empty(suite.Keeper.GetAllVaultSharePriceSnapshots(suite.Ctx))
+
+	suite.deposit(1000)
+	start := suite.Ctx.BlockTime()
+
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+
+	// Only one snapshot is recorded per period
+	suite.setSharePrice("1.1")
+	suite.advanceTime(time.Second)
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+
+	suite.Require().Equal(
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot(snapshotVaultDenom, sdk.OneDec(), start),
+		},
+		suite.Keeper.GetVaultSharePriceSnapshots(suite.Ctx, snapshotVaultDenom, time.Hour),
+	)
+
+	suite.advanceTime(types.VaultSnapshotGranularity)
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+
+	suite.Require().Equal(
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot(snapshotVaultDenom, sdk.OneDec(), start),
+			types.NewVaultSharePriceSnapshot(snapshotVaultDenom, sdk.MustNewDecFromStr("1.1"), suite.Ctx.BlockTime()),
+		},
+		suite.Keeper.GetVaultSharePriceSnapshots(suite.Ctx, snapshotVaultDenom, 24*time.Hour),
+	)
+}
+
+func (suite *snapshotTestSuite) TestGetVaultSharePriceSnapshots_Retention() {
+	suite.deposit(1000)
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+
+	// Record a snapshot in every period until the first slot is reused
+	for i := 0; i < int(types.VaultSnapshotRetention/types.VaultSnapshotGranularity); i++ {
+		suite.advanceTime(types.VaultSnapshotGranularity)
+		suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+	}
+
+	snapshots := suite.Keeper.GetVaultSharePriceSnapshots(suite.Ctx, snapshotVaultDenom, 2*types.VaultSnapshotRetention)
+	suite.Require().Len(snapshots, int(types.VaultSnapshotRetention/types.VaultSnapshotGranularity))
+	suite.Require().Len(suite.Keeper.GetAllVaultSharePriceSnapshots(suite.Ctx), len(snapshots))
+	suite.Require().Equal(suite.Ctx.BlockTime(), snapshots[len(snapshots)-1].Time)
+}
+
+func (suite *snapshotTestSuite) TestGetVaultApy() {
+	suite.deposit(1000)
+	suite.Keeper.RecordVaultSharePriceSnapshots(suite.Ctx)
+	start := suite.Ctx.BlockTime()
+
+	_, _, _, err := suite.Keeper.GetVaultApy(suite.Ctx, snapshotVaultDenom, 30*24*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrNoVaultSnapshot)
+
+	// 1% over 36.5 days is 10% a year
+	suite.advanceTime(types.SecondsPerYear / 10 * time.Second)
+	suite.setSharePrice("1.01")
+
+	apy, startSnapshot, endSnapshot, err := suite.Keeper.GetVaultApy(suite.Ctx, snapshotVaultDenom, 60*24*time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.1"), apy)
+	suite.Require().Equal(types.NewVaultSharePriceSnapshot(snapshotVaultDenom, sdk.OneDec(), start), startSnapshot)
+	suite.Require().Equal(types.NewVaultSharePriceSnapshot(snapshotVaultDenom, sdk.MustNewDecFromStr("1.01"), suite.Ctx.BlockTime()), endSnapshot)
+
+	// Snapshots outside the window are excluded
+	_, _, _, err = suite.Keeper.GetVaultApy(suite.Ctx, snapshotVaultDenom, 30*24*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrNoVaultSnapshot)
+}
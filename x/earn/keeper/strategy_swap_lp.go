@@ -0,0 +1,408 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// maxUnwindIterations is the maximum number of times the shares needed for a
+// withdraw are re-estimated.
+const maxUnwindIterations = 5
+
+// SwapLPStrategy defines the strategy that provides liquidity to a pool in
+// x/swap. Deposits swap part of the vault denom for the vault's swap pair
+// denom and deposit both, and withdraws swap the paired asset back.
+type SwapLPStrategy Keeper
+
+var _ Strategy = (*SwapLPStrategy)(nil)
+
+// GetStrategyType returns the strategy type
+func (s *SwapLPStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_SWAP_LP
+}
+
+// GetEstimatedTotalAssets returns the amount of the vault denom received by
+// removing all of the module account's liquidity from the vault's swap pool
+// and swapping the paired asset back, including the swap fee and price impact.
+// The pool reserves are taken at the oracle price with the same product, so
+// moving the pool price doesn't change the value. The pool's ShareValue isn't
+// used as it takes the reserves at the pool price and excludes the exit swap.
+func (s *SwapLPStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	vault, found := (*Keeper)(s).GetAllowedVault(ctx, denom)
+	if !found || vault.SwapPairDenom == "" {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	record, shares, found := s.getPosition(ctx, vault)
+	if !found {
+		// Return 0 if the module account has no shares in the pool
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	price, err := s.getOraclePrice(ctx, vault.Denom, vault.SwapPairDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	reserves := reservesAtPrice(record, vault, price)
+	_, received, err := simulateUnwind(reserves, record.TotalShares, shares, vault, s.swapKeeper.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, received), nil
+}
+
+// Deposit swaps part of the amount for the paired asset and provides both as
+// liquidity to the vault's swap pool.
+func (s *SwapLPStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	vault, found := (*Keeper)(s).GetAllowedVault(ctx, amount.Denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	if err := s.checkPoolPrice(ctx, vault); err != nil {
+		return err
+	}
+
+	return s.provideLiquidity(ctx, vault, amount)
+}
+
+// Withdraw removes enough liquidity from the vault's swap pool to receive the
+// amount once the paired asset is swapped back. Any rounding excess is left in
+// the module account.
+func (s *SwapLPStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	vault, found := (*Keeper)(s).GetAllowedVault(ctx, amount.Denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	if err := s.checkPoolPrice(ctx, vault); err != nil {
+		return err
+	}
+
+	record, ownedShares, found := s.getPosition(ctx, vault)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "no liquidity in swap pool %s", vault.SwapPoolID())
+	}
+
+	totalValue, err := s.GetEstimatedTotalAssets(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if amount.Amount.GT(totalValue.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "%s < %s", totalValue, amount)
+	}
+
+	price, err := s.getOraclePrice(ctx, vault.SwapPairDenom, vault.Denom)
+	if err != nil {
+		return err
+	}
+
+	// Start with the share of the position worth the amount, which is
+	// increased if the smaller withdraw is worth less than its share.
+	fee := s.swapKeeper.GetSwapFee(ctx)
+	shares := ceilMulQuo(ownedShares, amount.Amount, totalValue.Amount)
+	var withdrawn sdk.Coins
+	for i := 0; i < maxUnwindIterations; i++ {
+		if shares.GT(ownedShares) {
+			shares = ownedShares
+		}
+
+		var received sdkmath.Int
+		withdrawn, received, err = simulateUnwind(record.Reserves(), record.TotalShares, shares, vault, fee)
+		if err != nil {
+			return err
+		}
+		if received.GTE(amount.Amount) {
+			break
+		}
+		if shares.Equal(ownedShares) || i == maxUnwindIterations-1 {
+			return errorsmod.Wrapf(types.ErrInsufficientValue, "swap pool liquidity is worth less than %s", amount)
+		}
+
+		shares = ceilMulQuo(shares, amount.Amount, received)
+	}
+	if withdrawn.AmountOf(vault.Denom).IsZero() || withdrawn.AmountOf(vault.SwapPairDenom).IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "%s is too small to remove liquidity", amount)
+	}
+	expectedOutput := sdk.NewCoin(vault.Denom, price.MulInt(withdrawn.AmountOf(vault.SwapPairDenom)).TruncateInt())
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := s.swapKeeper.Withdraw(
+		ctx,
+		macc.GetAddress(),
+		shares,
+		sdk.NewCoin(vault.Denom, withdrawn.AmountOf(vault.Denom)),
+		sdk.NewCoin(vault.SwapPairDenom, withdrawn.AmountOf(vault.SwapPairDenom)),
+	); err != nil {
+		return err
+	}
+
+	_, err = s.swapKeeper.SwapExactForTokensFromModule(
+		ctx,
+		types.ModuleName,
+		sdk.NewCoin(vault.SwapPairDenom, withdrawn.AmountOf(vault.SwapPairDenom)),
+		expectedOutput,
+		vault.SwapSlippageLimit,
+	)
+	return err
+}
+
+// provideLiquidity swaps part of the amount for the vault's swap pair denom
+// and deposits both to the vault's swap pool. The amount swapped leaves the
+// remainder in the same ratio as the pool reserves after the swap, so at most
+// rounding dust is left in the module account.
+func (s *SwapLPStrategy) provideLiquidity(ctx sdk.Context, vault types.AllowedVault, amount sdk.Coin) error {
+	record, found := s.swapKeeper.GetPool(ctx, vault.SwapPoolID())
+	if !found {
+		return errorsmod.Wrapf(types.ErrSwapPoolNotFound, "pool %s", vault.SwapPoolID())
+	}
+
+	pool, err := swaptypes.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	if err != nil {
+		return err
+	}
+
+	price, err := s.getOraclePrice(ctx, amount.Denom, vault.SwapPairDenom)
+	if err != nil {
+		return err
+	}
+
+	swapInput := sdk.NewCoin(
+		amount.Denom,
+		zapSwapAmount(amount.Amount, pool.Reserves().AmountOf(amount.Denom), s.swapKeeper.GetSwapFee(ctx)),
+	)
+	expectedOutput := sdk.NewCoin(vault.SwapPairDenom, price.MulInt(swapInput.Amount).TruncateInt())
+	if !expectedOutput.IsPositive() || swapInput.Amount.GTE(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "%s is too small to provide liquidity", amount)
+	}
+
+	pairAmount, err := s.swapKeeper.SwapExactForTokensFromModule(ctx, types.ModuleName, swapInput, expectedOutput, vault.SwapSlippageLimit)
+	if err != nil {
+		return err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	return s.swapKeeper.Deposit(ctx, macc.GetAddress(), amount.Sub(swapInput), pairAmount, vault.SwapSlippageLimit)
+}
+
+// compoundSwapRewards claims the rewards the module account has earned in the
+// vault's swap pool, swaps them for the vault denom and provides them as
+// liquidity to the pool. Rewards are left unclaimed unless all of them can be
+// compounded, which includes rewards with a vesting lockup as the module
+// account can't hold locked coins.
+func (s *SwapLPStrategy) compoundSwapRewards(ctx sdk.Context, vault types.AllowedVault) {
+	if s.incentiveKeeper == nil {
+		return
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	cacheCtx, write := ctx.CacheContext()
+	rewards, err := s.incentiveKeeper.ClaimSwapRewardForPool(cacheCtx, macc.GetAddress(), macc.GetAddress(), vault.SwapPoolID())
+	if err != nil {
+		return
+	}
+
+	total := sdk.ZeroInt()
+	for _, reward := range rewards {
+		if reward.Denom == vault.Denom {
+			total = total.Add(reward.Amount)
+			continue
+		}
+
+		price, err := s.getOraclePrice(cacheCtx, reward.Denom, vault.Denom)
+		if err != nil {
+			return
+		}
+		expectedOutput := sdk.NewCoin(vault.Denom, price.MulInt(reward.Amount).TruncateInt())
+		if !expectedOutput.IsPositive() {
+			return
+		}
+
+		proceeds, err := s.swapKeeper.SwapExactForTokensFromModule(cacheCtx, types.ModuleName, reward, expectedOutput, vault.SwapSlippageLimit)
+		if err != nil {
+			return
+		}
+		total = total.Add(proceeds.Amount)
+	}
+
+	if err := s.provideLiquidity(cacheCtx, vault, sdk.NewCoin(vault.Denom, total)); err != nil {
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultCompound,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, vault.Denom),
+			sdk.NewAttribute(types.AttributeKeyRewards, rewards.String()),
+		),
+	)
+}
+
+// hasSwapCosts returns true if the vault has the swap LP strategy, which pays
+// swap fees and price impact on deposits and withdraws.
+func hasSwapCosts(allowedVault types.AllowedVault) bool {
+	return allowedVault.IsStrategyAllowed(types.STRATEGY_TYPE_SWAP_LP)
+}
+
+// compoundVaultRewards compounds the swap rewards earned by a vault with the
+// swap LP strategy, so they are included in the vault value before its shares
+// are priced.
+func (k *Keeper) compoundVaultRewards(ctx sdk.Context, allowedVault types.AllowedVault) {
+	if !hasSwapCosts(allowedVault) {
+		return
+	}
+
+	(*SwapLPStrategy)(k).compoundSwapRewards(ctx, allowedVault)
+}
+
+// getPosition returns the vault's swap pool and the module account's shares
+// in it.
+func (s *SwapLPStrategy) getPosition(ctx sdk.Context, vault types.AllowedVault) (swaptypes.PoolRecord, sdkmath.Int, bool) {
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	shares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), vault.SwapPoolID())
+	if !found || !shares.IsPositive() {
+		return swaptypes.PoolRecord{}, sdkmath.Int{}, false
+	}
+
+	record, found := s.swapKeeper.GetPool(ctx, vault.SwapPoolID())
+	if !found {
+		return swaptypes.PoolRecord{}, sdkmath.Int{}, false
+	}
+
+	return record, shares, true
+}
+
+// getOraclePrice returns the amount of the quote denom worth one unit of the
+// base denom, from the pricefeed prices of the denoms' hard money markets.
+func (s *SwapLPStrategy) getOraclePrice(ctx sdk.Context, baseDenom, quoteDenom string) (sdk.Dec, error) {
+	basePrice, err := s.getOracleUnitPrice(ctx, baseDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	quotePrice, err := s.getOracleUnitPrice(ctx, quoteDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return basePrice.Quo(quotePrice), nil
+}
+
+// getOracleUnitPrice returns the USD price of one unit of the denom.
+func (s *SwapLPStrategy) getOracleUnitPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	moneyMarket, found := s.hardKeeper.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrPriceNotFound, "no hard money market for %s", denom)
+	}
+
+	price, err := s.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrPriceNotFound, "%s: %s", moneyMarket.SpotMarketID, err)
+	}
+	if !price.Price.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrPriceNotFound, "%s has no positive price", moneyMarket.SpotMarketID)
+	}
+
+	return price.Price.QuoInt(moneyMarket.ConversionFactor), nil
+}
+
+// checkPoolPrice returns an error if the price of the vault's swap pool
+// deviates from the oracle price by more than the vault's SwapMaxPriceDeviation.
+func (s *SwapLPStrategy) checkPoolPrice(ctx sdk.Context, vault types.AllowedVault) error {
+	record, found := s.swapKeeper.GetPool(ctx, vault.SwapPoolID())
+	if !found {
+		return errorsmod.Wrapf(types.ErrSwapPoolNotFound, "pool %s", vault.SwapPoolID())
+	}
+
+	price, err := s.getOraclePrice(ctx, vault.Denom, vault.SwapPairDenom)
+	if err != nil {
+		return err
+	}
+
+	reserves := record.Reserves()
+	poolPrice := sdk.NewDecFromInt(reserves.AmountOf(vault.SwapPairDenom)).QuoInt(reserves.AmountOf(vault.Denom))
+	deviation := poolPrice.Quo(price).Sub(sdk.OneDec()).Abs()
+	if deviation.GT(vault.SwapMaxPriceDeviation) {
+		return errorsmod.Wrapf(
+			types.ErrSwapPoolPriceDeviation,
+			"pool %s price %s, oracle price %s", vault.SwapPoolID(), poolPrice, price,
+		)
+	}
+
+	return nil
+}
+
+// reservesAtPrice returns the reserves the swap pool would have at the price,
+// the amount of the swap pair denom worth one unit of the vault denom, with
+// the same product of reserves. These can't be changed by swaps, which only
+// increase the product through fees.
+func reservesAtPrice(record swaptypes.PoolRecord, vault types.AllowedVault, price sdk.Dec) sdk.Coins {
+	reserves := record.Reserves()
+	product := reserves.AmountOf(vault.Denom).Mul(reserves.AmountOf(vault.SwapPairDenom))
+
+	// price is scaled by 10^18 in its big.Int form, so product * 10^18 is
+	// divided by it to give product / price.
+	scaledProduct := new(big.Int).Mul(product.BigInt(), sdk.OneDec().BigInt())
+	vaultReserve := sdkmath.NewIntFromBigInt(new(big.Int).Sqrt(new(big.Int).Quo(scaledProduct, price.BigInt())))
+	if !vaultReserve.IsPositive() {
+		return reserves
+	}
+
+	return sdk.NewCoins(
+		sdk.NewCoin(vault.Denom, vaultReserve),
+		sdk.NewCoin(vault.SwapPairDenom, product.Quo(vaultReserve)),
+	)
+}
+
+// simulateUnwind returns the coins withdrawn for the shares from a pool with
+// the reserves and total shares, and the total amount of the vault denom
+// received once the withdrawn paired asset is swapped back.
+func simulateUnwind(
+	reserves sdk.Coins,
+	totalShares sdkmath.Int,
+	shares sdkmath.Int,
+	vault types.AllowedVault,
+	fee sdk.Dec,
+) (sdk.Coins, sdkmath.Int, error) {
+	pool, err := swaptypes.NewDenominatedPoolWithExistingShares(reserves, totalShares)
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+
+	withdrawn := pool.RemoveLiquidity(shares)
+	output := sdk.NewCoin(vault.Denom, sdk.ZeroInt())
+	if pairWithdrawn := withdrawn.AmountOf(vault.SwapPairDenom); pairWithdrawn.IsPositive() {
+		output, _ = pool.SwapWithExactInput(sdk.NewCoin(vault.SwapPairDenom, pairWithdrawn), fee)
+	}
+
+	return withdrawn, withdrawn.AmountOf(vault.Denom).Add(output.Amount), nil
+}
+
+// zapSwapAmount returns the amount of a single asset deposit that must be
+// swapped so the rest is in the ratio of the pool reserves after the swap.
+// This is a little under half the deposit, solving
+// (1-f)s^2 + (2-f)Rs - AR = 0 for the swap amount s, with deposit A, reserves
+// of the deposited asset R, and swap fee f.
+func zapSwapAmount(amount, reserves sdkmath.Int, fee sdk.Dec) sdkmath.Int {
+	oneMinusFee := sdk.OneDec().Sub(fee)
+	b := sdk.NewDec(2).Sub(fee).MulInt(reserves)
+
+	discriminant := b.Mul(b).Add(oneMinusFee.MulInt(amount).MulInt(reserves).MulInt64(4)).TruncateInt()
+	root := sdk.NewDecFromBigInt(new(big.Int).Sqrt(discriminant.BigInt()))
+
+	return root.Sub(b).Quo(oneMinusFee.MulInt64(2)).TruncateInt()
+}
+
+// ceilMulQuo returns a * b / c, rounded up.
+func ceilMulQuo(a, b, c sdkmath.Int) sdkmath.Int {
+	return sdk.NewDecFromInt(a).MulInt(b).QuoInt(c).Ceil().TruncateInt()
+}
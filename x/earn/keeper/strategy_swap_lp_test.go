@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	swapLPVaultDenom = "ukava"
+	swapLPPairDenom  = "usdx"
+)
+
+type strategySwapLPTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *strategySwapLPTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	// The pool is valued with the kava:usd price of 2 and the usdx:usd price
+	// of 1, which matches the pool price
+	suite.HardKeeper.SetMoneyMarket(suite.Ctx, swapLPVaultDenom, hardtypes.NewMoneyMarket(
+		swapLPVaultDenom,
+		hardtypes.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+		"kava:usd",
+		sdkmath.NewInt(1000000),
+		hardtypes.NewInterestRateModel(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.ZeroDec(),
+		sdk.ZeroDec(),
+	))
+
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool(swapLPVaultDenom, swapLPPairDenom)),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+
+	reserves := sdk.NewCoins(
+		sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000_000),
+		sdk.NewInt64Coin(swapLPPairDenom, 2_000_000_000),
+	)
+	provider := suite.CreateAccount(reserves, 10)
+	err := swapKeeper.Deposit(suite.Ctx, provider.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	vault := types.NewAllowedVault(swapLPVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_SWAP_LP}, false, nil)
+	vault.SwapPairDenom = swapLPPairDenom
+	vault.SwapSlippageLimit = sdk.MustNewDecFromStr("0.02")
+	vault.SwapMaxPriceDeviation = sdk.MustNewDecFromStr("0.02")
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+}
+
+func TestStrategySwapLPTestSuite(t *testing.T) {
+	suite.Run(t, new(strategySwapLPTestSuite))
+}
+
+func (suite *strategySwapLPTestSuite) TestGetStrategyType() {
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.STRATEGY_TYPE_SWAP_LP, strategy.GetStrategyType())
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	macc := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, macc, swaptypes.PoolID(swapLPVaultDenom, swapLPPairDenom))
+	suite.Require().True(found)
+	suite.True(shares.IsPositive())
+
+	// Only rounding dust is left in the module account
+	balance := suite.BankKeeper.GetAllBalances(suite.Ctx, macc)
+	suite.True(balance.AmountOf(swapLPVaultDenom).LT(sdkmath.NewInt(10)), "unexpected balance %s", balance)
+	suite.True(balance.AmountOf(swapLPPairDenom).LT(sdkmath.NewInt(10)), "unexpected balance %s", balance)
+
+	// Value is reduced by the swap fees of entering and exiting the pool
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	suite.True(totalValue.Amount.LT(depositAmount.Amount), "unexpected value %s", totalValue)
+	suite.True(totalValue.Amount.GT(sdkmath.NewInt(995_000)), "unexpected value %s", totalValue)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_CompoundsRewardsBeforeIssuingShares() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc1 := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(depositAmount), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	// The module account earns swap rewards in the vault denom worth about
+	// as much as the first deposit
+	rewards := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	suite.accrueSwapRewards(rewards)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	// Rewards earned before the second deposit belong to the first depositor
+	acc1Value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc1.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(acc1Value.Amount.GT(sdkmath.NewInt(1_980_000)), "unexpected value %s", acc1Value)
+
+	acc2Value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc2.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(acc2Value.Amount.LTE(depositAmount.Amount), "unexpected value %s", acc2Value)
+	suite.True(acc2Value.Amount.GT(sdkmath.NewInt(990_000)), "unexpected value %s", acc2Value)
+}
+
+func (suite *strategySwapLPTestSuite) TestDepositWithdraw_PaysOwnSwapCosts() {
+	smallDeposit := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	largeDeposit := sdk.NewInt64Coin(swapLPVaultDenom, 10_000_000)
+	acc1 := suite.CreateAccount(sdk.NewCoins(smallDeposit), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(largeDeposit), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), smallDeposit, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	acc1Value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc1.GetAddress())
+	suite.Require().NoError(err)
+
+	// The large deposit's swap fee and price impact are paid with its shares
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), largeDeposit, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	acc2Value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc2.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(acc2Value.Amount.LT(largeDeposit.Amount), "unexpected value %s", acc2Value)
+
+	valueAfterDeposit, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc1.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(valueAfterDeposit.Amount.GTE(acc1Value.Amount), "unexpected value %s < %s", valueAfterDeposit, acc1Value)
+
+	// The withdraw redeems at least the shares worth the value it removes
+	withdrawAmount := sdk.NewCoin(swapLPVaultDenom, acc2Value.Amount.QuoRaw(2))
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc2.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.True(withdrawAmount.Amount.Sub(withdrawn.Amount).LTE(sdk.OneInt()), "unexpected withdraw %s", withdrawn)
+
+	valueAfterWithdraw, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc1.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(valueAfterWithdraw.Amount.GTE(acc1Value.Amount), "unexpected value %s < %s", valueAfterWithdraw, acc1Value)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_PoolNotFound() {
+	vault := types.NewAllowedVault("busd", types.StrategyTypes{types.STRATEGY_TYPE_SWAP_LP}, false, nil)
+	vault.SwapPairDenom = swapLPPairDenom
+	vault.SwapSlippageLimit = sdk.MustNewDecFromStr("0.02")
+	vault.SwapMaxPriceDeviation = sdk.MustNewDecFromStr("0.02")
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	depositAmount := sdk.NewInt64Coin("busd", 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrSwapPoolNotFound)
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_Partial() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	withdrawAmount := sdk.NewInt64Coin(swapLPVaultDenom, 500_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	valueBefore, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	// Share conversion can truncate the amount withdrawn by a unit
+	suite.True(withdrawAmount.Amount.Sub(withdrawn.Amount).LTE(sdk.OneInt()), "unexpected withdraw %s", withdrawn)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(withdrawn))
+
+	valueAfter, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	// The pool price moved by the deposit's swap differs slightly from the
+	// oracle price the value is measured at
+	remaining := valueBefore.Amount.Sub(withdrawn.Amount)
+	suite.True(valueAfter.Amount.Sub(remaining).Abs().LTE(remaining.QuoRaw(1000)), "unexpected value %s", valueAfter)
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_Full() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	accValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), accValue, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	suite.Equal(accValue, withdrawn)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(accValue))
+
+	_, found := suite.Keeper.GetVaultRecord(suite.Ctx, swapLPVaultDenom)
+	suite.False(found, "vault record should be deleted once empty")
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_MoreThanValue() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+}
+
+func (suite *strategySwapLPTestSuite) TestGetEstimatedTotalAssets_IgnoresPoolPrice() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	valueBefore, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+
+	suite.movePoolPrice()
+
+	// Only the swap fee paid to the pool increases the value
+	valueAfter, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	suite.True(valueAfter.Amount.GTE(valueBefore.Amount), "unexpected value %s", valueAfter)
+	suite.True(valueAfter.Amount.LT(valueBefore.Amount.AddRaw(1_000)), "unexpected value %s", valueAfter)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_PoolPriceDeviates() {
+	suite.movePoolPrice()
+
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrSwapPoolPriceDeviation)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_PoolPriceWithinVaultLimits() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedVaults[0].SwapSlippageLimit = sdk.MustNewDecFromStr("0.25")
+	params.AllowedVaults[0].SwapMaxPriceDeviation = sdk.MustNewDecFromStr("0.25")
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	suite.movePoolPrice()
+
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_NoPrice() {
+	suite.HardKeeper.DeleteMoneyMarket(suite.Ctx, swapLPVaultDenom)
+
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrPriceNotFound)
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_PoolPriceDeviates() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	withdrawAmount := sdk.NewInt64Coin(swapLPVaultDenom, 500_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	suite.movePoolPrice()
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+	suite.Require().ErrorContains(err, types.ErrSwapPoolPriceDeviation.Error())
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw_PoolPriceDeviatesOtherStrategyPays() {
+	vault, found := suite.Keeper.GetAllowedVault(suite.Ctx, swapLPVaultDenom)
+	suite.Require().True(found)
+	vault.Strategies = types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SWAP_LP}
+	vault.StrategyWeights = []sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5")}
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount).Add(depositAmount)), 0)
+
+	// hard receives the first deposit and swap LP the rest, leaving swap LP
+	// the most overweight
+	for i := 0; i < 3; i++ {
+		err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+		suite.Require().NoError(err)
+	}
+	suite.HardDepositAmountEqual(sdk.NewCoins(depositAmount))
+
+	suite.movePoolPrice()
+
+	withdrawAmount := sdk.NewInt64Coin(swapLPVaultDenom, 400_000)
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.True(withdrawn.Amount.GTE(withdrawAmount.Amount.SubRaw(1)), "unexpected withdrawn %s", withdrawn)
+
+	// The withdraw is paid from hard
+	suite.HardDepositAmountEqual(sdk.NewCoins(depositAmount.Sub(withdrawn)))
+}
+
+// accrueSwapRewards sets the swap pool's reward index so the module account
+// has earned the rewards, and funds the incentive module account to pay them.
+func (suite *strategySwapLPTestSuite) accrueSwapRewards(rewards sdk.Coin) {
+	incentiveKeeper := suite.App.GetIncentiveKeeper()
+	params := incentivetypes.DefaultParams()
+	params.ClaimMultipliers = incentivetypes.MultipliersPerDenoms{{
+		Denom:       rewards.Denom,
+		Multipliers: incentivetypes.Multipliers{incentivetypes.NewMultiplier("small", 0, sdk.OneDec())},
+	}}
+	params.ClaimEnd = suite.Ctx.BlockTime().Add(365 * 24 * time.Hour)
+	incentiveKeeper.SetParams(suite.Ctx, params)
+
+	macc := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	poolID := swaptypes.PoolID(swapLPVaultDenom, swapLPPairDenom)
+	shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, macc, poolID)
+	suite.Require().True(found)
+
+	incentiveKeeper.SetSwapRewardIndexes(suite.Ctx, poolID, incentivetypes.RewardIndexes{
+		incentivetypes.NewRewardIndex(rewards.Denom, sdk.NewDecFromInt(rewards.Amount).QuoInt(shares)),
+	})
+
+	err := suite.App.FundModuleAccount(suite.Ctx, incentivetypes.IncentiveMacc, sdk.NewCoins(rewards))
+	suite.Require().NoError(err)
+}
+
+// movePoolPrice swaps enough of the vault denom to lower its pool price by
+// about a sixth.
+func (suite *strategySwapLPTestSuite) movePoolPrice() {
+	swapAmount := sdk.NewInt64Coin(swapLPVaultDenom, 100_000_000)
+	trader := suite.CreateAccount(sdk.NewCoins(swapAmount), 11)
+
+	swapKeeper := suite.App.GetSwapKeeper()
+	err := swapKeeper.SwapExactForTokens(
+		suite.Ctx,
+		trader.GetAddress(),
+		swapAmount,
+		sdk.NewInt64Coin(swapLPPairDenom, 180_000_000),
+		sdk.MustNewDecFromStr("0.05"),
+	)
+	suite.Require().NoError(err)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -21,11 +22,14 @@ func (k *Keeper) GetVaultTotalShares(
 }
 
 // GetVaultTotalValue returns the total value of a vault, i.e. the realizable
-// total value if the vault were to liquidate its entire strategies.
+// total value if the vault were to liquidate all of its strategies.
 //
 // **Note:** This does not include the tokens held in bank by the module
 // account. If it were to be included, also note that the module account is
 // unblocked and can receive funds from bank sends.
+//
+// The amounts of unfulfilled withdrawal claims are excluded, as the shares
+// they were redeemed for have already been removed from the vault.
 func (k *Keeper) GetVaultTotalValue(
 	ctx sdk.Context,
 	denom string,
@@ -35,26 +39,36 @@ func (k *Keeper) GetVaultTotalValue(
 		return sdk.Coin{}, types.ErrVaultRecordNotFound
 	}
 
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	_, total, err := k.getStrategyAllocations(ctx, allowedVault, denom)
 	if err != nil {
-		return sdk.Coin{}, types.ErrInvalidVaultStrategy
+		return sdk.Coin{}, err
 	}
 
-	// Denom can be different from allowedVault.Denom for bkava
-	return strategy.GetEstimatedTotalAssets(ctx, denom)
+	// Strategy losses may leave less than the pending withdrawals
+	total = sdkmath.MaxInt(total.Sub(k.GetPendingWithdrawals(ctx, denom)), sdk.ZeroInt())
+
+	return sdk.NewCoin(denom, total), nil
 }
 
-// GetVaultAccountShares returns the shares for a single address for all vaults.
+// GetVaultAccountShares returns the shares for a single address for all vaults,
+// including tokenized shares held as share coins.
 func (k *Keeper) GetVaultAccountShares(
 	ctx sdk.Context,
 	acc sdk.AccAddress,
 ) (types.VaultShares, bool) {
+	var shares types.VaultShares
+
 	vaultShareRecord, found := k.GetVaultShareRecord(ctx, acc)
-	if !found {
-		return nil, false
+	if found {
+		shares = vaultShareRecord.Shares
+	}
+
+	shareCoins := k.getAccountShareCoins(ctx, acc)
+	if shareCoins.IsZero() {
+		return shares, found
 	}
 
-	return vaultShareRecord.Shares, true
+	return shares.Add(shareCoins...), true
 }
 
 // GetVaultAccountValue returns the value of a single address within a vault
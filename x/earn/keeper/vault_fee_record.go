@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// VaultFeeRecord -- vault fee accrual state
+
+// GetVaultFeeRecord returns the vault fee record for a given denom.
+func (k *Keeper) GetVaultFeeRecord(
+	ctx sdk.Context,
+	vaultDenom string,
+) (types.VaultFeeRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultFeeRecordKeyPrefix)
+
+	bz := store.Get(types.VaultKey(vaultDenom))
+	if bz == nil {
+		return types.VaultFeeRecord{}, false
+	}
+
+	var record types.VaultFeeRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetVaultFeeRecord sets the vault fee record for a given denom.
+func (k *Keeper) SetVaultFeeRecord(ctx sdk.Context, record types.VaultFeeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultFeeRecordKeyPrefix)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.VaultKey(record.Denom), bz)
+}
+
+// IterateVaultFeeRecords iterates over all vault fee records in the store and
+// performs a callback function.
+func (k Keeper) IterateVaultFeeRecords(
+	ctx sdk.Context,
+	cb func(record types.VaultFeeRecord) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultFeeRecordKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.VaultFeeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllVaultFeeRecords returns all vault fee records from the store.
+func (k Keeper) GetAllVaultFeeRecords(ctx sdk.Context) types.VaultFeeRecords {
+	var records types.VaultFeeRecords
+
+	k.IterateVaultFeeRecords(ctx, func(record types.VaultFeeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
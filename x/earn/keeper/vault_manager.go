@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// AddAllowedDepositor allows an account to deposit to a private vault. It may
+// only be called by the vault manager.
+func (k *Keeper) AddAllowedDepositor(
+	ctx sdk.Context,
+	manager sdk.AccAddress,
+	denom string,
+	depositor sdk.AccAddress,
+) error {
+	allowedVault, err := k.getManagedVault(ctx, manager, denom)
+	if err != nil {
+		return err
+	}
+
+	if allowedVault.IsAccountAllowed(depositor) {
+		return errorsmod.Wrapf(types.ErrDepositorAlreadyAllowed, "%s", depositor)
+	}
+
+	allowedVault.AllowedDepositors = append(allowedVault.AllowedDepositors, depositor)
+
+	if err := k.setAllowedVault(ctx, allowedVault); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositorAllowed,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveAllowedDepositor stops an account from depositing to a private vault.
+// Existing deposits of the account are not affected. It may only be called by
+// the vault manager.
+func (k *Keeper) RemoveAllowedDepositor(
+	ctx sdk.Context,
+	manager sdk.AccAddress,
+	denom string,
+	depositor sdk.AccAddress,
+) error {
+	allowedVault, err := k.getManagedVault(ctx, manager, denom)
+	if err != nil {
+		return err
+	}
+
+	var allowedDepositors []sdk.AccAddress
+	for _, addr := range allowedVault.AllowedDepositors {
+		if !addr.Equals(depositor) {
+			allowedDepositors = append(allowedDepositors, addr)
+		}
+	}
+
+	if len(allowedDepositors) == len(allowedVault.AllowedDepositors) {
+		return errorsmod.Wrapf(types.ErrAccountDepositNotAllowed, "%s", depositor)
+	}
+
+	if len(allowedDepositors) == 0 {
+		return types.ErrLastAllowedDepositor
+	}
+
+	allowedVault.AllowedDepositors = allowedDepositors
+
+	if err := k.setAllowedVault(ctx, allowedVault); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositorRemoved,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+
+	return nil
+}
+
+// TransferVaultManager hands the manager role of a private vault to another
+// account. It may only be called by the vault manager.
+func (k *Keeper) TransferVaultManager(
+	ctx sdk.Context,
+	manager sdk.AccAddress,
+	denom string,
+	newManager sdk.AccAddress,
+) error {
+	allowedVault, err := k.getManagedVault(ctx, manager, denom)
+	if err != nil {
+		return err
+	}
+
+	allowedVault.Manager = newManager
+
+	if err := k.setAllowedVault(ctx, allowedVault); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeManagerTransfer,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+			sdk.NewAttribute(types.AttributeKeyNewManager, newManager.String()),
+		),
+	)
+
+	return nil
+}
+
+// getManagedVault returns the private vault with the exact denom if it is
+// managed by the account.
+func (k *Keeper) getManagedVault(
+	ctx sdk.Context,
+	manager sdk.AccAddress,
+	denom string,
+) (types.AllowedVault, error) {
+	allowedVault, found := k.getAllowedVaultRaw(ctx, denom)
+	if !found {
+		return types.AllowedVault{}, errorsmod.Wrapf(types.ErrInvalidVaultDenom, "%s", denom)
+	}
+
+	if !allowedVault.IsPrivateVault || allowedVault.Manager.Empty() || !allowedVault.Manager.Equals(manager) {
+		return types.AllowedVault{}, errorsmod.Wrapf(types.ErrNotVaultManager, "%s for vault %s", manager, denom)
+	}
+
+	return allowedVault, nil
+}
+
+// setAllowedVault replaces the AllowedVault with the same denom in the module
+// params.
+func (k *Keeper) setAllowedVault(ctx sdk.Context, allowedVault types.AllowedVault) error {
+	if err := allowedVault.Validate(); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	for i, vault := range params.AllowedVaults {
+		if vault.Denom == allowedVault.Denom {
+			params.AllowedVaults[i] = allowedVault
+		}
+	}
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
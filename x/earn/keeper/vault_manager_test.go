@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+const managedVaultDenom = "usdx"
+
+type vaultManagerTestSuite struct {
+	testutil.Suite
+
+	manager   sdk.AccAddress
+	depositor sdk.AccAddress
+}
+
+func (suite *vaultManagerTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.manager = suite.CreateAccount(sdk.NewCoins(), 0).GetAddress()
+	suite.depositor = suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(managedVaultDenom, 1000)), 1).GetAddress()
+
+	vault := types.NewAllowedVault(
+		managedVaultDenom,
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD},
+		true,
+		[]sdk.AccAddress{suite.manager},
+	)
+	vault.Manager = suite.manager
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+}
+
+func TestVaultManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(vaultManagerTestSuite))
+}
+
+func (suite *vaultManagerTestSuite) allowedVault() types.AllowedVault {
+	allowedVault, found := suite.Keeper.GetAllowedVault(suite.Ctx, managedVaultDenom)
+	suite.Require().True(found)
+
+	return allowedVault
+}
+
+func (suite *vaultManagerTestSuite) TestAddAllowedDepositor() {
+	amount := sdk.NewInt64Coin(managedVaultDenom, 100)
+
+	err := suite.Keeper.Deposit(suite.Ctx, suite.depositor, amount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrAccountDepositNotAllowed)
+
+	err = suite.Keeper.AddAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.depositor)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]sdk.AccAddress{suite.manager, suite.depositor}, suite.allowedVault().AllowedDepositors)
+
+	err = suite.Keeper.Deposit(suite.Ctx, suite.depositor, amount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeDepositorAllowed,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, managedVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyManager, suite.manager.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, suite.depositor.String()),
+	))
+
+	err = suite.Keeper.AddAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.depositor)
+	suite.Require().ErrorIs(err, types.ErrDepositorAlreadyAllowed)
+}
+
+func (suite *vaultManagerTestSuite) TestRemoveAllowedDepositor() {
+	err := suite.Keeper.AddAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.depositor)
+	suite.Require().NoError(err)
+
+	amount := sdk.NewInt64Coin(managedVaultDenom, 100)
+	err = suite.Keeper.Deposit(suite.Ctx, suite.depositor, amount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.RemoveAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.depositor)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]sdk.AccAddress{suite.manager}, suite.allowedVault().AllowedDepositors)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeDepositorRemoved,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, managedVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyManager, suite.manager.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, suite.depositor.String()),
+	))
+
+	// Removed depositors can't deposit, but can still withdraw
+	err = suite.Keeper.Deposit(suite.Ctx, suite.depositor, amount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrAccountDepositNotAllowed)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, suite.depositor, amount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.RemoveAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.depositor)
+	suite.Require().ErrorIs(err, types.ErrAccountDepositNotAllowed)
+
+	// Private vaults can't be left without depositors
+	err = suite.Keeper.RemoveAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.manager)
+	suite.Require().ErrorIs(err, types.ErrLastAllowedDepositor)
+}
+
+func (suite *vaultManagerTestSuite) TestTransferVaultManager() {
+	newManager := suite.CreateAccount(sdk.NewCoins(), 2).GetAddress()
+
+	err := suite.Keeper.TransferVaultManager(suite.Ctx, suite.manager, managedVaultDenom, newManager)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(newManager, suite.allowedVault().Manager)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeManagerTransfer,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, managedVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyManager, suite.manager.String()),
+		sdk.NewAttribute(types.AttributeKeyNewManager, newManager.String()),
+	))
+
+	// The previous manager loses the role
+	err = suite.Keeper.AddAllowedDepositor(suite.Ctx, suite.manager, managedVaultDenom, suite.depositor)
+	suite.Require().ErrorIs(err, types.ErrNotVaultManager)
+
+	err = suite.Keeper.AddAllowedDepositor(suite.Ctx, newManager, managedVaultDenom, suite.depositor)
+	suite.Require().NoError(err)
+}
+
+func (suite *vaultManagerTestSuite) TestNotVaultManager() {
+	err := suite.Keeper.AddAllowedDepositor(suite.Ctx, suite.depositor, managedVaultDenom, suite.depositor)
+	suite.Require().ErrorIs(err, types.ErrNotVaultManager)
+
+	err = suite.Keeper.RemoveAllowedDepositor(suite.Ctx, suite.depositor, managedVaultDenom, suite.manager)
+	suite.Require().ErrorIs(err, types.ErrNotVaultManager)
+
+	err = suite.Keeper.TransferVaultManager(suite.Ctx, suite.depositor, managedVaultDenom, suite.depositor)
+	suite.Require().ErrorIs(err, types.ErrNotVaultManager)
+
+	err = suite.Keeper.AddAllowedDepositor(suite.Ctx, suite.manager, "busd", suite.depositor)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	suite.Require().Equal([]sdk.AccAddress{suite.manager}, suite.allowedVault().AllowedDepositors)
+}
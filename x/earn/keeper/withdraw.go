@@ -17,42 +17,133 @@ func (k *Keeper) Withdraw(
 	wantAmount sdk.Coin,
 	withdrawStrategy types.StrategyType,
 ) (sdk.Coin, error) {
+	return k.WithdrawTo(ctx, from, from, wantAmount, withdrawStrategy)
+}
+
+// WithdrawTo removes the amount of supplied tokens from the vault deposit of
+// an account and transfers it to the recipient. The vault's unfulfilled
+// withdrawal claims are paid first, and the withdrawal is rejected only if the
+// vault strategies can't pay all of them.
+func (k *Keeper) WithdrawTo(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	recipient sdk.AccAddress,
+	wantAmount sdk.Coin,
+	withdrawStrategy types.StrategyType,
+) (sdk.Coin, error) {
+	// Liquidity freed up in the strategies goes to the queue in order
+	if k.GetPendingWithdrawals(ctx, wantAmount.Denom).IsPositive() {
+		if allowedVault, found := k.GetAllowedVault(ctx, wantAmount.Denom); found {
+			k.fulfillVaultWithdrawalClaims(ctx, allowedVault, wantAmount.Denom, 0)
+		}
+		if k.GetPendingWithdrawals(ctx, wantAmount.Denom).IsPositive() {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrWithdrawalsQueued, "%s, use a withdrawal request", wantAmount.Denom)
+		}
+	}
+
+	allowedVault, withdrawAmount, withdrawShares, err := k.getWithdrawAmount(ctx, from, wantAmount, withdrawStrategy)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Not necessary to check if amount denom is allowed for the strategy, as
+	// there would be no vault record if it weren't allowed.
+
+	// Withdraw the withdrawAmount from the most overweight of the vault's
+	// strategies
+	withdrawShares, err = k.withdrawSharesFromStrategies(ctx, allowedVault, from, withdrawAmount, withdrawShares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Send coins to the recipient, must withdraw from strategy first or the
+	// module account may not have any funds to send.
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		recipient,
+		sdk.NewCoins(withdrawAmount),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	withdrawShares, err = k.burnWithdrawShares(ctx, from, withdrawAmount, withdrawShares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultWithdraw,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+		),
+	)
+
+	return withdrawAmount, nil
+}
+
+// getWithdrawAmount returns the vault shares worth the amount of supplied
+// tokens and their truncated value, checking the account has enough shares to
+// withdraw them.
+func (k *Keeper) getWithdrawAmount(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	wantAmount sdk.Coin,
+	withdrawStrategy types.StrategyType,
+) (types.AllowedVault, sdk.Coin, types.VaultShare, error) {
 	// Get AllowedVault, if not found (not a valid vault), return error
 	allowedVault, found := k.GetAllowedVault(ctx, wantAmount.Denom)
 	if !found {
-		return sdk.Coin{}, types.ErrInvalidVaultDenom
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, types.ErrInvalidVaultDenom
 	}
 
 	if wantAmount.IsZero() {
-		return sdk.Coin{}, types.ErrInsufficientAmount
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, types.ErrInsufficientAmount
 	}
 
 	// Check if withdraw strategy is supported by vault
 	if !allowedVault.IsStrategyAllowed(withdrawStrategy) {
-		return sdk.Coin{}, types.ErrInvalidVaultStrategy
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, types.ErrInvalidVaultStrategy
+	}
+
+	// Compound rewards before fees are charged on the vault value and shares
+	// are redeemed at it
+	k.compoundVaultRewards(ctx, allowedVault)
+
+	// Charge fees before redeeming shares, as fee shares modify the
+	// VaultRecord and the fee recipient's VaultShareRecord
+	if err := k.AccrueVaultFees(ctx, wantAmount.Denom); err != nil {
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, err
 	}
 
 	// Check if VaultRecord exists
-	vaultRecord, found := k.GetVaultRecord(ctx, wantAmount.Denom)
-	if !found {
-		return sdk.Coin{}, types.ErrVaultRecordNotFound
+	if _, found := k.GetVaultRecord(ctx, wantAmount.Denom); !found {
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, types.ErrVaultRecordNotFound
 	}
 
-	// Get account share record for the vault
-	vaultShareRecord, found := k.GetVaultShareRecord(ctx, from)
-	if !found {
-		return sdk.Coin{}, types.ErrVaultShareRecordNotFound
+	// Get account shares for the vault
+	if _, found := k.GetVaultAccountShares(ctx, from); !found {
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, types.ErrVaultShareRecordNotFound
 	}
 
 	withdrawShares, err := k.ConvertToShares(ctx, wantAmount)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to convert assets to shares: %w", err)
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, fmt.Errorf("failed to convert assets to shares: %w", err)
 	}
 
-	accCurrentShares := vaultShareRecord.Shares.AmountOf(wantAmount.Denom)
+	// Tokenized shares are redeemed in whole units
+	if allowedVault.TokenizeShares {
+		withdrawShares.Amount = withdrawShares.Amount.Ceil()
+	}
+
+	accCurrentShares := k.getAccountVaultShares(ctx, from, wantAmount.Denom)
 	// Check if account is not withdrawing more shares than they have
 	if accCurrentShares.LT(withdrawShares.Amount) {
-		return sdk.Coin{}, errorsmod.Wrapf(
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, errorsmod.Wrapf(
 			types.ErrInsufficientValue,
 			"account has less %s vault shares than withdraw shares, %s < %s",
 			wantAmount.Denom,
@@ -64,17 +155,17 @@ func (k *Keeper) Withdraw(
 	// Convert shares to amount to get truncated true share value
 	withdrawAmount, err := k.ConvertToAssets(ctx, withdrawShares)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to convert shares to assets: %w", err)
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, fmt.Errorf("failed to convert shares to assets: %w", err)
 	}
 
 	accountValue, err := k.GetVaultAccountValue(ctx, wantAmount.Denom, from)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to get account value: %w", err)
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, fmt.Errorf("failed to get account value: %w", err)
 	}
 
 	// Check if withdrawAmount > account value
 	if withdrawAmount.Amount.GT(accountValue.Amount) {
-		return sdk.Coin{}, errorsmod.Wrapf(
+		return types.AllowedVault{}, sdk.Coin{}, types.VaultShare{}, errorsmod.Wrapf(
 			types.ErrInsufficientValue,
 			"account has less %s vault value than withdraw amount, %s < %s",
 			withdrawAmount.Denom,
@@ -83,72 +174,128 @@ func (k *Keeper) Withdraw(
 		)
 	}
 
-	// Get the strategy for the vault
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	return allowedVault, withdrawAmount, withdrawShares, nil
+}
+
+// withdrawSharesFromStrategies withdraws the amount from the vault's strategies
+// and returns the shares redeemed for it. Vaults with strategies that pay swap
+// costs redeem at least the shares worth the vault value the withdraw removed,
+// so the withdrawer pays for their own slippage instead of the vault's other
+// depositors.
+func (k *Keeper) withdrawSharesFromStrategies(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	from sdk.AccAddress,
+	withdrawAmount sdk.Coin,
+	withdrawShares types.VaultShare,
+) (types.VaultShare, error) {
+	if !hasSwapCosts(allowedVault) {
+		if err := k.withdrawFromStrategies(ctx, allowedVault, withdrawAmount); err != nil {
+			return types.VaultShare{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
+		}
+
+		return withdrawShares, nil
+	}
+
+	valueBefore, err := k.GetVaultTotalValue(ctx, withdrawAmount.Denom)
 	if err != nil {
-		return sdk.Coin{}, err
+		return types.VaultShare{}, err
 	}
 
-	// Not necessary to check if amount denom is allowed for the strategy, as
-	// there would be no vault record if it weren't allowed.
+	if err := k.withdrawFromStrategies(ctx, allowedVault, withdrawAmount); err != nil {
+		return types.VaultShare{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
+	}
 
-	// Withdraw the withdrawAmount from the strategy
-	if err := strategy.Withdraw(ctx, withdrawAmount); err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
+	valueAfter, err := k.GetVaultTotalValue(ctx, withdrawAmount.Denom)
+	if err != nil {
+		return types.VaultShare{}, err
 	}
 
-	// Send coins back to account, must withdraw from strategy first or the
-	// module account may not have any funds to send.
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		types.ModuleName,
-		from,
-		sdk.NewCoins(withdrawAmount),
-	); err != nil {
-		return sdk.Coin{}, err
+	removed := valueBefore.Amount.Sub(valueAfter.Amount)
+	if removed.LTE(withdrawAmount.Amount) {
+		return withdrawShares, nil
+	}
+
+	costShares := withdrawShares.Amount.MulInt(removed).QuoInt(withdrawAmount.Amount)
+	if allowedVault.TokenizeShares {
+		costShares = costShares.Ceil()
 	}
 
+	accCurrentShares := k.getAccountVaultShares(ctx, from, withdrawAmount.Denom)
+	if accCurrentShares.LT(costShares) {
+		return types.VaultShare{}, errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less %s vault shares than the withdraw removes from the vault, %s < %s",
+			withdrawAmount.Denom,
+			accCurrentShares,
+			costShares,
+		)
+	}
+
+	return types.NewVaultShare(withdrawAmount.Denom, costShares), nil
+}
+
+// burnWithdrawShares removes withdrawn shares from the account's vault deposit,
+// returning the shares removed. Shares left worth less than one coin are
+// removed as well.
+func (k *Keeper) burnWithdrawShares(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	withdrawAmount sdk.Coin,
+	withdrawShares types.VaultShare,
+) (types.VaultShare, error) {
+	vaultRecord, found := k.GetVaultRecord(ctx, withdrawAmount.Denom)
+	if !found {
+		return types.VaultShare{}, types.ErrVaultRecordNotFound
+	}
+
+	accCurrentShares := k.getAccountVaultShares(ctx, from, withdrawAmount.Denom)
+	if accCurrentShares.IsZero() {
+		return types.VaultShare{}, types.ErrVaultShareRecordNotFound
+	}
+	accShares := types.NewVaultShare(withdrawAmount.Denom, accCurrentShares)
+
 	// Check if new account balance of shares results in account share value
 	// of < 1 of a sdk.Coin. This share value is not able to be withdrawn and
 	// should just be removed.
-	isDust, err := k.ShareIsDust(
-		ctx,
-		vaultShareRecord.Shares.GetShare(withdrawAmount.Denom).Sub(withdrawShares),
-	)
+	isDust, err := k.ShareIsDust(ctx, accShares.Sub(withdrawShares))
 	if err != nil {
-		return sdk.Coin{}, err
+		return types.VaultShare{}, err
 	}
 
 	if isDust {
 		// Modify withdrawShares to subtract entire share balance for denom
 		// This does not modify the actual withdraw coin amount as the
 		// difference is < 1coin.
-		withdrawShares = vaultShareRecord.Shares.GetShare(withdrawAmount.Denom)
+		withdrawShares = accShares
 	}
 
 	// Call hook before record is modified with the user's current shares
-	k.BeforeVaultDepositModified(ctx, wantAmount.Denom, from, accCurrentShares)
+	k.BeforeVaultDepositModified(ctx, withdrawAmount.Denom, from, accCurrentShares)
 
-	// Decrement VaultRecord and VaultShareRecord supplies - must delete same
-	// amounts
-	vaultShareRecord.Shares = vaultShareRecord.Shares.Sub(withdrawShares)
+	// Decrement VaultRecord and account supplies - must delete same amounts
 	vaultRecord.TotalShares = vaultRecord.TotalShares.Sub(withdrawShares)
-
-	// Update VaultRecord and VaultShareRecord, deletes if zero supply
 	k.UpdateVaultRecord(ctx, vaultRecord)
-	k.UpdateVaultShareRecord(ctx, vaultShareRecord)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeVaultWithdraw,
-			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
-			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
-			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
-		),
-	)
+	// The record may have been deleted, so its form of shares is used directly
+	if vaultRecord.TokenizeShares {
+		if err := k.burnShareCoins(ctx, from, withdrawShares); err != nil {
+			return types.VaultShare{}, err
+		}
 
-	return withdrawAmount, nil
+		return withdrawShares, nil
+	}
+
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, from)
+	if !found {
+		return types.VaultShare{}, types.ErrVaultShareRecordNotFound
+	}
+
+	// Update VaultShareRecord, deletes if zero supply
+	vaultShareRecord.Shares = vaultShareRecord.Shares.Sub(withdrawShares)
+	k.UpdateVaultShareRecord(ctx, vaultShareRecord)
+
+	return withdrawShares, nil
 }
 
 // WithdrawFromModuleAccount removes the amount of supplied tokens from a vault and transfers it
@@ -243,6 +243,46 @@ func (suite *withdrawTestSuite) TestWithdraw_Partial() {
 	)
 }
 
+func (suite *withdrawTestSuite) TestWithdrawTo() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	withdrawAmount := sdk.NewInt64Coin(vaultDenom, 40)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	recipient := suite.CreateAccount(sdk.NewCoins(), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	withdrawn, err := suite.Keeper.WithdrawTo(suite.Ctx, acc.GetAddress(), recipient.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.Require().Equal(withdrawAmount, withdrawn)
+
+	// Shares are removed from the owner and funds sent to the recipient
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(startBalance.Sub(depositAmount)))
+	suite.AccountBalanceEqual(recipient.GetAddress(), sdk.NewCoins(withdrawAmount))
+
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecFromInt(depositAmount.Amount.Sub(withdrawAmount.Amount)), shares.AmountOf(vaultDenom))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultWithdraw,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDecFromInt(withdrawAmount.Amount).String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+	))
+
+	// The recipient has no shares to withdraw
+	_, err = suite.Keeper.WithdrawTo(suite.Ctx, recipient.GetAddress(), acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrVaultShareRecordNotFound)
+}
+
 func (suite *withdrawTestSuite) TestWithdraw_bKava() {
 	vaultDenom := "bkava"
 	coinDenom := testutil.TestBkavaDenoms[0]
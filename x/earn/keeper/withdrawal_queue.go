@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// maxClaimFulfillmentsPerVault is the most withdrawal claims of each vault
+// that are fulfilled in a single block.
+const maxClaimFulfillmentsPerVault = 20
+
+// ----------------------------------------------------------------------------
+// WithdrawalClaim -- queued withdrawals
+
+// GetWithdrawalClaim returns a withdrawal claim by id.
+func (k *Keeper) GetWithdrawalClaim(ctx sdk.Context, id uint64) (types.WithdrawalClaim, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalClaimKeyPrefix)
+
+	bz := store.Get(types.WithdrawalClaimKey(id))
+	if bz == nil {
+		return types.WithdrawalClaim{}, false
+	}
+
+	var claim types.WithdrawalClaim
+	k.cdc.MustUnmarshal(bz, &claim)
+
+	return claim, true
+}
+
+// SetWithdrawalClaim sets a withdrawal claim in the store.
+func (k *Keeper) SetWithdrawalClaim(ctx sdk.Context, claim types.WithdrawalClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalClaimKeyPrefix)
+	bz := k.cdc.MustMarshal(&claim)
+	store.Set(types.WithdrawalClaimKey(claim.ID), bz)
+}
+
+// DeleteWithdrawalClaim deletes a withdrawal claim from the store.
+func (k *Keeper) DeleteWithdrawalClaim(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalClaimKeyPrefix)
+	store.Delete(types.WithdrawalClaimKey(id))
+}
+
+// IterateWithdrawalClaims iterates over all withdrawal claims in order of id
+// and performs a callback function.
+func (k Keeper) IterateWithdrawalClaims(
+	ctx sdk.Context,
+	cb func(claim types.WithdrawalClaim) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.WithdrawalClaimKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.WithdrawalClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// GetAllWithdrawalClaims returns all withdrawal claims from the store.
+func (k Keeper) GetAllWithdrawalClaims(ctx sdk.Context) types.WithdrawalClaims {
+	var claims types.WithdrawalClaims
+
+	k.IterateWithdrawalClaims(ctx, func(claim types.WithdrawalClaim) bool {
+		claims = append(claims, claim)
+		return false
+	})
+
+	return claims
+}
+
+// SetNextWithdrawalClaimID stores the id to be used for the next withdrawal
+// claim.
+func (k *Keeper) SetNextWithdrawalClaimID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextWithdrawalClaimIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextWithdrawalClaimID returns the id to be used for the next withdrawal
+// claim.
+func (k *Keeper) GetNextWithdrawalClaimID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.NextWithdrawalClaimIDKey)
+	if bz == nil {
+		return types.DefaultNextWithdrawalClaimID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetPendingWithdrawals returns the total amount of the unfulfilled
+// withdrawal claims of a vault. This amount is still held by the vault
+// strategies but is no longer part of the vault value.
+func (k *Keeper) GetPendingWithdrawals(ctx sdk.Context, denom string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+
+	bz := store.Get(types.VaultKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var total sdkmath.Int
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return total
+}
+
+// setPendingWithdrawals sets the total amount of the unfulfilled withdrawal
+// claims of a vault, deleting it if zero.
+func (k *Keeper) setPendingWithdrawals(ctx sdk.Context, denom string, total sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+
+	if total.IsZero() {
+		store.Delete(types.VaultKey(denom))
+		return
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.VaultKey(denom), bz)
+}
+
+// AddWithdrawalClaim stores a withdrawal claim and, if it is unfulfilled,
+// adds it to the pending claims and pending withdrawals of its vault.
+func (k *Keeper) AddWithdrawalClaim(ctx sdk.Context, claim types.WithdrawalClaim) {
+	k.SetWithdrawalClaim(ctx, claim)
+
+	if !claim.Fulfilled {
+		store := prefix.NewStore(ctx.KVStore(k.key), types.PendingClaimKeyPrefix)
+		store.Set(types.PendingClaimKey(claim.Amount.Denom, claim.ID), []byte{})
+
+		pending := k.GetPendingWithdrawals(ctx, claim.Amount.Denom)
+		k.setPendingWithdrawals(ctx, claim.Amount.Denom, pending.Add(claim.Amount.Amount))
+	}
+}
+
+// markWithdrawalClaimFulfilled sets a withdrawal claim as fulfilled and
+// removes it from the pending claims and pending withdrawals of its vault.
+func (k *Keeper) markWithdrawalClaimFulfilled(ctx sdk.Context, claim types.WithdrawalClaim) {
+	claim.Fulfilled = true
+	k.SetWithdrawalClaim(ctx, claim)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingClaimKeyPrefix)
+	store.Delete(types.PendingClaimKey(claim.Amount.Denom, claim.ID))
+
+	pending := k.GetPendingWithdrawals(ctx, claim.Amount.Denom)
+	k.setPendingWithdrawals(ctx, claim.Amount.Denom, pending.Sub(claim.Amount.Amount))
+}
+
+// IteratePendingClaimIDs iterates over the ids of the unfulfilled withdrawal
+// claims of a vault in order and performs a callback function.
+func (k Keeper) IteratePendingClaimIDs(
+	ctx sdk.Context,
+	denom string,
+	cb func(id uint64) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(types.PendingClaimKeyPrefix, types.PendingClaimsKey(denom)...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.BigEndianToUint64(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Withdrawal queue
+
+// RequestWithdraw redeems the shares worth the amount of supplied tokens from
+// an account's vault deposit into a withdrawal claim. The claim is fulfilled
+// in an end blocker once the vault strategies have enough liquidity, and the
+// claim amount no longer earns yield from the vault. Claims must be at least
+// the MinWithdrawalClaim of the vault.
+func (k *Keeper) RequestWithdraw(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	wantAmount sdk.Coin,
+	withdrawStrategy types.StrategyType,
+) (types.WithdrawalClaim, error) {
+	allowedVault, withdrawAmount, withdrawShares, err := k.getWithdrawAmount(ctx, from, wantAmount, withdrawStrategy)
+	if err != nil {
+		return types.WithdrawalClaim{}, err
+	}
+
+	// Shares may be worth nothing once their value is truncated
+	if !withdrawAmount.IsPositive() {
+		return types.WithdrawalClaim{}, types.ErrInsufficientAmount
+	}
+
+	if allowedVault.IsBelowMinWithdrawalClaim(withdrawAmount.Amount) {
+		return types.WithdrawalClaim{}, errorsmod.Wrapf(
+			types.ErrWithdrawalClaimTooSmall,
+			"%s < %s",
+			withdrawAmount.Amount, allowedVault.MinWithdrawalClaim,
+		)
+	}
+
+	id := k.GetNextWithdrawalClaimID(ctx)
+	claim := types.NewWithdrawalClaim(id, from, withdrawAmount, ctx.BlockTime())
+
+	// The claim is removed from the vault value before the shares are burned,
+	// as a withdrawal from the strategies would be
+	k.AddWithdrawalClaim(ctx, claim)
+	k.SetNextWithdrawalClaimID(ctx, id+1)
+
+	withdrawShares, err = k.burnWithdrawShares(ctx, from, withdrawAmount, withdrawShares)
+	if err != nil {
+		return types.WithdrawalClaim{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalRequest,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+		),
+	)
+
+	return claim, nil
+}
+
+// ClaimWithdraw pays out a fulfilled withdrawal claim to its owner and
+// deletes the claim.
+func (k *Keeper) ClaimWithdraw(ctx sdk.Context, owner sdk.AccAddress, id uint64) (sdk.Coin, error) {
+	claim, found := k.GetWithdrawalClaim(ctx, id)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrWithdrawalClaimNotFound, "%d", id)
+	}
+
+	if !claim.Owner.Equals(owner) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrWithdrawalClaimNotFound, "%d for owner %s", id, owner)
+	}
+
+	if !claim.Fulfilled {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrWithdrawalClaimPending, "%d", id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		owner,
+		sdk.NewCoins(claim.Amount),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.DeleteWithdrawalClaim(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalClaim,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, claim.Amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount.Amount.String()),
+		),
+	)
+
+	return claim.Amount, nil
+}
+
+// FulfillWithdrawalClaims withdraws the amounts of unfulfilled withdrawal
+// claims from the vault strategies into the module account, up to
+// maxClaimFulfillmentsPerVault claims of each vault. Only the pending claims
+// index is read, so fulfilled claims waiting to be paid out cost nothing.
+func (k *Keeper) FulfillWithdrawalClaims(ctx sdk.Context) {
+	// Vault denoms with pending withdrawals, which include each bkava denom
+	var denoms []string
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, denom := range denoms {
+		allowedVault, found := k.GetAllowedVault(ctx, denom)
+		if !found {
+			continue
+		}
+
+		k.fulfillVaultWithdrawalClaims(ctx, allowedVault, denom, maxClaimFulfillmentsPerVault)
+	}
+}
+
+// fulfillVaultWithdrawalClaims fulfills the unfulfilled withdrawal claims of a
+// vault in order of id, stopping at the first claim the vault strategies can't
+// currently pay or after limit claims, if limit is positive. A strategy that
+// fails to pay is skipped for the vault's other strategies.
+func (k *Keeper) fulfillVaultWithdrawalClaims(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	denom string,
+	limit int,
+) {
+	var ids []uint64
+	k.IteratePendingClaimIDs(ctx, denom, func(id uint64) bool {
+		ids = append(ids, id)
+		return limit > 0 && len(ids) >= limit
+	})
+
+	for _, id := range ids {
+		claim, found := k.GetWithdrawalClaim(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("pending withdrawal claim %d not found", id))
+		}
+
+		// Strategies without enough liquidity leave the claim, and all later
+		// claims of the vault, in the queue until a later block
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.withdrawFromStrategies(cacheCtx, allowedVault, claim.Amount); err != nil {
+			k.Logger(ctx).Debug("withdrawal claim not fulfilled", "id", claim.ID, "denom", denom, "err", err)
+			return
+		}
+		writeCache()
+
+		k.markWithdrawalClaimFulfilled(ctx, claim)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawalFulfill,
+				sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyOwner, claim.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyClaimID, fmt.Sprintf("%d", claim.ID)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount.Amount.String()),
+			),
+		)
+	}
+}
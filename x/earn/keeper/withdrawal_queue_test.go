@@ -0,0 +1,333 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+const queueVaultDenom = "usdx"
+
+type withdrawalQueueTestSuite struct {
+	testutil.Suite
+
+	// borrower holds hard liquidity removed to make the vault illiquid
+	borrower sdk.AccAddress
+}
+
+func (suite *withdrawalQueueTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+
+	suite.CreateVault(queueVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.borrower = suite.CreateAccount(sdk.NewCoins(), 9).GetAddress()
+}
+
+func TestWithdrawalQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(withdrawalQueueTestSuite))
+}
+
+func (suite *withdrawalQueueTestSuite) deposit(amount int64, index int) sdk.AccAddress {
+	depositAmount := sdk.NewInt64Coin(queueVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), index)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	return acc.GetAddress()
+}
+
+// borrowLiquidity moves funds out of the hard module account as if they were
+// borrowed
+func (suite *withdrawalQueueTestSuite) borrowLiquidity(amount int64) {
+	err := suite.BankKeeper.SendCoinsFromModuleToAccount(
+		suite.Ctx,
+		hardtypes.ModuleAccountName,
+		suite.borrower,
+		sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, amount)),
+	)
+	suite.Require().NoError(err)
+}
+
+// repayLiquidity returns borrowed funds to the hard module account
+func (suite *withdrawalQueueTestSuite) repayLiquidity(amount int64) {
+	err := suite.BankKeeper.SendCoinsFromAccountToModule(
+		suite.Ctx,
+		suite.borrower,
+		hardtypes.ModuleAccountName,
+		sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, amount)),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *withdrawalQueueTestSuite) requestWithdraw(from sdk.AccAddress, amount int64) types.WithdrawalClaim {
+	claim, err := suite.Keeper.RequestWithdraw(
+		suite.Ctx,
+		from,
+		sdk.NewInt64Coin(queueVaultDenom, amount),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().NoError(err)
+
+	return claim
+}
+
+func (suite *withdrawalQueueTestSuite) claimFulfilledEqual(id uint64, expected bool) {
+	claim, found := suite.Keeper.GetWithdrawalClaim(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(expected, claim.Fulfilled, "claim %d fulfilled", id)
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdraw() {
+	depositor := suite.deposit(1000, 0)
+	other := suite.deposit(1000, 1)
+
+	claim := suite.requestWithdraw(depositor, 400)
+
+	suite.Require().Equal(
+		types.NewWithdrawalClaim(1, depositor, sdk.NewInt64Coin(queueVaultDenom, 400), suite.Ctx.BlockTime()),
+		claim,
+	)
+	suite.Require().Equal(uint64(2), suite.Keeper.GetNextWithdrawalClaimID(suite.Ctx))
+
+	// Shares are redeemed but the funds stay in the strategy
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(600), shares.AmountOf(queueVaultDenom))
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 2000)))
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins())
+
+	// Pending claims are excluded from the vault value, so the share price of
+	// the remaining depositors is unchanged
+	suite.Require().Equal(sdk.NewInt(400), suite.Keeper.GetPendingWithdrawals(suite.Ctx, queueVaultDenom))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1600)))
+
+	value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, queueVaultDenom, other)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(queueVaultDenom, 1000), value)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeWithdrawalRequest,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, queueVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, depositor.String()),
+		sdk.NewAttribute(types.AttributeKeyClaimID, "1"),
+		sdk.NewAttribute(types.AttributeKeyShares, "400.000000000000000000"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "400"),
+	))
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdraw_InsufficientValue() {
+	depositor := suite.deposit(1000, 0)
+
+	_, err := suite.Keeper.RequestWithdraw(
+		suite.Ctx,
+		depositor,
+		sdk.NewInt64Coin(queueVaultDenom, 1001),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	suite.Require().Empty(suite.Keeper.GetAllWithdrawalClaims(suite.Ctx))
+}
+
+func (suite *withdrawalQueueTestSuite) TestFulfillWithdrawalClaims_FIFO() {
+	first := suite.deposit(1000, 0)
+	second := suite.deposit(1000, 1)
+
+	suite.borrowLiquidity(2000)
+
+	// Direct withdrawals fail while the strategy is illiquid
+	_, err := suite.Keeper.Withdraw(suite.Ctx, first, sdk.NewInt64Coin(queueVaultDenom, 300), types.STRATEGY_TYPE_HARD)
+	suite.Require().Error(err)
+
+	suite.requestWithdraw(first, 300)
+	suite.requestWithdraw(second, 200)
+
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(1, false)
+	suite.claimFulfilledEqual(2, false)
+
+	// The second claim could be paid, but must wait for the first
+	suite.repayLiquidity(250)
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(1, false)
+	suite.claimFulfilledEqual(2, false)
+
+	suite.repayLiquidity(100)
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(1, true)
+	suite.claimFulfilledEqual(2, false)
+
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 300)))
+	suite.Require().Equal(sdk.NewInt(200), suite.Keeper.GetPendingWithdrawals(suite.Ctx, queueVaultDenom))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1500)))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeWithdrawalFulfill,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, queueVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, first.String()),
+		sdk.NewAttribute(types.AttributeKeyClaimID, "1"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "300"),
+	))
+
+	suite.repayLiquidity(1650)
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(2, true)
+
+	suite.Require().True(suite.Keeper.GetPendingWithdrawals(suite.Ctx, queueVaultDenom).IsZero())
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1500)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestClaimWithdraw() {
+	depositor := suite.deposit(1000, 0)
+	other := suite.deposit(1000, 1)
+
+	suite.borrowLiquidity(2000)
+	claim := suite.requestWithdraw(depositor, 400)
+
+	_, err := suite.Keeper.ClaimWithdraw(suite.Ctx, depositor, claim.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalClaimPending)
+
+	suite.repayLiquidity(2000)
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+
+	// Only the owner can claim
+	_, err = suite.Keeper.ClaimWithdraw(suite.Ctx, other, claim.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalClaimNotFound)
+
+	amount, err := suite.Keeper.ClaimWithdraw(suite.Ctx, depositor, claim.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(queueVaultDenom, 400), amount)
+
+	suite.AccountBalanceEqual(depositor, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 400)))
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins())
+
+	_, found := suite.Keeper.GetWithdrawalClaim(suite.Ctx, claim.ID)
+	suite.Require().False(found)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeWithdrawalClaim,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, queueVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, depositor.String()),
+		sdk.NewAttribute(types.AttributeKeyClaimID, "1"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "400"),
+	))
+
+	_, err = suite.Keeper.ClaimWithdraw(suite.Ctx, depositor, claim.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalClaimNotFound)
+}
+
+func (suite *withdrawalQueueTestSuite) TestRequestWithdraw_BelowMinimum() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedVaults[0].MinWithdrawalClaim = sdk.NewInt(100)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	depositor := suite.deposit(1000, 0)
+
+	_, err := suite.Keeper.RequestWithdraw(
+		suite.Ctx,
+		depositor,
+		sdk.NewInt64Coin(queueVaultDenom, 99),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalClaimTooSmall)
+	suite.Require().Empty(suite.Keeper.GetAllWithdrawalClaims(suite.Ctx))
+
+	suite.requestWithdraw(depositor, 100)
+}
+
+func (suite *withdrawalQueueTestSuite) TestWithdraw_ClaimsQueued() {
+	first := suite.deposit(1000, 0)
+	second := suite.deposit(1000, 1)
+
+	suite.borrowLiquidity(2000)
+	suite.requestWithdraw(first, 300)
+
+	// Repaid liquidity goes to the queue before direct withdrawals
+	suite.repayLiquidity(200)
+	_, err := suite.Keeper.Withdraw(suite.Ctx, second, sdk.NewInt64Coin(queueVaultDenom, 200), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalsQueued)
+	suite.claimFulfilledEqual(1, false)
+
+	suite.repayLiquidity(1800)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, second, sdk.NewInt64Coin(queueVaultDenom, 300), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.claimFulfilledEqual(1, true)
+	suite.AccountBalanceEqual(second, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 300)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestWithdraw_PaysSmallClaimFirst() {
+	first := suite.deposit(1000, 0)
+	second := suite.deposit(1000, 1)
+
+	suite.requestWithdraw(first, 10)
+
+	// A pending claim the strategies can pay doesn't block a direct withdrawal
+	_, err := suite.Keeper.Withdraw(suite.Ctx, second, sdk.NewInt64Coin(queueVaultDenom, 500), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.claimFulfilledEqual(1, true)
+	suite.Require().True(suite.Keeper.GetPendingWithdrawals(suite.Ctx, queueVaultDenom).IsZero())
+	suite.AccountBalanceEqual(second, sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 500)))
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 10)))
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1490)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestFulfillWithdrawalClaims_OtherStrategyPays() {
+	vault := types.NewAllowedVault(
+		queueVaultDenom,
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+		false,
+		nil,
+	)
+	vault.StrategyWeights = []sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5")}
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}, types.DefaultRebalanceThreshold))
+
+	depositor := suite.deposit(1000, 0)
+	suite.deposit(1000, 1)
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1000)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1000)))
+
+	suite.borrowLiquidity(1000)
+	suite.requestWithdraw(depositor, 300)
+
+	// The illiquid hard strategy is skipped for the savings strategy
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(1, true)
+
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 300)))
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 1000)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(queueVaultDenom, 700)))
+}
+
+func (suite *withdrawalQueueTestSuite) TestFulfillWithdrawalClaims_PerBlockLimit() {
+	depositor := suite.deposit(1000, 0)
+
+	for i := 0; i < 25; i++ {
+		suite.requestWithdraw(depositor, 10)
+	}
+
+	// At most 20 claims of a vault are fulfilled in a block
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(20, true)
+	suite.claimFulfilledEqual(21, false)
+	suite.Require().Equal(sdk.NewInt(50), suite.Keeper.GetPendingWithdrawals(suite.Ctx, queueVaultDenom))
+
+	var pending []uint64
+	suite.Keeper.IteratePendingClaimIDs(suite.Ctx, queueVaultDenom, func(id uint64) bool {
+		pending = append(pending, id)
+		return false
+	})
+	suite.Require().Equal([]uint64{21, 22, 23, 24, 25}, pending)
+
+	suite.Keeper.FulfillWithdrawalClaims(suite.Ctx)
+	suite.claimFulfilledEqual(25, true)
+	suite.Require().True(suite.Keeper.GetPendingWithdrawals(suite.Ctx, queueVaultDenom).IsZero())
+}
@@ -259,7 +259,28 @@ func (suite *Suite) CreateVault(
 	allowedVaults := suite.Keeper.GetAllowedVaults(suite.Ctx)
 	allowedVaults = append(allowedVaults, vault)
 
-	params := types.NewParams(allowedVaults)
+	params := types.NewParams(allowedVaults, types.DefaultRebalanceThreshold)
+
+	suite.Keeper.SetParams(
+		suite.Ctx,
+		params,
+	)
+}
+
+// CreateWeightedVault adds a new public vault to the keeper parameters that
+// splits its value between the strategies by the target weights
+func (suite *Suite) CreateWeightedVault(
+	vaultDenom string,
+	vaultStrategies types.StrategyTypes,
+	strategyWeights []sdk.Dec,
+) {
+	vault := types.NewAllowedVault(vaultDenom, vaultStrategies, false, nil)
+	vault.StrategyWeights = strategyWeights
+
+	allowedVaults := suite.Keeper.GetAllowedVaults(suite.Ctx)
+	allowedVaults = append(allowedVaults, vault)
+
+	params := types.NewParams(allowedVaults, types.DefaultRebalanceThreshold)
 
 	suite.Keeper.SetParams(
 		suite.Ctx,
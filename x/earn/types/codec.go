@@ -15,6 +15,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "earn/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "earn/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgRebalanceVault{}, "earn/MsgRebalanceVault", nil)
+	cdc.RegisterConcrete(&MsgRequestWithdraw{}, "earn/MsgRequestWithdraw", nil)
+	cdc.RegisterConcrete(&MsgClaimWithdraw{}, "earn/MsgClaimWithdraw", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedDepositor{}, "earn/MsgAddAllowedDepositor", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedDepositor{}, "earn/MsgRemoveAllowedDepositor", nil)
+	cdc.RegisterConcrete(&MsgTransferVaultManager{}, "earn/MsgTransferVaultManager", nil)
 	cdc.RegisterConcrete(&CommunityPoolDepositProposal{}, "kava/CommunityPoolDepositProposal", nil)
 	cdc.RegisterConcrete(&CommunityPoolWithdrawProposal{}, "kava/CommunityPoolWithdrawProposal", nil)
 }
@@ -25,6 +31,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
 		&MsgWithdraw{},
+		&MsgRebalanceVault{},
+		&MsgRequestWithdraw{},
+		&MsgClaimWithdraw{},
+		&MsgAddAllowedDepositor{},
+		&MsgRemoveAllowedDepositor{},
+		&MsgTransferVaultManager{},
 	)
 	registry.RegisterImplementations((*govv1beta1.Content)(nil),
 		&CommunityPoolDepositProposal{},
@@ -11,4 +11,16 @@ var (
 	ErrVaultRecordNotFound      = errorsmod.Register(ModuleName, 6, "vault record not found")
 	ErrVaultShareRecordNotFound = errorsmod.Register(ModuleName, 7, "vault share record not found")
 	ErrAccountDepositNotAllowed = errorsmod.Register(ModuleName, 8, "account is not allowed to deposit to this vault")
+	ErrSwapPoolNotFound         = errorsmod.Register(ModuleName, 9, "swap pool not found")
+	ErrVaultBalanced            = errorsmod.Register(ModuleName, 10, "vault is within the rebalance threshold")
+	ErrNoVaultSnapshot          = errorsmod.Register(ModuleName, 11, "no vault share price snapshot found")
+	ErrWithdrawalClaimNotFound  = errorsmod.Register(ModuleName, 12, "withdrawal claim not found")
+	ErrWithdrawalClaimPending   = errorsmod.Register(ModuleName, 13, "withdrawal claim has not been fulfilled")
+	ErrNotVaultManager          = errorsmod.Register(ModuleName, 14, "account is not the vault manager")
+	ErrDepositorAlreadyAllowed  = errorsmod.Register(ModuleName, 15, "account is already allowed to deposit to this vault")
+	ErrLastAllowedDepositor     = errorsmod.Register(ModuleName, 16, "private vault must have at least one allowed depositor")
+	ErrPriceNotFound            = errorsmod.Register(ModuleName, 17, "no price found for denom")
+	ErrSwapPoolPriceDeviation   = errorsmod.Register(ModuleName, 18, "swap pool price deviates from the oracle price")
+	ErrWithdrawalClaimTooSmall  = errorsmod.Register(ModuleName, 19, "withdrawal claim is below the vault minimum")
+	ErrWithdrawalsQueued        = errorsmod.Register(ModuleName, 20, "vault has queued withdrawals")
 )
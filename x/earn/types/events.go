@@ -2,11 +2,25 @@ package types
 
 // Event types for earn module
 const (
-	AttributeValueCategory = ModuleName
-	EventTypeVaultDeposit  = "vault_deposit"
-	EventTypeVaultWithdraw = "vault_withdraw"
-	AttributeKeyVaultDenom = "vault_denom"
-	AttributeKeyDepositor  = "depositor"
-	AttributeKeyShares     = "shares"
-	AttributeKeyOwner      = "owner"
+	AttributeValueCategory     = ModuleName
+	EventTypeVaultDeposit      = "vault_deposit"
+	EventTypeVaultWithdraw     = "vault_withdraw"
+	EventTypeVaultCompound     = "vault_compound"
+	EventTypeVaultRebalance    = "vault_rebalance"
+	EventTypeVaultFee          = "vault_fee"
+	EventTypeWithdrawalRequest = "vault_withdrawal_request"
+	EventTypeWithdrawalFulfill = "vault_withdrawal_fulfill"
+	EventTypeWithdrawalClaim   = "vault_withdrawal_claim"
+	EventTypeDepositorAllowed  = "vault_depositor_allowed"
+	EventTypeDepositorRemoved  = "vault_depositor_removed"
+	EventTypeManagerTransfer   = "vault_manager_transfer"
+	AttributeKeyVaultDenom     = "vault_denom"
+	AttributeKeyDepositor      = "depositor"
+	AttributeKeyShares         = "shares"
+	AttributeKeyOwner          = "owner"
+	AttributeKeyRewards        = "rewards"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyClaimID        = "claim_id"
+	AttributeKeyManager        = "manager"
+	AttributeKeyNewManager     = "new_manager"
 )
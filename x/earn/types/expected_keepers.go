@@ -1,12 +1,15 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	savingstypes "github.com/kava-labs/kava/x/savings/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // AccountKeeper defines the expected account keeper
@@ -20,10 +23,15 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // DistributionKeeper defines the expected interface needed for community-pool deposits to earn vaults
@@ -46,6 +54,7 @@ type HardKeeper interface {
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 
 	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetMoneyMarket(ctx sdk.Context, denom string) (hardtypes.MoneyMarket, bool)
 }
 
 // SavingsKeeper defines the expected interface needed for the savings strategy.
@@ -56,6 +65,29 @@ type SavingsKeeper interface {
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 }
 
+// SwapKeeper defines the expected interface needed for the swap LP strategy.
+type SwapKeeper interface {
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coin, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.Int, minCoinA, minCoinB sdk.Coin) error
+	SwapExactForTokensFromModule(ctx sdk.Context, senderModule string, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) (sdk.Coin, error)
+
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+	GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool)
+	GetSwapFee(ctx sdk.Context) sdk.Dec
+}
+
+// PricefeedKeeper defines the expected interface needed to value the swap LP
+// strategy's position.
+type PricefeedKeeper interface {
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+}
+
+// IncentiveKeeper defines the expected interface needed to compound the swap
+// rewards earned by the swap LP strategy.
+type IncentiveKeeper interface {
+	ClaimSwapRewardForPool(ctx sdk.Context, owner, receiver sdk.AccAddress, poolID string) (sdk.Coins, error)
+}
+
 // EarnHooks are event hooks called when a user's deposit to a earn vault changes.
 type EarnHooks interface {
 	AfterVaultDepositCreated(ctx sdk.Context, vaultDenom string, depositor sdk.AccAddress, sharesOwned sdk.Dec)
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerYear is the number of seconds the management fee is charged over.
+const SecondsPerYear = 31536000
+
+// VaultFeeCheckpointInterval is the longest time a vault's fees are left
+// uncharged before they are charged in the BeginBlocker.
+const VaultFeeCheckpointInterval = 24 * time.Hour
+
+// NewVaultFees returns a new VaultFees with the given values.
+func NewVaultFees(managementFee, performanceFee sdk.Dec, recipient sdk.AccAddress) *VaultFees {
+	return &VaultFees{
+		ManagementFee:  managementFee,
+		PerformanceFee: performanceFee,
+		Recipient:      recipient,
+	}
+}
+
+// Validate returns an error if the VaultFees are invalid.
+func (f VaultFees) Validate() error {
+	if f.ManagementFee.IsNil() || f.ManagementFee.IsNegative() || f.ManagementFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("management fee must be between 0 and 1: %s", f.ManagementFee)
+	}
+
+	if f.PerformanceFee.IsNil() || f.PerformanceFee.IsNegative() || f.PerformanceFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("performance fee must be between 0 and 1: %s", f.PerformanceFee)
+	}
+
+	// Limits the fees charged in a single accrual to less than the vault value
+	if f.ManagementFee.Add(f.PerformanceFee).GTE(sdk.OneDec()) {
+		return fmt.Errorf("combined management and performance fees must be less than 1")
+	}
+
+	if f.Recipient.Empty() {
+		return fmt.Errorf("fee recipient is empty")
+	}
+
+	return nil
+}
+
+// NewVaultFeeRecord returns a new VaultFeeRecord with the given values.
+func NewVaultFeeRecord(
+	denom string,
+	highWaterMark sdk.Dec,
+	lastAccrualTime time.Time,
+	accruedFeeShares sdk.Dec,
+) VaultFeeRecord {
+	return VaultFeeRecord{
+		Denom:            denom,
+		HighWaterMark:    highWaterMark,
+		LastAccrualTime:  lastAccrualTime,
+		AccruedFeeShares: accruedFeeShares,
+	}
+}
+
+// Validate returns an error if a VaultFeeRecord is invalid.
+func (r VaultFeeRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	if r.HighWaterMark.IsNil() || r.HighWaterMark.IsNegative() {
+		return fmt.Errorf("high-water mark cannot be negative: %s", r.HighWaterMark)
+	}
+
+	if r.AccruedFeeShares.IsNil() || r.AccruedFeeShares.IsNegative() {
+		return fmt.Errorf("accrued fee shares cannot be negative: %s", r.AccruedFeeShares)
+	}
+
+	return nil
+}
+
+// VaultFeeRecords is a slice of VaultFeeRecord.
+type VaultFeeRecords []VaultFeeRecord
+
+// Validate returns an error if a slice of VaultFeeRecords is invalid.
+func (rs VaultFeeRecords) Validate() error {
+	denoms := make(map[string]bool)
+
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[r.Denom] {
+			return fmt.Errorf("duplicate vault fee record denom %s", r.Denom)
+		}
+
+		denoms[r.Denom] = true
+	}
+
+	return nil
+}
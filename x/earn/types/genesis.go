@@ -1,15 +1,25 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
 	vaultRecords VaultRecords,
 	vaultShareRecords VaultShareRecords,
+	vaultFeeRecords VaultFeeRecords,
+	vaultSharePriceSnapshots VaultSharePriceSnapshots,
+	withdrawalClaims WithdrawalClaims,
+	nextWithdrawalClaimID uint64,
 ) GenesisState {
 	return GenesisState{
-		Params:            params,
-		VaultRecords:      vaultRecords,
-		VaultShareRecords: vaultShareRecords,
+		Params:                   params,
+		VaultRecords:             vaultRecords,
+		VaultShareRecords:        vaultShareRecords,
+		VaultFeeRecords:          vaultFeeRecords,
+		VaultSharePriceSnapshots: vaultSharePriceSnapshots,
+		WithdrawalClaims:         withdrawalClaims,
+		NextWithdrawalClaimID:    nextWithdrawalClaimID,
 	}
 }
 
@@ -27,6 +37,27 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.VaultFeeRecords.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.VaultSharePriceSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.WithdrawalClaims.Validate(); err != nil {
+		return err
+	}
+
+	for _, claim := range gs.WithdrawalClaims {
+		if claim.ID >= gs.NextWithdrawalClaimID {
+			return fmt.Errorf(
+				"withdrawal claim id %d must be less than the next withdrawal claim id %d",
+				claim.ID, gs.NextWithdrawalClaimID,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -36,5 +67,9 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		VaultRecords{},
 		VaultShareRecords{},
+		VaultFeeRecords{},
+		VaultSharePriceSnapshots{},
+		WithdrawalClaims{},
+		DefaultNextWithdrawalClaimID,
 	)
 }
@@ -31,6 +31,14 @@ type GenesisState struct {
 	VaultRecords VaultRecords `protobuf:"bytes,2,rep,name=vault_records,json=vaultRecords,proto3,castrepeated=VaultRecords" json:"vault_records"`
 	// share_records defines the owned shares of each vault
 	VaultShareRecords VaultShareRecords `protobuf:"bytes,3,rep,name=vault_share_records,json=vaultShareRecords,proto3,castrepeated=VaultShareRecords" json:"vault_share_records"`
+	// vault_fee_records defines the fee accrual state of each vault
+	VaultFeeRecords VaultFeeRecords `protobuf:"bytes,4,rep,name=vault_fee_records,json=vaultFeeRecords,proto3,castrepeated=VaultFeeRecords" json:"vault_fee_records"`
+	// vault_share_price_snapshots defines the share price history of each vault
+	VaultSharePriceSnapshots VaultSharePriceSnapshots `protobuf:"bytes,5,rep,name=vault_share_price_snapshots,json=vaultSharePriceSnapshots,proto3,castrepeated=VaultSharePriceSnapshots" json:"vault_share_price_snapshots"`
+	// withdrawal_claims defines the queued withdrawals of each vault
+	WithdrawalClaims WithdrawalClaims `protobuf:"bytes,6,rep,name=withdrawal_claims,json=withdrawalClaims,proto3,castrepeated=WithdrawalClaims" json:"withdrawal_claims"`
+	// next_withdrawal_claim_id defines the id of the next withdrawal claim
+	NextWithdrawalClaimID uint64 `protobuf:"varint,7,opt,name=next_withdrawal_claim_id,json=nextWithdrawalClaimId,proto3" json:"next_withdrawal_claim_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +95,34 @@ func (m *GenesisState) GetVaultShareRecords() VaultShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetVaultFeeRecords() VaultFeeRecords {
+	if m != nil {
+		return m.VaultFeeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaultSharePriceSnapshots() VaultSharePriceSnapshots {
+	if m != nil {
+		return m.VaultSharePriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalClaims() WithdrawalClaims {
+	if m != nil {
+		return m.WithdrawalClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextWithdrawalClaimID() uint64 {
+	if m != nil {
+		return m.NextWithdrawalClaimID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.earn.v1beta1.GenesisState")
 }
@@ -94,26 +130,35 @@ func init() {
 func init() { proto.RegisterFile("kava/earn/v1beta1/genesis.proto", fileDescriptor_514fe130cb964f8c) }
 
 var fileDescriptor_514fe130cb964f8c = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc5,
-	0x94, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0x81, 0x48, 0x2b, 0x4d, 0x62, 0xe2, 0xe2, 0x71, 0x87, 0xd8,
-	0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0xd1, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xa9, 0x87, 0xe1, 0x12, 0xbd, 0x00, 0xb0, 0x02, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xa0, 0xca, 0x85, 0x22, 0xb9, 0x78, 0xc1, 0x06, 0xc7, 0x17, 0xa5, 0x26, 0xe7, 0x17,
-	0xa5, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0x61, 0xd1, 0x1f, 0x06, 0x52, 0x17,
-	0x04, 0x56, 0xe6, 0x24, 0x02, 0x32, 0x64, 0xd5, 0x7d, 0x79, 0x1e, 0x24, 0xc1, 0xe2, 0x20, 0x9e,
-	0x32, 0x24, 0x9e, 0x50, 0x1e, 0x97, 0x30, 0xc4, 0xe8, 0xe2, 0x8c, 0xc4, 0xa2, 0x54, 0xb8, 0x05,
-	0xcc, 0x60, 0x0b, 0x94, 0x71, 0x59, 0x10, 0x0c, 0x52, 0x0c, 0xb5, 0x45, 0x12, 0x6a, 0x8b, 0x20,
-	0xba, 0x4c, 0x71, 0x90, 0x60, 0x19, 0xba, 0x90, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x83, 0xac, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b, 0x20, 0x81, 0x5c, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x54,
-	0x3b, 0x56, 0xe8, 0x01, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xd6, 0x15, 0xc9, 0x2b, 0xda, 0x6a, 0x36, 0xe1, 0x16, 0xe1, 0x96, 0x21, 0xa1,
+	0x0a, 0x89, 0x44, 0x1b, 0x07, 0xae, 0x28, 0x20, 0x10, 0x17, 0x34, 0xb9, 0x12, 0x08, 0x2e, 0x91,
+	0x9b, 0x78, 0x49, 0x44, 0x9a, 0x44, 0xb6, 0x97, 0x96, 0x67, 0xe0, 0xc2, 0x73, 0xf0, 0x22, 0xec,
+	0xb8, 0x23, 0xa7, 0x81, 0xd2, 0x17, 0x41, 0x76, 0xcc, 0x68, 0xd3, 0x86, 0x5b, 0xf2, 0xff, 0x7e,
+	0xdf, 0xff, 0x67, 0x1f, 0x0c, 0x86, 0x9f, 0x69, 0x41, 0x1d, 0x46, 0x79, 0xea, 0x14, 0x27, 0x53,
+	0x26, 0xe9, 0x89, 0x13, 0xb2, 0x94, 0x89, 0x58, 0xd8, 0x39, 0xcf, 0x64, 0x06, 0x7b, 0x0a, 0xb0,
+	0x15, 0x60, 0x1b, 0x60, 0x70, 0x18, 0x66, 0x61, 0xa6, 0xa7, 0x8e, 0xfa, 0xaa, 0xc0, 0x01, 0xde,
+	0x6c, 0xca, 0x29, 0xa7, 0x33, 0x53, 0x34, 0x78, 0xb0, 0x39, 0x2f, 0xe8, 0x45, 0x22, 0xab, 0xf1,
+	0xf1, 0x8f, 0x5d, 0xd0, 0x7d, 0x53, 0x99, 0x27, 0x92, 0x4a, 0x06, 0x9f, 0x83, 0x4e, 0xb5, 0x8f,
+	0xac, 0x91, 0x35, 0xde, 0x3b, 0xed, 0xdb, 0x1b, 0x27, 0xb1, 0xcf, 0x34, 0xe0, 0xb6, 0x2f, 0xaf,
+	0x87, 0x2d, 0x62, 0x70, 0xf8, 0x11, 0xdc, 0xd1, 0xc5, 0x1e, 0x67, 0x7e, 0xc6, 0x03, 0x81, 0x6e,
+	0x8d, 0x76, 0xc6, 0x7b, 0xa7, 0x78, 0xcb, 0xfe, 0x7b, 0xc5, 0x11, 0x8d, 0xb9, 0x87, 0xaa, 0xe4,
+	0xfb, 0xaf, 0x61, 0x77, 0x25, 0x14, 0xa4, 0x5b, 0xac, 0xfc, 0xc1, 0x14, 0xdc, 0xad, 0xaa, 0x45,
+	0x44, 0x39, 0xbb, 0x11, 0xec, 0x68, 0xc1, 0xa3, 0x26, 0xc1, 0x44, 0xc1, 0xc6, 0xd2, 0x37, 0x96,
+	0x5e, 0x7d, 0x22, 0x48, 0xaf, 0xa8, 0x47, 0xf0, 0x1c, 0x54, 0xa1, 0x77, 0xce, 0xfe, 0xd9, 0xda,
+	0xda, 0xf6, 0xb0, 0xc9, 0xf6, 0x9a, 0xfd, 0x75, 0xdd, 0x33, 0xae, 0xfd, 0xf5, 0x5c, 0x90, 0xfd,
+	0x62, 0x3d, 0x80, 0x5f, 0x2d, 0x70, 0x7f, 0xf5, 0x62, 0x39, 0x8f, 0x7d, 0xe6, 0x89, 0x94, 0xe6,
+	0x22, 0xca, 0xa4, 0x40, 0xbb, 0x5a, 0xf9, 0xe4, 0xbf, 0x17, 0x3c, 0x53, 0x3b, 0x13, 0xb3, 0xe2,
+	0x8e, 0x8c, 0x1b, 0x35, 0x00, 0x82, 0xa0, 0xa2, 0x61, 0x02, 0x63, 0xd0, 0x9b, 0xc7, 0x32, 0x0a,
+	0x38, 0x9d, 0xd3, 0xc4, 0xf3, 0x13, 0x1a, 0xcf, 0x04, 0xea, 0xe8, 0x23, 0x1c, 0x6f, 0x39, 0xc2,
+	0x87, 0x1b, 0xf6, 0xa5, 0x42, 0x5d, 0x64, 0xd4, 0x07, 0xb5, 0x81, 0x20, 0x07, 0xf3, 0x5a, 0x02,
+	0x09, 0x40, 0x29, 0x5b, 0x48, 0xaf, 0xee, 0xf3, 0xe2, 0x00, 0xdd, 0x1e, 0x59, 0xe3, 0xb6, 0xdb,
+	0x2f, 0xaf, 0x87, 0x47, 0xef, 0xd8, 0x42, 0xd6, 0xda, 0xde, 0xbe, 0x22, 0x47, 0xe9, 0x96, 0x38,
+	0x70, 0x5f, 0x5c, 0x96, 0xd8, 0xba, 0x2a, 0xb1, 0xf5, 0xbb, 0xc4, 0xd6, 0xb7, 0x25, 0x6e, 0x5d,
+	0x2d, 0x71, 0xeb, 0xe7, 0x12, 0xb7, 0x3e, 0x3d, 0x0e, 0x63, 0x19, 0x5d, 0x4c, 0x6d, 0x3f, 0x9b,
+	0x39, 0xea, 0x1e, 0x4f, 0x13, 0x3a, 0x15, 0xfa, 0xcb, 0x59, 0x54, 0x2f, 0x43, 0x7e, 0xc9, 0x99,
+	0x98, 0x76, 0xf4, 0x93, 0x78, 0xf6, 0x67, 0x00, 0x26, 0xbe, 0x9e, 0x0d, 0x9d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +181,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextWithdrawalClaimID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalClaimID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WithdrawalClaims) > 0 {
+		for iNdEx := len(m.WithdrawalClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VaultSharePriceSnapshots) > 0 {
+		for iNdEx := len(m.VaultSharePriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultSharePriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VaultFeeRecords) > 0 {
+		for iNdEx := len(m.VaultFeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultFeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.VaultShareRecords) > 0 {
 		for iNdEx := len(m.VaultShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +300,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VaultFeeRecords) > 0 {
+		for _, e := range m.VaultFeeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultSharePriceSnapshots) > 0 {
+		for _, e := range m.VaultSharePriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawalClaims) > 0 {
+		for _, e := range m.WithdrawalClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextWithdrawalClaimID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalClaimID))
+	}
 	return n
 }
 
@@ -347,6 +460,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultFeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultFeeRecords = append(m.VaultFeeRecords, VaultFeeRecord{})
+			if err := m.VaultFeeRecords[len(m.VaultFeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultSharePriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultSharePriceSnapshots = append(m.VaultSharePriceSnapshots, VaultSharePriceSnapshot{})
+			if err := m.VaultSharePriceSnapshots[len(m.VaultSharePriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalClaims = append(m.WithdrawalClaims, WithdrawalClaim{})
+			if err := m.WithdrawalClaims[len(m.WithdrawalClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalClaimID", wireType)
+			}
+			m.NextWithdrawalClaimID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalClaimID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -21,8 +24,14 @@ const (
 
 // key prefixes for store
 var (
-	VaultRecordKeyPrefix      = []byte{0x01} // denom -> vault
-	VaultShareRecordKeyPrefix = []byte{0x02} // depositor address -> vault shares
+	VaultRecordKeyPrefix       = []byte{0x01} // denom -> vault
+	VaultShareRecordKeyPrefix  = []byte{0x02} // depositor address -> vault shares
+	VaultFeeRecordKeyPrefix    = []byte{0x03} // denom -> vault fee accrual state
+	VaultSnapshotKeyPrefix     = []byte{0x04} // denom + slot -> vault share price snapshot
+	WithdrawalClaimKeyPrefix   = []byte{0x05} // claim id -> withdrawal claim
+	PendingWithdrawalKeyPrefix = []byte{0x06} // denom -> total amount of unfulfilled withdrawal claims
+	NextWithdrawalClaimIDKey   = []byte{0x07} // key for the next withdrawal claim id
+	PendingClaimKeyPrefix      = []byte{0x08} // denom + claim id -> empty, for unfulfilled withdrawal claims
 )
 
 // VaultKey returns a key generated from a vault denom
@@ -34,3 +43,32 @@ func VaultKey(denom string) []byte {
 func DepositorVaultSharesKey(depositor sdk.AccAddress) []byte {
 	return depositor.Bytes()
 }
+
+// VaultSnapshotsKey returns the key prefix of the share price snapshots of a
+// vault
+func VaultSnapshotsKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// VaultSnapshotKey returns the key of a slot of the share price snapshot ring
+// buffer of a vault
+func VaultSnapshotKey(denom string, slot uint64) []byte {
+	return append(VaultSnapshotsKey(denom), sdk.Uint64ToBigEndian(slot)...)
+}
+
+// WithdrawalClaimKey returns a key from a withdrawal claim id
+func WithdrawalClaimKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PendingClaimsKey returns the key prefix of the unfulfilled withdrawal claims
+// of a vault
+func PendingClaimsKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// PendingClaimKey returns the key of an unfulfilled withdrawal claim in the
+// index of its vault
+func PendingClaimKey(denom string, id uint64) []byte {
+	return append(PendingClaimsKey(denom), sdk.Uint64ToBigEndian(id)...)
+}
@@ -10,14 +10,32 @@ import (
 var (
 	_ sdk.Msg            = &MsgDeposit{}
 	_ sdk.Msg            = &MsgWithdraw{}
+	_ sdk.Msg            = &MsgRebalanceVault{}
+	_ sdk.Msg            = &MsgRequestWithdraw{}
+	_ sdk.Msg            = &MsgClaimWithdraw{}
+	_ sdk.Msg            = &MsgAddAllowedDepositor{}
+	_ sdk.Msg            = &MsgRemoveAllowedDepositor{}
+	_ sdk.Msg            = &MsgTransferVaultManager{}
 	_ legacytx.LegacyMsg = &MsgDeposit{}
 	_ legacytx.LegacyMsg = &MsgWithdraw{}
+	_ legacytx.LegacyMsg = &MsgRebalanceVault{}
+	_ legacytx.LegacyMsg = &MsgRequestWithdraw{}
+	_ legacytx.LegacyMsg = &MsgClaimWithdraw{}
+	_ legacytx.LegacyMsg = &MsgAddAllowedDepositor{}
+	_ legacytx.LegacyMsg = &MsgRemoveAllowedDepositor{}
+	_ legacytx.LegacyMsg = &MsgTransferVaultManager{}
 )
 
 // legacy message types
 const (
-	TypeMsgDeposit  = "earn_msg_deposit"
-	TypeMsgWithdraw = "earn_msg_withdraw"
+	TypeMsgDeposit                = "earn_msg_deposit"
+	TypeMsgWithdraw               = "earn_msg_withdraw"
+	TypeMsgRebalanceVault         = "earn_msg_rebalance_vault"
+	TypeMsgRequestWithdraw        = "earn_msg_request_withdraw"
+	TypeMsgClaimWithdraw          = "earn_msg_claim_withdraw"
+	TypeMsgAddAllowedDepositor    = "earn_msg_add_allowed_depositor"
+	TypeMsgRemoveAllowedDepositor = "earn_msg_remove_allowed_depositor"
+	TypeMsgTransferVaultManager   = "earn_msg_transfer_vault_manager"
 )
 
 // NewMsgDeposit returns a new MsgDeposit.
@@ -35,6 +53,12 @@ func (msg MsgDeposit) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
 	if err := msg.Amount.Validate(); err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
 	}
@@ -87,6 +111,12 @@ func (msg MsgWithdraw) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
 	if err := msg.Amount.Validate(); err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
 	}
@@ -123,3 +153,305 @@ func (msg MsgWithdraw) Route() string {
 func (msg MsgWithdraw) Type() string {
 	return TypeMsgWithdraw
 }
+
+// NewMsgRebalanceVault returns a new MsgRebalanceVault.
+func NewMsgRebalanceVault(signer string, denom string) *MsgRebalanceVault {
+	return &MsgRebalanceVault{
+		Signer: signer,
+		Denom:  denom,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRebalanceVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRebalanceVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRebalanceVault) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRebalanceVault) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRebalanceVault) Type() string {
+	return TypeMsgRebalanceVault
+}
+
+// NewMsgRequestWithdraw returns a new MsgRequestWithdraw.
+func NewMsgRequestWithdraw(from string, amount sdk.Coin, strategy StrategyType) *MsgRequestWithdraw {
+	return &MsgRequestWithdraw{
+		From:     from,
+		Amount:   amount,
+		Strategy: strategy,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRequestWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if err := msg.Strategy.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRequestWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRequestWithdraw) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRequestWithdraw) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRequestWithdraw) Type() string {
+	return TypeMsgRequestWithdraw
+}
+
+// NewMsgClaimWithdraw returns a new MsgClaimWithdraw.
+func NewMsgClaimWithdraw(owner string, claimID uint64) *MsgClaimWithdraw {
+	return &MsgClaimWithdraw{
+		Owner:   owner,
+		ClaimID: claimID,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgClaimWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.ClaimID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "claim id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimWithdraw) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgClaimWithdraw) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgClaimWithdraw) Type() string {
+	return TypeMsgClaimWithdraw
+}
+
+// NewMsgAddAllowedDepositor returns a new MsgAddAllowedDepositor.
+func NewMsgAddAllowedDepositor(manager string, denom string, depositor string) *MsgAddAllowedDepositor {
+	return &MsgAddAllowedDepositor{
+		Manager:   manager,
+		Denom:     denom,
+		Depositor: depositor,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgAddAllowedDepositor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgAddAllowedDepositor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgAddAllowedDepositor) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{manager}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgAddAllowedDepositor) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgAddAllowedDepositor) Type() string {
+	return TypeMsgAddAllowedDepositor
+}
+
+// NewMsgRemoveAllowedDepositor returns a new MsgRemoveAllowedDepositor.
+func NewMsgRemoveAllowedDepositor(manager string, denom string, depositor string) *MsgRemoveAllowedDepositor {
+	return &MsgRemoveAllowedDepositor{
+		Manager:   manager,
+		Denom:     denom,
+		Depositor: depositor,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRemoveAllowedDepositor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRemoveAllowedDepositor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRemoveAllowedDepositor) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{manager}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRemoveAllowedDepositor) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRemoveAllowedDepositor) Type() string {
+	return TypeMsgRemoveAllowedDepositor
+}
+
+// NewMsgTransferVaultManager returns a new MsgTransferVaultManager.
+func NewMsgTransferVaultManager(manager string, denom string, newManager string) *MsgTransferVaultManager {
+	return &MsgTransferVaultManager{
+		Manager:    manager,
+		Denom:      denom,
+		NewManager: newManager,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferVaultManager) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewManager); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new manager: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferVaultManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferVaultManager) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{manager}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgTransferVaultManager) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgTransferVaultManager) Type() string {
+	return TypeMsgTransferVaultManager
+}
@@ -3,25 +3,29 @@ package types
 import (
 	fmt "fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys and default values
 var (
-	KeyAllowedVaults     = []byte("AllowedVaults")
-	DefaultAllowedVaults = AllowedVaults{}
+	KeyAllowedVaults          = []byte("AllowedVaults")
+	KeyRebalanceThreshold     = []byte("RebalanceThreshold")
+	DefaultAllowedVaults      = AllowedVaults{}
+	DefaultRebalanceThreshold = sdk.MustNewDecFromStr("0.05")
 )
 
 // NewParams returns a new params object
-func NewParams(allowedVaults AllowedVaults) Params {
+func NewParams(allowedVaults AllowedVaults, rebalanceThreshold sdk.Dec) Params {
 	return Params{
-		AllowedVaults: allowedVaults,
+		AllowedVaults:      allowedVaults,
+		RebalanceThreshold: rebalanceThreshold,
 	}
 }
 
 // DefaultParams returns default params for earn module
 func DefaultParams() Params {
-	return NewParams(DefaultAllowedVaults)
+	return NewParams(DefaultAllowedVaults, DefaultRebalanceThreshold)
 }
 
 // ParamKeyTable for earn module.
@@ -33,12 +37,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedVaults, &p.AllowedVaults, validateAllowedVaultsParams),
+		paramtypes.NewParamSetPair(KeyRebalanceThreshold, &p.RebalanceThreshold, validateRebalanceThresholdParam),
 	}
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
-	return p.AllowedVaults.Validate()
+	if err := p.AllowedVaults.Validate(); err != nil {
+		return err
+	}
+
+	return validateRebalanceThresholdParam(p.RebalanceThreshold)
 }
 
 func validateAllowedVaultsParams(i interface{}) error {
@@ -49,3 +58,20 @@ func validateAllowedVaultsParams(i interface{}) error {
 
 	return p.Validate()
 }
+
+func validateRebalanceThresholdParam(i interface{}) error {
+	threshold, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if threshold.IsNil() {
+		return fmt.Errorf("rebalance threshold cannot be nil")
+	}
+
+	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("rebalance threshold must be between 0 and 1: %s", threshold)
+	}
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package types
 
-import "github.com/cosmos/cosmos-sdk/types/query"
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
 
 // NewQueryParamsRequest returns a new QueryParamsRequest
 func NewQueryParamsRequest() *QueryParamsRequest {
@@ -33,3 +37,39 @@ func NewQueryDepositsRequest(
 		Pagination:          pagination,
 	}
 }
+
+// NewQueryVaultFeesRequest returns a new QueryVaultFeesRequest
+func NewQueryVaultFeesRequest(denom string) *QueryVaultFeesRequest {
+	return &QueryVaultFeesRequest{
+		Denom: denom,
+	}
+}
+
+// NewQueryVaultHistoryRequest returns a new QueryVaultHistoryRequest
+func NewQueryVaultHistoryRequest(denom string, window time.Duration) *QueryVaultHistoryRequest {
+	return &QueryVaultHistoryRequest{
+		Denom:  denom,
+		Window: window,
+	}
+}
+
+// NewQueryVaultApyRequest returns a new QueryVaultApyRequest
+func NewQueryVaultApyRequest(denom string, window time.Duration) *QueryVaultApyRequest {
+	return &QueryVaultApyRequest{
+		Denom:  denom,
+		Window: window,
+	}
+}
+
+// NewQueryWithdrawalClaimsRequest returns a new QueryWithdrawalClaimsRequest
+func NewQueryWithdrawalClaimsRequest(
+	owner string,
+	denom string,
+	pagination *query.PageRequest,
+) *QueryWithdrawalClaimsRequest {
+	return &QueryWithdrawalClaimsRequest{
+		Owner:      owner,
+		Denom:      denom,
+		Pagination: pagination,
+	}
+}
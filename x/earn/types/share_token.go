@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ShareDenomPrefix is the prefix of the bank denoms of tokenized vault
+	// shares.
+	ShareDenomPrefix = "erc/earn-"
+	// ShareDenomSuffix is the suffix of the bank denoms of tokenized vault
+	// shares.
+	ShareDenomSuffix = "-share"
+)
+
+// ShareDenom returns the bank denom of the tokenized shares of the vault with
+// the denom, e.g. erc/earn-usdx-share.
+func ShareDenom(vaultDenom string) string {
+	return fmt.Sprintf("%s%s%s", ShareDenomPrefix, vaultDenom, ShareDenomSuffix)
+}
+
+// ParseShareDenom returns the vault denom of a tokenized share denom, and false
+// if the denom is not a share denom.
+func ParseShareDenom(denom string) (string, bool) {
+	if !strings.HasPrefix(denom, ShareDenomPrefix) || !strings.HasSuffix(denom, ShareDenomSuffix) {
+		return "", false
+	}
+
+	vaultDenom := strings.TrimSuffix(strings.TrimPrefix(denom, ShareDenomPrefix), ShareDenomSuffix)
+	if vaultDenom == "" {
+		return "", false
+	}
+
+	return vaultDenom, true
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+func TestShareDenom(t *testing.T) {
+	require.Equal(t, "erc/earn-usdx-share", types.ShareDenom("usdx"))
+	require.Equal(t, "erc/earn-bkava-kavavaloper1xyz-share", types.ShareDenom("bkava-kavavaloper1xyz"))
+}
+
+func TestParseShareDenom(t *testing.T) {
+	tests := []struct {
+		name       string
+		denom      string
+		vaultDenom string
+		ok         bool
+	}{
+		{"share denom", "erc/earn-usdx-share", "usdx", true},
+		{"bkava share denom", "erc/earn-bkava-kavavaloper1xyz-share", "bkava-kavavaloper1xyz", true},
+		{"vault denom", "usdx", "", false},
+		{"missing suffix", "erc/earn-usdx", "", false},
+		{"empty vault denom", "erc/earn--share", "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vaultDenom, ok := types.ParseShareDenom(tc.denom)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.vaultDenom, vaultDenom)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// VaultSnapshotGranularity is the period each share price snapshot covers.
+	// At most one snapshot is recorded per vault per period.
+	VaultSnapshotGranularity = 6 * time.Hour
+	// VaultSnapshotRetention is how long share price snapshots are kept.
+	VaultSnapshotRetention = 180 * 24 * time.Hour
+)
+
+// VaultSnapshotPeriod returns the index of the snapshot period containing t.
+func VaultSnapshotPeriod(t time.Time) uint64 {
+	return uint64(t.UnixNano() / int64(VaultSnapshotGranularity))
+}
+
+// VaultSnapshotSlot returns the position in a vault's ring buffer of the
+// snapshot recorded at t.
+func VaultSnapshotSlot(t time.Time) uint64 {
+	return VaultSnapshotPeriod(t) % uint64(VaultSnapshotRetention/VaultSnapshotGranularity)
+}
+
+// NewVaultSharePriceSnapshot returns a new VaultSharePriceSnapshot.
+func NewVaultSharePriceSnapshot(denom string, sharePrice sdk.Dec, t time.Time) VaultSharePriceSnapshot {
+	return VaultSharePriceSnapshot{
+		Denom:      denom,
+		SharePrice: sharePrice,
+		Time:       t,
+	}
+}
+
+// Validate returns an error if a VaultSharePriceSnapshot is invalid.
+func (s VaultSharePriceSnapshot) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.SharePrice.IsNil() || !s.SharePrice.IsPositive() {
+		return fmt.Errorf("share price must be positive: %s", s.SharePrice)
+	}
+
+	if s.Time.Unix() <= 0 {
+		return fmt.Errorf("snapshot time cannot be zero")
+	}
+
+	return nil
+}
+
+// VaultSharePriceSnapshots is a slice of VaultSharePriceSnapshot.
+type VaultSharePriceSnapshots []VaultSharePriceSnapshot
+
+// Validate returns an error if a slice of VaultSharePriceSnapshots is invalid.
+func (ss VaultSharePriceSnapshots) Validate() error {
+	slots := make(map[string]bool)
+
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", s.Denom, VaultSnapshotSlot(s.Time))
+		if slots[key] {
+			return fmt.Errorf("duplicate share price snapshot for vault %s at %s", s.Denom, s.Time)
+		}
+
+		slots[key] = true
+	}
+
+	return nil
+}
+
+// SortByTime sorts the snapshots from oldest to newest.
+func (ss VaultSharePriceSnapshots) SortByTime() {
+	sort.Slice(ss, func(i, j int) bool { return ss[i].Time.Before(ss[j].Time) })
+}
+
+// Apy returns the change in share price from start to end, annualized
+// without compounding.
+func Apy(start, end VaultSharePriceSnapshot) (sdk.Dec, error) {
+	elapsed := end.Time.Sub(start.Time)
+	if elapsed <= 0 {
+		return sdk.Dec{}, fmt.Errorf("end time %s must be after start time %s", end.Time, start.Time)
+	}
+
+	change := end.SharePrice.Quo(start.SharePrice).Sub(sdk.OneDec())
+
+	return change.MulInt64(int64(SecondsPerYear * time.Second)).QuoInt64(int64(elapsed)), nil
+}
@@ -7,7 +7,7 @@ import (
 
 // IsValid returns true if the StrategyType status is valid and false otherwise.
 func (s StrategyType) IsValid() bool {
-	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS
+	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS || s == STRATEGY_TYPE_SWAP_LP
 }
 
 // Validate returns an error if the StrategyType is invalid.
@@ -26,6 +26,8 @@ func NewStrategyTypeFromString(str string) StrategyType {
 		return STRATEGY_TYPE_HARD
 	case "savings":
 		return STRATEGY_TYPE_SAVINGS
+	case "swap_lp":
+		return STRATEGY_TYPE_SWAP_LP
 	default:
 		return STRATEGY_TYPE_UNSPECIFIED
 	}
@@ -40,10 +42,6 @@ func (strategies StrategyTypes) Validate() error {
 		return fmt.Errorf("empty StrategyTypes")
 	}
 
-	if len(strategies) != 1 {
-		return fmt.Errorf("must have exactly one strategy type, multiple strategies are not supported")
-	}
-
 	uniqueStrategies := make(map[StrategyType]bool)
 
 	for _, strategy := range strategies {
@@ -23,6 +23,11 @@ func TestNewStrategyTypeFromString(t *testing.T) {
 			strategy: "savings",
 			expected: types.STRATEGY_TYPE_SAVINGS,
 		},
+		{
+			name:     "swap lp",
+			strategy: "swap_lp",
+			expected: types.STRATEGY_TYPE_SWAP_LP,
+		},
 		{
 			name:     "unspecified",
 			strategy: "not a valid strategy name",
@@ -73,8 +78,7 @@ func TestValidateStrategyTypes(t *testing.T) {
 			},
 			errArgs: errArgs{
 				expectPass: false,
-				// This will change to duplicate error if multiple strategies are supported
-				contains: "must have exactly one strategy type, multiple strategies are not supported",
+				contains:   "duplicate strategy STRATEGY_TYPE_SAVINGS",
 			},
 		},
 		{
@@ -94,14 +98,13 @@ func TestValidateStrategyTypes(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid - more than 1",
+			name: "valid - more than 1",
 			strategies: types.StrategyTypes{
 				types.STRATEGY_TYPE_HARD,
 				types.STRATEGY_TYPE_SAVINGS,
 			},
 			errArgs: errArgs{
-				expectPass: false,
-				contains:   "must have exactly one strategy type, multiple strategies are not supported",
+				expectPass: true,
 			},
 		},
 	}
@@ -5,6 +5,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // NewVaultRecord returns a new VaultRecord with 0 supply.
@@ -93,10 +95,13 @@ func NewAllowedVault(
 	allowedDepositors []sdk.AccAddress,
 ) AllowedVault {
 	return AllowedVault{
-		Denom:             denom,
-		Strategies:        strategyTypes,
-		IsPrivateVault:    isPrivateVault,
-		AllowedDepositors: allowedDepositors,
+		Denom:                 denom,
+		Strategies:            strategyTypes,
+		IsPrivateVault:        isPrivateVault,
+		AllowedDepositors:     allowedDepositors,
+		MinWithdrawalClaim:    sdk.ZeroInt(),
+		SwapSlippageLimit:     sdk.ZeroDec(),
+		SwapMaxPriceDeviation: sdk.ZeroDec(),
 	}
 }
 
@@ -116,7 +121,136 @@ func (a *AllowedVault) Validate() error {
 		return fmt.Errorf("non-private vaults cannot have any AllowedDepositors")
 	}
 
-	return a.Strategies.Validate()
+	// Manager -> private
+	if !a.Manager.Empty() {
+		if !a.IsPrivateVault {
+			return fmt.Errorf("only private vaults can have a Manager")
+		}
+		if err := sdk.VerifyAddressFormat(a.Manager); err != nil {
+			return fmt.Errorf("invalid vault manager: %w", err)
+		}
+	}
+
+	// Swap LP strategy <-> swap pair denom and swap limits
+	if a.IsStrategyAllowed(STRATEGY_TYPE_SWAP_LP) {
+		if err := sdk.ValidateDenom(a.SwapPairDenom); err != nil {
+			return fmt.Errorf("swap LP vaults require a valid SwapPairDenom: %w", err)
+		}
+		if a.SwapPairDenom == a.Denom {
+			return fmt.Errorf("SwapPairDenom cannot be the vault denom %s", a.Denom)
+		}
+		if !isSwapLimit(a.SwapSlippageLimit) {
+			return fmt.Errorf("swap LP vaults require a SwapSlippageLimit between 0 and 1: %s", a.SwapSlippageLimit)
+		}
+		if !isSwapLimit(a.SwapMaxPriceDeviation) {
+			return fmt.Errorf("swap LP vaults require a SwapMaxPriceDeviation between 0 and 1: %s", a.SwapMaxPriceDeviation)
+		}
+	} else {
+		if a.SwapPairDenom != "" {
+			return fmt.Errorf("only swap LP vaults can have a SwapPairDenom")
+		}
+		// Unset limits from before the fields existed are nil
+		if !a.SwapSlippageLimit.IsNil() && !a.SwapSlippageLimit.IsZero() {
+			return fmt.Errorf("only swap LP vaults can have a SwapSlippageLimit")
+		}
+		if !a.SwapMaxPriceDeviation.IsNil() && !a.SwapMaxPriceDeviation.IsZero() {
+			return fmt.Errorf("only swap LP vaults can have a SwapMaxPriceDeviation")
+		}
+	}
+
+	if err := a.Strategies.Validate(); err != nil {
+		return err
+	}
+
+	if a.Fees != nil {
+		if err := a.Fees.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if a.TokenizeShares {
+		// Share coins could be held by accounts outside AllowedDepositors
+		if a.IsPrivateVault {
+			return fmt.Errorf("private vaults cannot tokenize shares")
+		}
+		if err := sdk.ValidateDenom(ShareDenom(a.Denom)); err != nil {
+			return fmt.Errorf("invalid share denom for vault %s: %w", a.Denom, err)
+		}
+	}
+
+	// Unset minimums from before the field existed allow any amount
+	if !a.MinWithdrawalClaim.IsNil() && a.MinWithdrawalClaim.IsNegative() {
+		return fmt.Errorf("MinWithdrawalClaim cannot be negative: %s", a.MinWithdrawalClaim)
+	}
+
+	return a.validateStrategyWeights()
+}
+
+// IsBelowMinWithdrawalClaim returns true if an amount is too small for a queued
+// withdrawal from the vault.
+func (a *AllowedVault) IsBelowMinWithdrawalClaim(amount sdk.Int) bool {
+	if a.MinWithdrawalClaim.IsNil() {
+		return false
+	}
+
+	return amount.LT(a.MinWithdrawalClaim)
+}
+
+// isSwapLimit returns true if a swap LP slippage or price deviation limit is
+// set and between 0 and 1, exclusive.
+func isSwapLimit(limit sdk.Dec) bool {
+	return !limit.IsNil() && limit.IsPositive() && limit.LT(sdk.OneDec())
+}
+
+// validateStrategyWeights returns an error if the strategy weights are not
+// positive and summing to 1, with one weight for each strategy.
+func (a *AllowedVault) validateStrategyWeights() error {
+	// A single strategy has an implicit weight of 1
+	if len(a.StrategyWeights) == 0 {
+		if len(a.Strategies) != 1 {
+			return fmt.Errorf("vaults with multiple strategies require StrategyWeights")
+		}
+
+		return nil
+	}
+
+	if len(a.StrategyWeights) != len(a.Strategies) {
+		return fmt.Errorf(
+			"number of StrategyWeights %d does not match number of Strategies %d",
+			len(a.StrategyWeights), len(a.Strategies),
+		)
+	}
+
+	total := sdk.ZeroDec()
+	for _, weight := range a.StrategyWeights {
+		if weight.IsNil() || !weight.IsPositive() {
+			return fmt.Errorf("strategy weights must be positive: %s", weight)
+		}
+
+		total = total.Add(weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("strategy weights must sum to 1: %s", total)
+	}
+
+	return nil
+}
+
+// TargetWeights returns the target weight of each of the vault's strategies,
+// in the same order as Strategies.
+func (a *AllowedVault) TargetWeights() []sdk.Dec {
+	if len(a.StrategyWeights) == 0 {
+		return []sdk.Dec{sdk.OneDec()}
+	}
+
+	return a.StrategyWeights
+}
+
+// SwapPoolID returns the ID of the swap pool the vault provides liquidity to
+// with the swap LP strategy.
+func (a *AllowedVault) SwapPoolID() string {
+	return swaptypes.PoolID(a.Denom, a.SwapPairDenom)
 }
 
 // IsStrategyAllowed returns true if the given strategy type is allowed for the
@@ -154,6 +288,7 @@ type AllowedVaults []AllowedVault
 // Validate returns an error if the AllowedVaults is invalid.
 func (a AllowedVaults) Validate() error {
 	denoms := make(map[string]bool)
+	swapPools := make(map[string]bool)
 
 	for _, v := range a {
 		if err := v.Validate(); err != nil {
@@ -165,6 +300,16 @@ func (a AllowedVaults) Validate() error {
 		}
 
 		denoms[v.Denom] = true
+
+		// The module account holds a single position in each pool, which
+		// can't be shared between vaults.
+		if v.SwapPairDenom != "" {
+			if swapPools[v.SwapPoolID()] {
+				return fmt.Errorf("duplicate vault swap pool %s", v.SwapPoolID())
+			}
+
+			swapPools[v.SwapPoolID()] = true
+		}
 	}
 
 	return nil
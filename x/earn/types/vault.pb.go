@@ -7,17 +7,22 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +45,42 @@ type AllowedVault struct {
 	// are not allowed to deposit into this vault. If IsPrivateVault is false,
 	// this should be empty and ignored.
 	AllowedDepositors []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=allowed_depositors,json=allowedDepositors,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"allowed_depositors,omitempty"`
+	// SwapPairDenom is the denom paired with the vault denom in the swap pool
+	// the swap LP strategy provides liquidity to. It must be set if, and only
+	// if, the vault uses the swap LP strategy. The position is valued as the
+	// vault denom received by unwinding it in a pool at the prices of the hard
+	// money markets of both denoms, rather than at its share of the pool
+	// reserves, so the value includes the exit swap costs and can't be moved by
+	// trading against the pool.
+	SwapPairDenom string `protobuf:"bytes,5,opt,name=swap_pair_denom,json=swapPairDenom,proto3" json:"swap_pair_denom,omitempty"`
+	// StrategyWeights are the target shares of the vault value held in each of
+	// the Strategies, in the same order. They must sum to 1, and may be empty
+	// if the vault has a single strategy.
+	StrategyWeights []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,rep,name=strategy_weights,json=strategyWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"strategy_weights"`
+	// Fees are the fees charged by the vault. A vault without fees charges none.
+	Fees *VaultFees `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees,omitempty"`
+	// TokenizeShares is true if the vault shares are held as bank coins of the
+	// vault share denom instead of in VaultShareRecords. Shares are then issued
+	// and redeemed in whole units, and can be transferred like other coins. Changes
+	// take effect once the vault has no deposits. Private vaults can't tokenize
+	// shares.
+	TokenizeShares bool `protobuf:"varint,8,opt,name=tokenize_shares,json=tokenizeShares,proto3" json:"tokenize_shares,omitempty"`
+	// Manager is the account allowed to add and remove AllowedDepositors of a
+	// private vault without a params change, and to transfer the role to
+	// another account. It may only be set for private vaults.
+	Manager github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,9,opt,name=manager,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"manager,omitempty"`
+	// MinWithdrawalClaim is the smallest amount of the vault denom that can be
+	// requested in a queued withdrawal. Zero allows any amount.
+	MinWithdrawalClaim github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_withdrawal_claim,json=minWithdrawalClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_withdrawal_claim"`
+	// SwapSlippageLimit is the largest slippage from the oracle price that the
+	// swaps and pool deposits of the swap LP strategy can execute at. It must be
+	// set if, and only if, the vault uses the swap LP strategy.
+	SwapSlippageLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=swap_slippage_limit,json=swapSlippageLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_slippage_limit"`
+	// SwapMaxPriceDeviation is the largest difference between the swap pool
+	// price and the oracle price that the swap LP strategy deposits and
+	// withdraws at. It must be set if, and only if, the vault uses the swap LP
+	// strategy.
+	SwapMaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=swap_max_price_deviation,json=swapMaxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_max_price_deviation"`
 }
 
 func (m *AllowedVault) Reset()         { *m = AllowedVault{} }
@@ -103,17 +144,306 @@ func (m *AllowedVault) GetAllowedDepositors() []github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *AllowedVault) GetSwapPairDenom() string {
+	if m != nil {
+		return m.SwapPairDenom
+	}
+	return ""
+}
+
+func (m *AllowedVault) GetFees() *VaultFees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *AllowedVault) GetTokenizeShares() bool {
+	if m != nil {
+		return m.TokenizeShares
+	}
+	return false
+}
+
+func (m *AllowedVault) GetManager() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Manager
+	}
+	return nil
+}
+
+// VaultFees defines the fees charged by a vault. Fees are paid by minting vault
+// shares to the fee recipient, diluting the other depositors.
+type VaultFees struct {
+	// ManagementFee is the annual fee charged on the vault value.
+	ManagementFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=management_fee,json=managementFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"management_fee"`
+	// PerformanceFee is the fee charged on share price gains above the vault's
+	// high-water mark.
+	PerformanceFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=performance_fee,json=performanceFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_fee"`
+	// Recipient is the address the fee shares are minted to.
+	Recipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+}
+
+func (m *VaultFees) Reset()         { *m = VaultFees{} }
+func (m *VaultFees) String() string { return proto.CompactTextString(m) }
+func (*VaultFees) ProtoMessage()    {}
+func (*VaultFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{1}
+}
+func (m *VaultFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFees.Merge(m, src)
+}
+func (m *VaultFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultFees proto.InternalMessageInfo
+
+func (m *VaultFees) GetRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+// VaultFeeRecord is the fee accrual state of a vault.
+type VaultFeeRecord struct {
+	// Denom is the denom of the vault.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// HighWaterMark is the highest share price the performance fee has been
+	// charged up to.
+	HighWaterMark github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=high_water_mark,json=highWaterMark,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_water_mark"`
+	// LastAccrualTime is the time fees were last charged.
+	LastAccrualTime time.Time `protobuf:"bytes,3,opt,name=last_accrual_time,json=lastAccrualTime,proto3,stdtime" json:"last_accrual_time"`
+	// AccruedFeeShares is the total of the shares minted to fee recipients.
+	AccruedFeeShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=accrued_fee_shares,json=accruedFeeShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accrued_fee_shares"`
+}
+
+func (m *VaultFeeRecord) Reset()         { *m = VaultFeeRecord{} }
+func (m *VaultFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultFeeRecord) ProtoMessage()    {}
+func (*VaultFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{2}
+}
+func (m *VaultFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFeeRecord.Merge(m, src)
+}
+func (m *VaultFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultFeeRecord proto.InternalMessageInfo
+
+func (m *VaultFeeRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VaultFeeRecord) GetLastAccrualTime() time.Time {
+	if m != nil {
+		return m.LastAccrualTime
+	}
+	return time.Time{}
+}
+
+// VaultSharePriceSnapshot is the value of a single vault share at a point in
+// time.
+type VaultSharePriceSnapshot struct {
+	// Denom is the denom of the vault.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// SharePrice is the vault value per share.
+	SharePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share_price,json=sharePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share_price"`
+	// Time is the time the snapshot was recorded.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *VaultSharePriceSnapshot) Reset()         { *m = VaultSharePriceSnapshot{} }
+func (m *VaultSharePriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VaultSharePriceSnapshot) ProtoMessage()    {}
+func (*VaultSharePriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{3}
+}
+func (m *VaultSharePriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSharePriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultSharePriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultSharePriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSharePriceSnapshot.Merge(m, src)
+}
+func (m *VaultSharePriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSharePriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSharePriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSharePriceSnapshot proto.InternalMessageInfo
+
+func (m *VaultSharePriceSnapshot) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VaultSharePriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// WithdrawalClaim is a queued withdrawal of vault funds. The shares of the
+// claim are redeemed when it is requested, and the amount is withdrawn from
+// the vault strategies once they have enough liquidity.
+type WithdrawalClaim struct {
+	// ID is the unique identifier of the claim. Claims are fulfilled in order of
+	// ID within each vault.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the address the claim is paid out to.
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// Amount is the value of the redeemed shares. The vault corresponds to the
+	// denom of the amount coin.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// RequestTime is the time the withdrawal was requested.
+	RequestTime time.Time `protobuf:"bytes,4,opt,name=request_time,json=requestTime,proto3,stdtime" json:"request_time"`
+	// Fulfilled is true once the amount has been withdrawn from the vault
+	// strategies and is held by the module account until claimed.
+	Fulfilled bool `protobuf:"varint,5,opt,name=fulfilled,proto3" json:"fulfilled,omitempty"`
+}
+
+func (m *WithdrawalClaim) Reset()         { *m = WithdrawalClaim{} }
+func (m *WithdrawalClaim) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalClaim) ProtoMessage()    {}
+func (*WithdrawalClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{4}
+}
+func (m *WithdrawalClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalClaim.Merge(m, src)
+}
+func (m *WithdrawalClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalClaim proto.InternalMessageInfo
+
+func (m *WithdrawalClaim) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *WithdrawalClaim) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *WithdrawalClaim) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *WithdrawalClaim) GetRequestTime() time.Time {
+	if m != nil {
+		return m.RequestTime
+	}
+	return time.Time{}
+}
+
+func (m *WithdrawalClaim) GetFulfilled() bool {
+	if m != nil {
+		return m.Fulfilled
+	}
+	return false
+}
+
 // VaultRecord is the state of a vault.
 type VaultRecord struct {
 	// TotalShares is the total distributed number of shares in the vault.
 	TotalShares VaultShare `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares"`
+	// TokenizeShares is the TokenizeShares of the AllowedVault when the record
+	// was created. It is used instead of the AllowedVault's until the vault has
+	// no deposits, so existing shares aren't stranded by a params change.
+	TokenizeShares bool `protobuf:"varint,2,opt,name=tokenize_shares,json=tokenizeShares,proto3" json:"tokenize_shares,omitempty"`
 }
 
 func (m *VaultRecord) Reset()         { *m = VaultRecord{} }
 func (m *VaultRecord) String() string { return proto.CompactTextString(m) }
 func (*VaultRecord) ProtoMessage()    {}
 func (*VaultRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{1}
+	return fileDescriptor_884eb89509fbdc04, []int{5}
 }
 func (m *VaultRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,6 +479,13 @@ func (m *VaultRecord) GetTotalShares() VaultShare {
 	return VaultShare{}
 }
 
+func (m *VaultRecord) GetTokenizeShares() bool {
+	if m != nil {
+		return m.TokenizeShares
+	}
+	return false
+}
+
 // VaultShareRecord defines the vault shares owned by a depositor.
 type VaultShareRecord struct {
 	// Depositor represents the owner of the shares
@@ -161,7 +498,7 @@ func (m *VaultShareRecord) Reset()         { *m = VaultShareRecord{} }
 func (m *VaultShareRecord) String() string { return proto.CompactTextString(m) }
 func (*VaultShareRecord) ProtoMessage()    {}
 func (*VaultShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{2}
+	return fileDescriptor_884eb89509fbdc04, []int{6}
 }
 func (m *VaultShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +550,7 @@ type VaultShare struct {
 func (m *VaultShare) Reset()      { *m = VaultShare{} }
 func (*VaultShare) ProtoMessage() {}
 func (*VaultShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{3}
+	return fileDescriptor_884eb89509fbdc04, []int{7}
 }
 func (m *VaultShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,6 +588,10 @@ func (m *VaultShare) GetDenom() string {
 
 func init() {
 	proto.RegisterType((*AllowedVault)(nil), "kava.earn.v1beta1.AllowedVault")
+	proto.RegisterType((*VaultFees)(nil), "kava.earn.v1beta1.VaultFees")
+	proto.RegisterType((*VaultFeeRecord)(nil), "kava.earn.v1beta1.VaultFeeRecord")
+	proto.RegisterType((*VaultSharePriceSnapshot)(nil), "kava.earn.v1beta1.VaultSharePriceSnapshot")
+	proto.RegisterType((*WithdrawalClaim)(nil), "kava.earn.v1beta1.WithdrawalClaim")
 	proto.RegisterType((*VaultRecord)(nil), "kava.earn.v1beta1.VaultRecord")
 	proto.RegisterType((*VaultShareRecord)(nil), "kava.earn.v1beta1.VaultShareRecord")
 	proto.RegisterType((*VaultShare)(nil), "kava.earn.v1beta1.VaultShare")
@@ -259,38 +600,72 @@ func init() {
 func init() { proto.RegisterFile("kava/earn/v1beta1/vault.proto", fileDescriptor_884eb89509fbdc04) }
 
 var fileDescriptor_884eb89509fbdc04 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x93, 0x10, 0xd1, 0x4b, 0xa8, 0x1a, 0xb7, 0x83, 0xa9, 0x54, 0xdb, 0xca, 0x80, 0xbc,
-	0xd8, 0x56, 0xcb, 0x86, 0x18, 0x88, 0x15, 0x21, 0xc4, 0x84, 0xae, 0x85, 0x81, 0x25, 0xba, 0xd8,
-	0x47, 0x6a, 0xd5, 0xc9, 0x45, 0x7e, 0x17, 0x97, 0x2c, 0x7c, 0x06, 0x46, 0x24, 0x16, 0xe6, 0xce,
-	0xfd, 0x0c, 0xa8, 0x63, 0xd5, 0x09, 0x31, 0xa4, 0x28, 0xf9, 0x16, 0x4c, 0xe8, 0xfe, 0xa8, 0x8e,
-	0x14, 0x10, 0x0c, 0x4c, 0xb9, 0xfb, 0xbd, 0xf7, 0x7e, 0x7f, 0x5e, 0x7c, 0xe8, 0xe0, 0x8c, 0x94,
-	0x24, 0xa2, 0xa4, 0x98, 0x44, 0xe5, 0xe1, 0x90, 0x72, 0x72, 0x18, 0x95, 0x64, 0x96, 0xf3, 0x70,
-	0x5a, 0x30, 0xce, 0xac, 0x8e, 0x28, 0x87, 0xa2, 0x1c, 0xea, 0xf2, 0xfe, 0xc3, 0x84, 0xc1, 0x98,
-	0xc1, 0x40, 0x36, 0x44, 0xea, 0xa2, 0xba, 0xf7, 0xf7, 0x46, 0x6c, 0xc4, 0x14, 0x2e, 0x4e, 0x1a,
-	0xf5, 0x36, 0x25, 0x80, 0x17, 0x84, 0xd3, 0xd1, 0x5c, 0x75, 0x74, 0x3f, 0xd7, 0x50, 0xbb, 0x97,
-	0xe7, 0xec, 0x9c, 0xa6, 0x6f, 0x84, 0xb8, 0xb5, 0x87, 0xee, 0xa5, 0x74, 0xc2, 0xc6, 0xb6, 0xe9,
-	0x99, 0xfe, 0x16, 0x56, 0x17, 0x0b, 0x23, 0xa4, 0x07, 0x33, 0x0a, 0x76, 0xcd, 0xab, 0xfb, 0xdb,
-	0x47, 0x6e, 0xb8, 0xe1, 0x30, 0x3c, 0xd6, 0xec, 0x27, 0xf3, 0x29, 0x8d, 0x3b, 0x17, 0xb7, 0xee,
-	0x83, 0x75, 0x04, 0xf0, 0x1a, 0x8b, 0xe5, 0xa3, 0x9d, 0x4c, 0x64, 0xc9, 0x4a, 0xc2, 0xe9, 0x40,
-	0x46, 0xb7, 0xeb, 0x9e, 0xe9, 0xdf, 0xc7, 0xdb, 0x19, 0xbc, 0x52, 0xb0, 0xf2, 0x74, 0x8e, 0x2c,
-	0xa2, 0x3c, 0x0e, 0x52, 0x3a, 0x65, 0x90, 0x71, 0x56, 0x80, 0xdd, 0xf0, 0xea, 0x7e, 0x3b, 0x7e,
-	0xf1, 0x73, 0xe1, 0x06, 0xa3, 0x8c, 0x9f, 0xce, 0x86, 0x61, 0xc2, 0xc6, 0x7a, 0x2b, 0xfa, 0x27,
-	0x80, 0xf4, 0x2c, 0xe2, 0x42, 0x39, 0xec, 0x25, 0x49, 0x2f, 0x4d, 0x0b, 0x0a, 0x70, 0x73, 0x19,
-	0xec, 0xea, 0xdd, 0x69, 0x24, 0x9e, 0x73, 0x0a, 0xb8, 0xa3, 0x35, 0xfa, 0x77, 0x12, 0xdd, 0xd7,
-	0xa8, 0x25, 0x1d, 0x60, 0x9a, 0xb0, 0x22, 0xb5, 0x9e, 0xa3, 0x36, 0x67, 0x9c, 0xe4, 0x03, 0x38,
-	0x25, 0x05, 0x05, 0xb9, 0xa2, 0xd6, 0xd1, 0xc1, 0x6f, 0xf6, 0x20, 0xa7, 0x8e, 0x45, 0x57, 0xdc,
-	0xb8, 0x5a, 0xb8, 0x06, 0x6e, 0xc9, 0x41, 0x89, 0x40, 0xf7, 0xab, 0x89, 0x76, 0xaa, 0x0e, 0x4d,
-	0xfe, 0x0e, 0x6d, 0xdd, 0x85, 0x93, 0xcc, 0xff, 0x33, 0x5b, 0x45, 0x6d, 0xbd, 0x44, 0x4d, 0x6d,
-	0x5f, 0xfc, 0x8d, 0x7f, 0xb5, 0xbf, 0x2b, 0xec, 0x5f, 0xdc, 0xba, 0xad, 0x0a, 0x03, 0xac, 0x19,
-	0xba, 0x1f, 0x10, 0xaa, 0xe0, 0x3f, 0x7c, 0x3a, 0x27, 0xa8, 0x49, 0xc6, 0x6c, 0x36, 0xe1, 0x76,
-	0x4d, 0xc0, 0xf1, 0x53, 0x41, 0xf8, 0x7d, 0xe1, 0x3e, 0xfa, 0x87, 0x60, 0x7d, 0x9a, 0xdc, 0x5c,
-	0x06, 0x48, 0x27, 0xea, 0xd3, 0x04, 0x6b, 0xae, 0x27, 0x8d, 0x4f, 0x5f, 0x5c, 0x23, 0x7e, 0x76,
-	0xb5, 0x74, 0xcc, 0xeb, 0xa5, 0x63, 0xfe, 0x58, 0x3a, 0xe6, 0xc7, 0x95, 0x63, 0x5c, 0xaf, 0x1c,
-	0xe3, 0xdb, 0xca, 0x31, 0xde, 0xae, 0xb3, 0x8b, 0x7c, 0x41, 0x4e, 0x86, 0x20, 0x4f, 0xd1, 0x7b,
-	0xf5, 0x20, 0xa4, 0xc2, 0xb0, 0x29, 0x9f, 0xc1, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0xa9, 0xba, 0x64, 0x8d, 0x03, 0x00, 0x00,
+	// 1040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x31, 0x6f, 0x23, 0x45,
+	0x14, 0xce, 0x3a, 0xbe, 0x24, 0x7e, 0x4e, 0xe2, 0x64, 0x12, 0x60, 0x2f, 0xba, 0xb3, 0x2d, 0x17,
+	0x87, 0x9b, 0xd8, 0x5c, 0x28, 0x40, 0x88, 0x82, 0xf8, 0xac, 0x40, 0x10, 0x27, 0x45, 0x9b, 0x88,
+	0x48, 0x48, 0xb0, 0x1a, 0xef, 0x3e, 0xaf, 0x87, 0xec, 0xee, 0x2c, 0x3b, 0xe3, 0x38, 0xa1, 0xb8,
+	0xdf, 0x70, 0x25, 0x25, 0x2d, 0x57, 0x5f, 0x0f, 0x15, 0x8a, 0xa8, 0x4e, 0x57, 0x21, 0x8a, 0x04,
+	0x25, 0xff, 0x82, 0x0a, 0xcd, 0xec, 0xd8, 0x8e, 0xb8, 0x44, 0x10, 0xb1, 0x95, 0x3d, 0x6f, 0xe6,
+	0x7d, 0xef, 0x7b, 0xdf, 0x7b, 0xf3, 0x66, 0xe1, 0xe1, 0x11, 0x3d, 0xa6, 0x6d, 0xa4, 0x69, 0xdc,
+	0x3e, 0x7e, 0xdc, 0x43, 0x49, 0x1f, 0xb7, 0x8f, 0xe9, 0x30, 0x94, 0xad, 0x24, 0xe5, 0x92, 0x93,
+	0x55, 0xb5, 0xdd, 0x52, 0xdb, 0x2d, 0xb3, 0xbd, 0x51, 0xf5, 0xb8, 0x88, 0xb8, 0x68, 0xf7, 0xa8,
+	0xc0, 0x89, 0x8f, 0xc7, 0x59, 0x9c, 0xb9, 0x6c, 0xdc, 0xcf, 0xf6, 0x5d, 0xbd, 0x6a, 0x67, 0x0b,
+	0xb3, 0xb5, 0x1e, 0xf0, 0x80, 0x67, 0x76, 0xf5, 0xcf, 0x58, 0x6b, 0x01, 0xe7, 0x41, 0x88, 0x6d,
+	0xbd, 0xea, 0x0d, 0xfb, 0x6d, 0xc9, 0x22, 0x14, 0x92, 0x46, 0x89, 0x39, 0x50, 0x7f, 0x93, 0xa3,
+	0x90, 0x29, 0x95, 0x18, 0x9c, 0x66, 0x27, 0x1a, 0xbf, 0xcc, 0xc3, 0xe2, 0x76, 0x18, 0xf2, 0x11,
+	0xfa, 0x5f, 0x2a, 0xf6, 0x64, 0x1d, 0xee, 0xf9, 0x18, 0xf3, 0xc8, 0xb6, 0xea, 0x56, 0xb3, 0xe4,
+	0x64, 0x0b, 0xe2, 0x00, 0x18, 0x47, 0x86, 0xc2, 0x2e, 0xd4, 0x67, 0x9b, 0xcb, 0x5b, 0xb5, 0xd6,
+	0x1b, 0x29, 0xb6, 0xf6, 0x0d, 0xfa, 0xc1, 0x69, 0x82, 0x9d, 0xd5, 0x17, 0x17, 0xb5, 0xa5, 0xeb,
+	0x16, 0xe1, 0x5c, 0x43, 0x21, 0x4d, 0x58, 0x61, 0x2a, 0x59, 0x76, 0x4c, 0x25, 0xba, 0x5a, 0x3b,
+	0x7b, 0xb6, 0x6e, 0x35, 0x17, 0x9c, 0x65, 0x26, 0xf6, 0x32, 0x73, 0xc6, 0x69, 0x04, 0x84, 0x66,
+	0x1c, 0x5d, 0x1f, 0x13, 0x2e, 0x98, 0xe4, 0xa9, 0xb0, 0x8b, 0xf5, 0xd9, 0xe6, 0x62, 0xe7, 0xb3,
+	0xbf, 0xce, 0x6b, 0x9b, 0x01, 0x93, 0x83, 0x61, 0xaf, 0xe5, 0xf1, 0xc8, 0xc8, 0x66, 0x7e, 0x36,
+	0x85, 0x7f, 0xd4, 0x96, 0x2a, 0x72, 0x6b, 0xdb, 0xf3, 0xb6, 0x7d, 0x3f, 0x45, 0x21, 0x5e, 0xbf,
+	0xdc, 0x5c, 0x33, 0xe2, 0x1a, 0x4b, 0xe7, 0x54, 0xa2, 0x70, 0x56, 0x4d, 0x8c, 0xee, 0x24, 0x04,
+	0x79, 0x04, 0x15, 0x31, 0xa2, 0x89, 0x9b, 0x50, 0x96, 0xba, 0x99, 0x2c, 0xf7, 0xb4, 0x2c, 0x4b,
+	0xca, 0xbc, 0x47, 0x59, 0xda, 0xd5, 0xf2, 0x04, 0xb0, 0x32, 0xd6, 0xd5, 0x1d, 0x21, 0x0b, 0x06,
+	0x52, 0xd8, 0x73, 0xf5, 0xd9, 0x66, 0xa9, 0xf3, 0xf1, 0xd9, 0x79, 0x6d, 0xe6, 0x8f, 0xf3, 0xda,
+	0xa3, 0xff, 0x40, 0xb1, 0x8b, 0xde, 0xeb, 0x97, 0x9b, 0x60, 0xb8, 0x75, 0xd1, 0x73, 0x2a, 0x63,
+	0xd4, 0xc3, 0x0c, 0x94, 0xbc, 0x07, 0xc5, 0x3e, 0xa2, 0xb0, 0xe7, 0xeb, 0x56, 0xb3, 0xbc, 0xf5,
+	0xe0, 0x86, 0x0a, 0x68, 0xc5, 0x76, 0x10, 0x85, 0xa3, 0x4f, 0x92, 0x77, 0xa1, 0x22, 0xf9, 0x11,
+	0xc6, 0xec, 0x7b, 0x74, 0xc5, 0x80, 0xa6, 0x28, 0xec, 0x85, 0x4c, 0xe4, 0xb1, 0x79, 0x5f, 0x5b,
+	0x49, 0x0f, 0xe6, 0x23, 0x1a, 0xd3, 0x00, 0x53, 0xbb, 0x54, 0xb7, 0x72, 0x55, 0x76, 0x0c, 0x4c,
+	0x62, 0x58, 0x8f, 0x58, 0xec, 0x8e, 0x98, 0x1c, 0xf8, 0x29, 0x1d, 0xd1, 0xd0, 0xf5, 0x42, 0xca,
+	0x22, 0x1b, 0xea, 0xd6, 0x1d, 0xb5, 0xda, 0x8d, 0xe5, 0x35, 0xad, 0x76, 0x63, 0xe9, 0x90, 0x88,
+	0xc5, 0x87, 0x13, 0xe0, 0x27, 0x0a, 0x97, 0x84, 0xb0, 0xa6, 0xeb, 0x27, 0x42, 0x96, 0x24, 0x34,
+	0x40, 0x37, 0x64, 0x11, 0x93, 0x76, 0xb9, 0x6e, 0xfd, 0xef, 0xd2, 0xac, 0x2a, 0xe0, 0x7d, 0x83,
+	0xfb, 0x85, 0x82, 0x25, 0x43, 0xb0, 0x75, 0xb4, 0x88, 0x9e, 0xa8, 0xb6, 0xf6, 0xd0, 0xf5, 0xf1,
+	0x98, 0x51, 0xc9, 0x78, 0x6c, 0x2f, 0xe6, 0x10, 0xf2, 0x2d, 0x85, 0xfe, 0x94, 0x9e, 0xec, 0x29,
+	0xec, 0xee, 0x18, 0xba, 0xf1, 0x73, 0x01, 0x4a, 0x93, 0xaa, 0x13, 0x0f, 0x96, 0x33, 0xb5, 0x23,
+	0x8c, 0xa5, 0xdb, 0x47, 0xb4, 0xad, 0x1c, 0x42, 0x2f, 0x4d, 0x31, 0x77, 0x10, 0x09, 0x42, 0x25,
+	0xc1, 0xb4, 0xcf, 0xd3, 0x88, 0xc6, 0x1e, 0xea, 0x28, 0x85, 0x1c, 0xa2, 0x2c, 0x5f, 0x03, 0x55,
+	0x61, 0xfa, 0x50, 0x4a, 0xd1, 0x63, 0x09, 0xc3, 0x38, 0x1b, 0x0d, 0x79, 0x36, 0xe5, 0x14, 0xba,
+	0xf1, 0x5b, 0x01, 0x96, 0xc7, 0x0a, 0x3a, 0xe8, 0xf1, 0xd4, 0xbf, 0x65, 0x0c, 0xfa, 0x50, 0x19,
+	0xb0, 0x60, 0xe0, 0x8e, 0xa8, 0xc4, 0xd4, 0x8d, 0x68, 0x7a, 0x94, 0x4b, 0xde, 0x4b, 0x0a, 0xf4,
+	0x50, 0x61, 0x3e, 0xa5, 0xe9, 0x11, 0xd9, 0x83, 0xd5, 0x90, 0x0a, 0xe9, 0x52, 0xcf, 0x4b, 0x87,
+	0x34, 0x74, 0xd5, 0x54, 0xd7, 0xe9, 0x97, 0xb7, 0x36, 0x5a, 0xd9, 0xc8, 0x6f, 0x8d, 0x47, 0x7e,
+	0xeb, 0x60, 0x3c, 0xf2, 0x3b, 0x0b, 0x8a, 0xc3, 0xf3, 0x8b, 0x9a, 0xe5, 0x54, 0x94, 0xfb, 0x76,
+	0xe6, 0xad, 0xf6, 0xc9, 0xb7, 0x40, 0x34, 0x18, 0xfa, 0xaa, 0x56, 0xe3, 0x39, 0x50, 0xcc, 0x81,
+	0xfa, 0x8a, 0xc1, 0xdd, 0x41, 0x33, 0x47, 0x1a, 0x67, 0x16, 0xbc, 0xa3, 0xc5, 0xd4, 0x6b, 0xdd,
+	0xab, 0xfb, 0x31, 0x4d, 0xc4, 0x80, 0xdf, 0xf6, 0xb8, 0x7c, 0x0d, 0x65, 0xcd, 0x28, 0xbb, 0x34,
+	0xb9, 0x28, 0x0a, 0x62, 0x12, 0x9c, 0x7c, 0x08, 0xc5, 0x3b, 0x2b, 0xa8, 0x3d, 0x1a, 0x3f, 0x15,
+	0xa0, 0xf2, 0xcf, 0x91, 0xf2, 0x36, 0x14, 0x98, 0xaf, 0xf9, 0x17, 0x3b, 0x73, 0x97, 0xe7, 0xb5,
+	0xc2, 0x6e, 0xd7, 0x29, 0x30, 0x9f, 0x7c, 0x03, 0xf7, 0xf8, 0x28, 0xc6, 0xd4, 0x2e, 0xe4, 0xdc,
+	0xa7, 0x19, 0x2c, 0xf9, 0x00, 0xe6, 0x68, 0xc4, 0x87, 0xe6, 0x22, 0x94, 0xb7, 0xee, 0xb7, 0xcc,
+	0x61, 0xf5, 0x35, 0x31, 0x99, 0xfe, 0x4f, 0x38, 0x8b, 0x3b, 0x45, 0x95, 0x86, 0x63, 0x8e, 0x93,
+	0x4f, 0x61, 0x31, 0xc5, 0xef, 0x86, 0x28, 0x64, 0xd6, 0x48, 0xc5, 0x3b, 0xc8, 0x50, 0x36, 0x9e,
+	0xba, 0x89, 0x1e, 0x40, 0xa9, 0x3f, 0x0c, 0xfb, 0x2c, 0x0c, 0xd1, 0xd7, 0xcf, 0xe0, 0x82, 0x33,
+	0x35, 0x34, 0x9e, 0x41, 0x59, 0x57, 0xdd, 0xdc, 0x9f, 0x1d, 0x58, 0x94, 0x5c, 0xd2, 0x70, 0xdc,
+	0x6b, 0x96, 0x8e, 0xfa, 0xf0, 0xb6, 0x07, 0x4b, 0xf7, 0x8a, 0x21, 0x5e, 0xd6, 0x8e, 0xe6, 0x55,
+	0xba, 0xe1, 0xf9, 0x2a, 0xdc, 0xf4, 0x7c, 0x35, 0x7e, 0xb5, 0x60, 0x65, 0x0a, 0x65, 0x58, 0xf4,
+	0xa1, 0x34, 0xf9, 0x60, 0xb0, 0xad, 0x9c, 0x0b, 0x33, 0x85, 0x26, 0x9f, 0xc3, 0xdc, 0x84, 0xdc,
+	0xec, 0xbf, 0xe7, 0xb9, 0xa6, 0xf2, 0x7c, 0x71, 0x51, 0x2b, 0x4f, 0x6d, 0xc2, 0x31, 0x08, 0x8d,
+	0x67, 0x00, 0x53, 0xf3, 0x2d, 0x37, 0xe6, 0x60, 0xd2, 0x0c, 0x79, 0x5c, 0x16, 0x83, 0xf5, 0x51,
+	0xf1, 0x87, 0x1f, 0x6b, 0x33, 0x9d, 0x4f, 0xce, 0x2e, 0xab, 0xd6, 0xab, 0xcb, 0xaa, 0xf5, 0xe7,
+	0x65, 0xd5, 0x7a, 0x7e, 0x55, 0x9d, 0x79, 0x75, 0x55, 0x9d, 0xf9, 0xfd, 0xaa, 0x3a, 0xf3, 0xd5,
+	0x75, 0x74, 0x95, 0xdf, 0x66, 0x48, 0x7b, 0x42, 0xff, 0x6b, 0x9f, 0x64, 0x1f, 0x99, 0x3a, 0x42,
+	0x6f, 0x4e, 0xf7, 0xd4, 0xfb, 0x7f, 0x0f, 0x00, 0x6b, 0xa7, 0x8a, 0x58, 0x22, 0x0b, 0x00, 0x00,
 }
 
 func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
@@ -313,6 +688,86 @@ func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapMaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.SwapMaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.SwapSlippageLimit.Size()
+		i -= size
+		if _, err := m.SwapSlippageLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinWithdrawalClaim.Size()
+		i -= size
+		if _, err := m.MinWithdrawalClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TokenizeShares {
+		i--
+		if m.TokenizeShares {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Fees != nil {
+		{
+			size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StrategyWeights) > 0 {
+		for iNdEx := len(m.StrategyWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.StrategyWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.StrategyWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SwapPairDenom) > 0 {
+		i -= len(m.SwapPairDenom)
+		copy(dAtA[i:], m.SwapPairDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.SwapPairDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.AllowedDepositors) > 0 {
 		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDepositors[iNdEx])
@@ -333,20 +788,20 @@ func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Strategies) > 0 {
-		dAtA2 := make([]byte, len(m.Strategies)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Strategies)*10)
+		var j2 int
 		for _, num := range m.Strategies {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintVault(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintVault(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -360,7 +815,7 @@ func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
+func (m *VaultFees) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -370,22 +825,39 @@ func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VaultRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *VaultFees) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VaultFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.PerformanceFee.Size()
+		i -= size
+		if _, err := m.PerformanceFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ManagementFee.Size()
 		i -= size
+		if _, err := m.ManagementFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
 	i--
@@ -393,7 +865,7 @@ func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
+func (m *VaultFeeRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -403,42 +875,254 @@ func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VaultShareRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *VaultFeeRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VaultShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VaultFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVault(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.AccruedFeeShares.Size()
+		i -= size
+		if _, err := m.AccruedFeeShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastAccrualTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAccrualTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *VaultShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	i -= n4
+	i = encodeVarintVault(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HighWaterMark.Size()
+		i -= size
+		if _, err := m.HighWaterMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultSharePriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSharePriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSharePriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintVault(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fulfilled {
+		i--
+		if m.Fulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RequestTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintVault(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenizeShares {
+		i--
+		if m.TokenizeShares {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -514,61 +1198,1114 @@ func (m *AllowedVault) Size() (n int) {
 			n += 1 + l + sovVault(uint64(l))
 		}
 	}
+	l = len(m.SwapPairDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.StrategyWeights) > 0 {
+		for _, e := range m.StrategyWeights {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.TokenizeShares {
+		n += 2
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.MinWithdrawalClaim.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.SwapSlippageLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.SwapMaxPriceDeviation.Size()
+	n += 1 + l + sovVault(uint64(l))
 	return n
 }
 
-func (m *VaultRecord) Size() (n int) {
+func (m *VaultFees) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TotalShares.Size()
+	l = m.ManagementFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.PerformanceFee.Size()
 	n += 1 + l + sovVault(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
 	return n
 }
 
-func (m *VaultShareRecord) Size() (n int) {
+func (m *VaultFeeRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Depositor)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovVault(uint64(l))
 	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovVault(uint64(l))
+	l = m.HighWaterMark.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAccrualTime)
+	n += 1 + l + sovVault(uint64(l))
+	l = m.AccruedFeeShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultSharePriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.SharePrice.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *WithdrawalClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovVault(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime)
+	n += 1 + l + sovVault(uint64(l))
+	if m.Fulfilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *VaultRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.TokenizeShares {
+		n += 2
+	}
+	return n
+}
+
+func (m *VaultShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowedVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v StrategyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= StrategyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Strategies = append(m.Strategies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVault
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthVault
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Strategies) == 0 {
+					m.Strategies = make([]StrategyType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v StrategyType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVault
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= StrategyType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Strategies = append(m.Strategies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivateVault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPrivateVault = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPairDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapPairDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StrategyWeights = append(m.StrategyWeights, v)
+			if err := m.StrategyWeights[len(m.StrategyWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &VaultFees{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShares", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenizeShares = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = append(m.Manager[:0], dAtA[iNdEx:postIndex]...)
+			if m.Manager == nil {
+				m.Manager = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawalClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWithdrawalClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapSlippageLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapSlippageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapMaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagementFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ManagementFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerformanceFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighWaterMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFeeShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedFeeShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultSharePriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultSharePriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultSharePriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *VaultShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovVault(uint64(l))
-	return n
-}
-
-func sovVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVault(x uint64) (n int) {
-	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AllowedVault) Unmarshal(dAtA []byte) error {
+func (m *WithdrawalClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,17 +2328,36 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawalClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawalClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -611,98 +2367,31 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v StrategyType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowVault
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= StrategyType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Strategies = append(m.Strategies, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowVault
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthVault
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthVault
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Strategies) == 0 {
-					m.Strategies = make([]StrategyType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v StrategyType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowVault
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= StrategyType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Strategies = append(m.Strategies, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivateVault", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -712,17 +2401,30 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPrivateVault = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -732,24 +2434,45 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
-			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fulfilled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -833,6 +2556,26 @@ func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShares", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenizeShares = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
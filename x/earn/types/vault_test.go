@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -308,6 +309,398 @@ func TestAllowedVaultsValidate(t *testing.T) {
 				contains:   "non-private vaults cannot have any AllowedDepositors",
 			},
 		},
+		{
+			name: "valid - weighted strategies",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					StrategyWeights:   []sdk.Dec{sdk.MustNewDecFromStr("0.7"), sdk.MustNewDecFromStr("0.3")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - multiple strategies without weights",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vaults with multiple strategies require StrategyWeights",
+			},
+		},
+		{
+			name: "invalid - weights don't match strategies",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "number of StrategyWeights 1 does not match number of Strategies 2",
+			},
+		},
+		{
+			name: "invalid - zero weight",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					StrategyWeights:   []sdk.Dec{sdk.OneDec(), sdk.ZeroDec()},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "strategy weights must be positive",
+			},
+		},
+		{
+			name: "invalid - weights don't sum to 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					StrategyWeights:   []sdk.Dec{sdk.MustNewDecFromStr("0.7"), sdk.MustNewDecFromStr("0.4")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "strategy weights must sum to 1",
+			},
+		},
+		{
+			name: "valid - swap LP vault",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:                 "ukava",
+					Strategies:            []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:        false,
+					AllowedDepositors:     []sdk.AccAddress{},
+					SwapPairDenom:         "usdx",
+					SwapSlippageLimit:     sdk.MustNewDecFromStr("0.02"),
+					SwapMaxPriceDeviation: sdk.MustNewDecFromStr("0.02"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - swap LP without slippage limit",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:                 "ukava",
+					Strategies:            []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:        false,
+					AllowedDepositors:     []sdk.AccAddress{},
+					SwapPairDenom:         "usdx",
+					SwapMaxPriceDeviation: sdk.MustNewDecFromStr("0.02"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap LP vaults require a SwapSlippageLimit between 0 and 1",
+			},
+		},
+		{
+			name: "invalid - swap LP with max price deviation of 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:                 "ukava",
+					Strategies:            []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:        false,
+					AllowedDepositors:     []sdk.AccAddress{},
+					SwapPairDenom:         "usdx",
+					SwapSlippageLimit:     sdk.MustNewDecFromStr("0.02"),
+					SwapMaxPriceDeviation: sdk.OneDec(),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap LP vaults require a SwapMaxPriceDeviation between 0 and 1",
+			},
+		},
+		{
+			name: "invalid - slippage limit without swap LP",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					SwapSlippageLimit: sdk.MustNewDecFromStr("0.02"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "only swap LP vaults can have a SwapSlippageLimit",
+			},
+		},
+		{
+			name: "invalid - swap LP without swap pair denom",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "ukava",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap LP vaults require a valid SwapPairDenom",
+			},
+		},
+		{
+			name: "invalid - swap pair denom equal to vault denom",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "ukava",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					SwapPairDenom:     "ukava",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "SwapPairDenom cannot be the vault denom ukava",
+			},
+		},
+		{
+			name: "invalid - swap pair denom without swap LP",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					SwapPairDenom:     "ukava",
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "only swap LP vaults can have a SwapPairDenom",
+			},
+		},
+		{
+			name: "invalid - duplicate swap pool",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:                 "ukava",
+					Strategies:            []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:        false,
+					AllowedDepositors:     []sdk.AccAddress{},
+					SwapPairDenom:         "usdx",
+					SwapSlippageLimit:     sdk.MustNewDecFromStr("0.02"),
+					SwapMaxPriceDeviation: sdk.MustNewDecFromStr("0.02"),
+				},
+				{
+					Denom:                 "usdx",
+					Strategies:            []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					IsPrivateVault:        false,
+					AllowedDepositors:     []sdk.AccAddress{},
+					SwapPairDenom:         "ukava",
+					SwapSlippageLimit:     sdk.MustNewDecFromStr("0.02"),
+					SwapMaxPriceDeviation: sdk.MustNewDecFromStr("0.02"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate vault swap pool ukava:usdx",
+			},
+		},
+		{
+			name: "valid - vault fees",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					Fees:              types.NewVaultFees(sdk.MustNewDecFromStr("0.02"), sdk.MustNewDecFromStr("0.2"), sdk.AccAddress("recipient")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - negative management fee",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					Fees:              types.NewVaultFees(sdk.MustNewDecFromStr("-0.02"), sdk.MustNewDecFromStr("0.2"), sdk.AccAddress("recipient")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "management fee must be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid - combined fees of 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					Fees:              types.NewVaultFees(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5"), sdk.AccAddress("recipient")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "combined management and performance fees must be less than 1",
+			},
+		},
+		{
+			name: "invalid - fees without recipient",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					Fees:              types.NewVaultFees(sdk.MustNewDecFromStr("0.02"), sdk.MustNewDecFromStr("0.2"), nil),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "fee recipient is empty",
+			},
+		},
+		{
+			name: "valid - tokenized shares",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					TokenizeShares:    true,
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - tokenized private vault",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    true,
+					AllowedDepositors: []sdk.AccAddress{sdk.AccAddress("depositor")},
+					TokenizeShares:    true,
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "private vaults cannot tokenize shares",
+			},
+		},
+		{
+			name: "invalid - tokenized share denom too long",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             strings.Repeat("a", 120),
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					TokenizeShares:    true,
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid share denom",
+			},
+		},
+		{
+			name: "valid - private vault manager",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    true,
+					AllowedDepositors: []sdk.AccAddress{sdk.AccAddress("depositor")},
+					Manager:           sdk.AccAddress("manager"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - public vault manager",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:             "usdx",
+					Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:    false,
+					AllowedDepositors: []sdk.AccAddress{},
+					Manager:           sdk.AccAddress("manager"),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "only private vaults can have a Manager",
+			},
+		},
+		{
+			name: "valid - min withdrawal claim",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:     false,
+					AllowedDepositors:  []sdk.AccAddress{},
+					MinWithdrawalClaim: sdk.NewInt(1e6),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - negative min withdrawal claim",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					IsPrivateVault:     false,
+					AllowedDepositors:  []sdk.AccAddress{},
+					MinWithdrawalClaim: sdk.NewInt(-1),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "MinWithdrawalClaim cannot be negative",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextWithdrawalClaimID is the id of the first withdrawal claim.
+const DefaultNextWithdrawalClaimID uint64 = 1
+
+// NewWithdrawalClaim returns a new unfulfilled WithdrawalClaim.
+func NewWithdrawalClaim(id uint64, owner sdk.AccAddress, amount sdk.Coin, requestTime time.Time) WithdrawalClaim {
+	return WithdrawalClaim{
+		ID:          id,
+		Owner:       owner,
+		Amount:      amount,
+		RequestTime: requestTime,
+		Fulfilled:   false,
+	}
+}
+
+// Validate returns an error if a WithdrawalClaim is invalid.
+func (c WithdrawalClaim) Validate() error {
+	if c.ID == 0 {
+		return errors.New("withdrawal claim id cannot be zero")
+	}
+
+	if c.Owner.Empty() {
+		return fmt.Errorf("withdrawal claim %d owner cannot be empty", c.ID)
+	}
+
+	if err := c.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid withdrawal claim %d amount: %w", c.ID, err)
+	}
+
+	if !c.Amount.IsPositive() {
+		return fmt.Errorf("withdrawal claim %d amount must be positive: %s", c.ID, c.Amount)
+	}
+
+	return nil
+}
+
+// WithdrawalClaims is a slice of WithdrawalClaim.
+type WithdrawalClaims []WithdrawalClaim
+
+// Validate returns an error if a slice of WithdrawalClaims is invalid.
+func (cs WithdrawalClaims) Validate() error {
+	ids := make(map[uint64]bool)
+
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if ids[c.ID] {
+			return fmt.Errorf("duplicate withdrawal claim id %d", c.ID)
+		}
+
+		ids[c.ID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+func TestWithdrawalClaimsValidate(t *testing.T) {
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+
+	owner := sdk.AccAddress("test1")
+	requestTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		claims  types.WithdrawalClaims
+		errArgs errArgs
+	}{
+		{
+			name: "valid claims",
+			claims: types.WithdrawalClaims{
+				types.NewWithdrawalClaim(1, owner, sdk.NewInt64Coin("usdx", 100), requestTime),
+				types.NewWithdrawalClaim(2, owner, sdk.NewInt64Coin("ukava", 100), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - duplicate id",
+			claims: types.WithdrawalClaims{
+				types.NewWithdrawalClaim(1, owner, sdk.NewInt64Coin("usdx", 100), requestTime),
+				types.NewWithdrawalClaim(1, owner, sdk.NewInt64Coin("ukava", 100), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate withdrawal claim id 1",
+			},
+		},
+		{
+			name: "invalid - zero id",
+			claims: types.WithdrawalClaims{
+				types.NewWithdrawalClaim(0, owner, sdk.NewInt64Coin("usdx", 100), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "withdrawal claim id cannot be zero",
+			},
+		},
+		{
+			name: "invalid - empty owner",
+			claims: types.WithdrawalClaims{
+				types.NewWithdrawalClaim(1, nil, sdk.NewInt64Coin("usdx", 100), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "owner cannot be empty",
+			},
+		},
+		{
+			name: "invalid - zero amount",
+			claims: types.WithdrawalClaims{
+				types.NewWithdrawalClaim(1, owner, sdk.NewInt64Coin("usdx", 0), requestTime),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "amount must be positive",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.claims.Validate()
+
+			if test.errArgs.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.errArgs.contains)
+			}
+		})
+	}
+}
@@ -9,9 +9,10 @@ import (
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
-// BeginBlocker updates interest rates
+// BeginBlocker updates interest rates and sells excess reserves when a reserve buyback is due
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.ApplyInterestRateUpdates(ctx)
+	k.ApplyReserveBuyback(ctx)
 }
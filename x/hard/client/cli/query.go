@@ -16,9 +16,11 @@ import (
 
 // flags for cli queries
 const (
-	flagName  = "name"
-	flagDenom = "denom"
-	flagOwner = "owner"
+	flagName      = "name"
+	flagDenom     = "denom"
+	flagOwner     = "owner"
+	flagDelegator = "delegator"
+	flagDelegatee = "delegatee"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -43,6 +45,10 @@ func GetQueryCmd() *cobra.Command {
 		queryInterestRateCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryAccountHealthCmd(),
+		queryLiquidationCandidatesCmd(),
+		queryHeadroomCmd(),
+		queryCreditDelegationsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -532,3 +538,188 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryAccountHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "account-health [address]",
+		Short: "get the health of an account's position",
+		Long:  "get an account's synced position, its LTV, borrow limit, liquidation threshold and the price of each asset at which it can be liquidated",
+		Example: fmt.Sprintf(`%[1]s q %[2]s account-health kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountHealth(context.Background(), &types.QueryAccountHealthRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryLiquidationCandidatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-candidates",
+		Short: "get accounts that can be liquidated",
+		Long:  "get the health of each account whose synced position can be liquidated at current prices",
+		Example: fmt.Sprintf(`%[1]s q %[2]s liquidation-candidates
+%[1]s q %[2]s liquidation-candidates --page 2 --limit 50`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LiquidationCandidates(context.Background(), &types.QueryLiquidationCandidatesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "liquidation candidates")
+
+	return cmd
+}
+
+func queryHeadroomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "headroom",
+		Short: "get remaining supply and account borrow caps",
+		Long:  "get how much more can be supplied to each money market, and borrowed by an account, before their caps are reached",
+		Example: fmt.Sprintf(`%[1]s q %[2]s headroom
+%[1]s q %[2]s headroom --denom bnb
+%[1]s q %[2]s headroom --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHeadroomRequest{
+				Denom: denom,
+			}
+
+			if len(ownerBech) != 0 {
+				owner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				req.Owner = owner.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Headroom(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "(optional) account to get the remaining borrow caps of")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for headroom by denom")
+
+	return cmd
+}
+
+func queryCreditDelegationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-delegations",
+		Short: "query credit delegations with optional filters",
+		Long:  "query for all credit delegations, or those of a delegator or delegatee using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s credit-delegations
+%[1]s q %[2]s credit-delegations --delegator kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+%[1]s q %[2]s credit-delegations --delegatee kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegatorBech, err := cmd.Flags().GetString(flagDelegator)
+			if err != nil {
+				return err
+			}
+			delegateeBech, err := cmd.Flags().GetString(flagDelegatee)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCreditDelegationsRequest{
+				Pagination: pageReq,
+			}
+
+			if len(delegatorBech) != 0 {
+				delegator, err := sdk.AccAddressFromBech32(delegatorBech)
+				if err != nil {
+					return err
+				}
+				req.Delegator = delegator.String()
+			}
+			if len(delegateeBech) != 0 {
+				delegatee, err := sdk.AccAddressFromBech32(delegateeBech)
+				if err != nil {
+					return err
+				}
+				req.Delegatee = delegatee.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CreditDelegations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "credit delegations")
+
+	cmd.Flags().String(flagDelegator, "", "(optional) filter for credit delegations by delegator address")
+	cmd.Flags().String(flagDelegatee, "", "(optional) filter for credit delegations by delegatee address")
+
+	return cmd
+}
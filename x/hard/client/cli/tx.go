@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -31,6 +33,11 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdSetAssetCategory(),
+		getCmdFlashLoan(),
+		getCmdApproveCreditDelegation(),
+		getCmdDelegatedBorrow(),
+		getCmdSetCollateralEnabled(),
 	}
 
 	for _, cmd := range cmds {
@@ -203,3 +210,167 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSetAssetCategory() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-asset-category [category]",
+		Short: "opt into an asset category to borrow between correlated assets at its limits, or out with an empty category",
+		Long: strings.TrimSpace(`opt into an asset category to borrow between correlated assets at the category's loan-to-value.
+All borrows must be in the category, and deposits outside of it stop counting as collateral. Pass "" to opt out of the current category.`),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%[1]s tx %[2]s set-asset-category kava-derivatives --from <key>
+%[1]s tx %[2]s set-asset-category "" --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAssetCategory(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdFlashLoan() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-loan [amount] [tx-json-file]",
+		Short: "borrow funds without collateral, execute the messages in a tx file, then repay the funds plus a fee",
+		Long: strings.TrimSpace(`borrow funds without collateral for the duration of the messages in a tx file, which must all be
+signed by the borrower. The loaned amount plus the flash loan fee is collected from the borrower once the
+messages have run, and the whole transaction fails if it can't be repaid.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%[1]s tx swap swap-exact-for-tokens 10000000usdx 5000000ukava 0.01 1624224736 --from <key> --generate-only > swap.json
+%[1]s tx %[2]s flash-loan 10000000usdx swap.json --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgFlashLoan(clientCtx.GetFromAddress(), amount, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdApproveCreditDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-credit-delegation [delegatee-addr] [amount]",
+		Short: "let another account borrow against your deposits",
+		Long: strings.TrimSpace(`sets how much of a denom another account can borrow against your deposits, replacing any previous
+allowance for the denom. The debt is added to your borrow. An amount of zero removes the allowance.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s approve-credit-delegation kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 1000000000usdx --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegatee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveCreditDelegation(clientCtx.GetFromAddress(), delegatee, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdDelegatedBorrow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegated-borrow [delegator-addr] [amount]",
+		Short: "borrow tokens against another account's deposits",
+		Long:  strings.TrimSpace(`borrows tokens against the deposits of an account that has approved a credit delegation to you`),
+		Args:  cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s delegated-borrow kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 1000000000usdx --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegatedBorrow(clientCtx.GetFromAddress(), delegator, coins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetCollateralEnabled() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-collateral-enabled [denom] [true/false]",
+		Short: "choose whether deposits of a denom are used as collateral",
+		Long: strings.TrimSpace(`choose whether deposits of a denom count towards the borrow limit. Deposits that aren't used as
+collateral still earn supply interest, and can't be seized when the account is liquidated. Collateral can't be
+disabled if the account's borrows would then exceed its borrow limit.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%[1]s tx %[2]s set-collateral-enabled usdx false --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCollateralEnabled(clientCtx.GetFromAddress(), args[0], enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
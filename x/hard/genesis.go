@@ -39,6 +39,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	for _, aac := range gs.AccountAssetCategories {
+		k.SetAccountAssetCategory(ctx, aac.Address, aac.Category)
+	}
+
+	for _, cd := range gs.CreditDelegations {
+		k.SetCreditDelegation(ctx, cd)
+	}
+
+	for _, dc := range gs.DisabledCollateral {
+		k.SetDisabledCollateral(ctx, dc)
+	}
+
+	if !gs.PreviousReserveBuybackTime.IsZero() {
+		k.SetPreviousReserveBuybackTime(ctx, gs.PreviousReserveBuybackTime)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	if DepositModuleAccount == nil {
@@ -109,8 +125,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		gats = append(gats, gat)
 
 	}
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
 	)
+	gs.AccountAssetCategories = k.GetAllAccountAssetCategories(ctx)
+	gs.CreditDelegations = k.GetAllCreditDelegations(ctx)
+	gs.DisabledCollateral = k.GetAllDisabledCollateral(ctx)
+	if previousBuybackTime, found := k.GetPreviousReserveBuybackTime(ctx); found {
+		gs.PreviousReserveBuybackTime = previousBuybackTime
+	}
+	return gs
 }
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetAccountAssetCategory returns the name of the asset category an account has opted into
+func (k Keeper) GetAccountAssetCategory(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountAssetCategoryPrefix)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetAccountAssetCategory stores the asset category an account has opted into
+func (k Keeper) SetAccountAssetCategory(ctx sdk.Context, addr sdk.AccAddress, category string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountAssetCategoryPrefix)
+	store.Set(addr.Bytes(), []byte(category))
+}
+
+// DeleteAccountAssetCategory removes an account's asset category
+func (k Keeper) DeleteAccountAssetCategory(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountAssetCategoryPrefix)
+	store.Delete(addr.Bytes())
+}
+
+// IterateAccountAssetCategories iterates over all accounts that have opted into an asset category
+func (k Keeper) IterateAccountAssetCategories(ctx sdk.Context, cb func(addr sdk.AccAddress, category string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountAssetCategoryPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()), string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllAccountAssetCategories returns the asset categories of all accounts that have opted into one
+func (k Keeper) GetAllAccountAssetCategories(ctx sdk.Context) types.AccountAssetCategories {
+	categories := types.AccountAssetCategories{}
+	k.IterateAccountAssetCategories(ctx, func(addr sdk.AccAddress, category string) bool {
+		categories = append(categories, types.NewAccountAssetCategory(addr, category))
+		return false
+	})
+	return categories
+}
+
+// GetEffectiveAssetCategory returns the asset category whose limits apply to a position. A category
+// applies only when the account has opted into it, it is still in params, and every asset of the
+// position belongs to it. Positions are checked with their collateral, which leaves out deposits
+// outside the category.
+func (k Keeper) GetEffectiveAssetCategory(ctx sdk.Context, addr sdk.AccAddress, deposits, borrows sdk.Coins) (types.AssetCategory, bool) {
+	name, found := k.GetAccountAssetCategory(ctx, addr)
+	if !found {
+		return types.AssetCategory{}, false
+	}
+	category, found := k.GetParams(ctx).AssetCategories.Get(name)
+	if !found {
+		return types.AssetCategory{}, false
+	}
+	if !category.ContainsAll(deposits, borrows) {
+		return types.AssetCategory{}, false
+	}
+	return category, true
+}
+
+// SetAssetCategory opts an account into an asset category, or out of its current one if the name is
+// empty. All of the account's borrows must be in the new category, and its position must be within the
+// borrow limit that applies after the change. Deposits outside the category stop counting as collateral.
+func (k Keeper) SetAssetCategory(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	deposit, found := k.GetSyncedDeposit(ctx, addr)
+	if !found {
+		deposit = types.NewDeposit(addr, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, addr)
+	if !found {
+		borrow = types.NewBorrow(addr, sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+
+	var category *types.AssetCategory
+	if name != "" {
+		c, found := k.GetParams(ctx).AssetCategories.Get(name)
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidAssetCategory, "asset category %s not found", name)
+		}
+		if !c.ContainsAll(borrow.Amount) {
+			return errorsmod.Wrapf(types.ErrDenomNotInAssetCategory, "borrows %s must all be in asset category %s", borrow.Amount, name)
+		}
+		category = &c
+	}
+
+	if !borrow.Amount.IsZero() {
+		collateral := k.getCollateralInCategory(ctx, deposit, category)
+		liqMap, err := k.loadLiquidationData(ctx, collateral, borrow, category, false)
+		if err != nil {
+			return err
+		}
+		if !isWithinLtvRange(liqMap, collateral, borrow, false) {
+			return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "position would exceed the borrow limit after changing asset category")
+		}
+	}
+
+	if name == "" {
+		k.DeleteAccountAssetCategory(ctx, addr)
+	} else {
+		k.SetAccountAssetCategory(ctx, addr, name)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetAssetCategory,
+			sdk.NewAttribute(types.AttributeKeyOwner, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAssetCategory, name),
+		),
+	)
+	return nil
+}
+
+// ValidateAssetCategoryDenoms checks that an account opted into an asset category only borrows assets in it
+func (k Keeper) ValidateAssetCategoryDenoms(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	name, found := k.GetAccountAssetCategory(ctx, addr)
+	if !found {
+		return nil
+	}
+	category, found := k.GetParams(ctx).AssetCategories.Get(name)
+	if !found {
+		// the category was removed by governance, so the account is back to the standard limits
+		return nil
+	}
+	if !category.ContainsAll(coins) {
+		return errorsmod.Wrapf(types.ErrDenomNotInAssetCategory, "%s are not all in asset category %s", coins, name)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestAssetCategory() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{sdk.NewCoins(
+			sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)),
+			sdk.NewCoin("bnb", sdkmath.NewInt(10*BNB_CF)),
+		)},
+		[]sdk.AccAddress{borrower},
+	)
+
+	moneyMarket := func(denom, marketID string, conversionFactor int64) types.MoneyMarket {
+		return types.NewMoneyMarket(denom,
+			types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.5")),
+			marketID, sdkmath.NewInt(conversionFactor), model, reserveFactor, sdk.MustNewDecFromStr("0.05"))
+	}
+	params := types.NewParams(
+		types.MoneyMarkets{
+			moneyMarket("ukava", "kava:usd", KAVA_CF),
+			moneyMarket("usdx", "usdx:usd", USDX_CF),
+			moneyMarket("bnb", "bnb:usd", BNB_CF),
+		},
+		sdk.NewDec(10),
+	)
+	params.AssetCategories = types.AssetCategories{
+		types.NewAssetCategory("kava", []string{"ukava", "usdx"}, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")),
+	}
+	hardGS := types.NewGenesisState(params, types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: time.Now().Add(100 * time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF)),
+		sdk.NewCoin("bnb", sdkmath.NewInt(100*BNB_CF)),
+	))
+	suite.Require().NoError(err)
+
+	keeper := tApp.GetHardKeeper()
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = keeper
+
+	// $200 of collateral supports $100 of borrows at the standard loan-to-value
+	suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))))
+	err = keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(150*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	err = keeper.SetAssetCategory(ctx, borrower, "unknown")
+	suite.Require().ErrorIs(err, types.ErrInvalidAssetCategory)
+
+	// the category raises the borrow limit to $180
+	suite.Require().NoError(keeper.SetAssetCategory(ctx, borrower, "kava"))
+	category, found := keeper.GetAccountAssetCategory(ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal("kava", category)
+	suite.Require().NoError(keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(150*USDX_CF)))))
+
+	// assets outside the category can be deposited, but don't add to the borrow limit
+	suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(5*BNB_CF)))))
+	err = keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(35*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	// nor can they be borrowed
+	err = keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(BNB_CF/100))))
+	suite.Require().ErrorIs(err, types.ErrDenomNotInAssetCategory)
+
+	// leaving the category would put the position over the standard borrow limit
+	err = keeper.SetAssetCategory(ctx, borrower, "")
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	// between the category loan-to-value and liquidation threshold the position can't withdraw, but isn't liquidatable
+	suite.setKavaPrice(ctx, sdk.MustNewDecFromStr("1.65"))
+	deposit, _ := keeper.GetSyncedDeposit(ctx, borrower)
+	borrow, _ := keeper.GetSyncedBorrow(ctx, borrower)
+	valid, err := keeper.IsWithinValidLtvRange(ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.Require().True(valid)
+	err = keeper.Withdraw(ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(KAVA_CF))))
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawAmount)
+
+	// past the liquidation threshold the position is liquidatable
+	suite.setKavaPrice(ctx, sdk.MustNewDecFromStr("1.55"))
+	valid, err = keeper.IsWithinValidLtvRange(ctx, deposit, borrow)
+	suite.Require().NoError(err)
+	suite.Require().False(valid)
+}
+
+func (suite *KeeperTestSuite) setKavaPrice(ctx sdk.Context, price sdk.Dec) {
+	pricefeedKeeper := suite.app.GetPriceFeedKeeper()
+	_, err := pricefeedKeeper.SetPrice(ctx, sdk.AccAddress{}, "kava:usd", price, ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, "kava:usd"))
+}
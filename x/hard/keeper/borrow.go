@@ -13,6 +13,11 @@ import (
 
 // Borrow funds
 func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	return k.borrow(ctx, borrower, borrower, coins)
+}
+
+// borrow adds coins to the borrower's borrow, against the borrower's deposits, and sends them to the recipient
+func (k Keeper) borrow(ctx sdk.Context, borrower, recipient sdk.AccAddress, coins sdk.Coins) error {
 	// Set any new denoms' global borrow index to 1.0
 	for _, coin := range coins {
 		_, foundInterestFactor := k.GetBorrowInterestFactor(ctx, coin.Denom)
@@ -44,7 +49,7 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	}
 
 	// Sends coins from Hard module account to user
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, coins)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, recipient, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
 			macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -163,14 +168,35 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 					newProposedAssetTotalBorrowedAmount, moneyMarket.BorrowLimit.MaximumLimit)
 			}
 		}
+
+		// Validate the requested borrow amount against the money market's per-account borrow cap
+		headroom, capped := k.GetAccountBorrowHeadroom(ctx, borrower, coin.Denom)
+		if capped && coin.Amount.GT(headroom) {
+			return errorsmod.Wrapf(types.ErrExceedsAccountBorrowCap,
+				"borrow of %s exceeds the account's remaining borrow cap of %s%s", coin, headroom, coin.Denom)
+		}
 		proprosedBorrowUSDValue = proprosedBorrowUSDValue.Add(coinUSDValue)
 	}
 
-	// Get the total borrowable USD amount at user's existing deposits
+	if err := k.ValidateAssetCategoryDenoms(ctx, borrower, amount); err != nil {
+		return err
+	}
+
+	// Get the total borrowable USD amount at user's existing deposits, counting only those used as collateral
 	deposit, found := k.GetDeposit(ctx, borrower)
 	if !found {
 		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
+	deposit = k.GetCollateral(ctx, deposit)
+	existingBorrow, hasExistingBorrow := k.GetBorrow(ctx, borrower)
+
+	// Positions entirely within the account's asset category borrow at the category's loan-to-value
+	proposedBorrowCoins := amount
+	if hasExistingBorrow {
+		proposedBorrowCoins = existingBorrow.Amount.Add(amount...)
+	}
+	category, inCategory := k.GetEffectiveAssetCategory(ctx, borrower, deposit.Amount, proposedBorrowCoins)
+
 	totalBorrowableAmount := sdk.ZeroDec()
 	for _, coin := range deposit.Amount {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
@@ -184,14 +210,17 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		loanToValue := moneyMarket.BorrowLimit.LoanToValue
+		if inCategory {
+			loanToValue = category.LoanToValue
+		}
+		borrowableAmountForDeposit := depositUSDValue.Mul(loanToValue)
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
 	// Get the total USD value of user's existing borrows
 	existingBorrowUSDValue := sdk.ZeroDec()
-	existingBorrow, found := k.GetBorrow(ctx, borrower)
-	if found {
+	if hasExistingBorrow {
 		for _, coin := range existingBorrow.Amount {
 			moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
 			if !found {
@@ -269,6 +298,25 @@ func (k Keeper) GetSyncedBorrow(ctx sdk.Context, borrower sdk.AccAddress) (types
 	return k.loadSyncedBorrow(ctx, borrow), true
 }
 
+// GetAccountBorrowHeadroom returns the amount of a denom an account can still borrow before its money market's
+// account borrow cap is reached. The boolean is false if the money market has no account borrow cap.
+func (k Keeper) GetAccountBorrowHeadroom(ctx sdk.Context, borrower sdk.AccAddress, denom string) (sdkmath.Int, bool) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found || mm.AccountBorrowCap == nil {
+		return sdkmath.Int{}, false
+	}
+
+	borrowed := sdk.ZeroInt()
+	if borrow, found := k.GetSyncedBorrow(ctx, borrower); found {
+		borrowed = borrow.Amount.AmountOf(denom)
+	}
+	headroom := mm.AccountBorrowCap.Sub(borrowed)
+	if headroom.IsNegative() {
+		return sdk.ZeroInt(), true
+	}
+	return headroom, true
+}
+
 // loadSyncedBorrow calculates a user's synced borrow, but does not update state
 func (k Keeper) loadSyncedBorrow(ctx sdk.Context, borrow types.Borrow) types.Borrow {
 	totalNewInterest := sdk.Coins{}
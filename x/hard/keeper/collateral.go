@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// IsCollateralEnabled returns true if an account's deposits of a denom are used as collateral, which they
+// are unless the account has disabled it
+func (k Keeper) IsCollateralEnabled(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DisabledCollateralPrefix)
+	return !store.Has(types.DisabledCollateralKey(addr, denom))
+}
+
+// SetDisabledCollateral records that an account doesn't use a denom as collateral
+func (k Keeper) SetDisabledCollateral(ctx sdk.Context, disabledCollateral types.DisabledCollateral) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DisabledCollateralPrefix)
+	bz := k.cdc.MustMarshal(&disabledCollateral)
+	store.Set(types.DisabledCollateralKey(disabledCollateral.Address, disabledCollateral.Denom), bz)
+}
+
+// DeleteDisabledCollateral re-enables a denom as collateral for an account
+func (k Keeper) DeleteDisabledCollateral(ctx sdk.Context, disabledCollateral types.DisabledCollateral) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DisabledCollateralPrefix)
+	store.Delete(types.DisabledCollateralKey(disabledCollateral.Address, disabledCollateral.Denom))
+}
+
+// IterateDisabledCollateral iterates over the denoms each account has disabled as collateral
+func (k Keeper) IterateDisabledCollateral(ctx sdk.Context, cb func(disabledCollateral types.DisabledCollateral) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DisabledCollateralPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var disabledCollateral types.DisabledCollateral
+		k.cdc.MustUnmarshal(iterator.Value(), &disabledCollateral)
+		if cb(disabledCollateral) {
+			break
+		}
+	}
+}
+
+// GetAllDisabledCollateral returns the denoms each account has disabled as collateral
+func (k Keeper) GetAllDisabledCollateral(ctx sdk.Context) types.DisabledCollaterals {
+	disabledCollaterals := types.DisabledCollaterals{}
+	k.IterateDisabledCollateral(ctx, func(disabledCollateral types.DisabledCollateral) bool {
+		disabledCollaterals = append(disabledCollaterals, disabledCollateral)
+		return false
+	})
+	return disabledCollaterals
+}
+
+// GetCollateral returns a deposit with only the coins its depositor uses as collateral. Deposits outside the
+// asset category the depositor has opted into are not collateral.
+func (k Keeper) GetCollateral(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	var category *types.AssetCategory
+	if name, found := k.GetAccountAssetCategory(ctx, deposit.Depositor); found {
+		if c, found := k.GetParams(ctx).AssetCategories.Get(name); found {
+			category = &c
+		}
+	}
+	return k.getCollateralInCategory(ctx, deposit, category)
+}
+
+// getCollateralInCategory returns a deposit with only the coins its depositor uses as collateral while opted
+// into an asset category, or into none if the category is nil
+func (k Keeper) getCollateralInCategory(ctx sdk.Context, deposit types.Deposit, category *types.AssetCategory) types.Deposit {
+	collateral := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		if category != nil && !category.Contains(coin.Denom) {
+			continue
+		}
+		if k.IsCollateralEnabled(ctx, deposit.Depositor, coin.Denom) {
+			collateral = collateral.Add(coin)
+		}
+	}
+	return types.NewDeposit(deposit.Depositor, collateral, deposit.Index)
+}
+
+// SetCollateralEnabled sets whether an account's deposits of a denom are used as collateral. Disabled
+// deposits still earn supply interest, but don't count towards the borrow limit and aren't seized in
+// liquidations. Collateral can't be disabled if the position would then exceed its borrow limit.
+func (k Keeper) SetCollateralEnabled(ctx sdk.Context, addr sdk.AccAddress, denom string, enabled bool) error {
+	if _, found := k.GetMoneyMarket(ctx, denom); !found {
+		return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", denom)
+	}
+
+	deposit, found := k.GetSyncedDeposit(ctx, addr)
+	if !found {
+		deposit = types.NewDeposit(addr, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, addr)
+	if !found {
+		borrow = types.NewBorrow(addr, sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+
+	proposedCollateral := k.GetCollateral(ctx, deposit)
+	amount := sdk.NewCoin(denom, deposit.Amount.AmountOf(denom))
+	if enabled && !k.IsCollateralEnabled(ctx, addr, denom) {
+		proposedCollateral.Amount = proposedCollateral.Amount.Add(amount)
+	}
+	if !enabled && k.IsCollateralEnabled(ctx, addr, denom) {
+		proposedCollateral.Amount = proposedCollateral.Amount.Sub(amount)
+	}
+
+	if !borrow.Amount.IsZero() {
+		liqMap, err := k.LoadLiquidationData(ctx, proposedCollateral, borrow)
+		if err != nil {
+			return err
+		}
+		if !isWithinLtvRange(liqMap, proposedCollateral, borrow, false) {
+			return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "position would exceed the borrow limit after disabling %s as collateral", denom)
+		}
+	}
+
+	if enabled {
+		k.DeleteDisabledCollateral(ctx, types.NewDisabledCollateral(addr, denom))
+	} else {
+		k.SetDisabledCollateral(ctx, types.NewDisabledCollateral(addr, denom))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetCollateralEnabled,
+			sdk.NewAttribute(types.AttributeKeyOwner, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyCollateralEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+	return nil
+}
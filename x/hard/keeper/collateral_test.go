@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestSetCollateralEnabled() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("testowner")))
+	liquidator := sdk.AccAddress(crypto.AddressHash([]byte("testliquidator")))
+	usdx := func(amount int64) sdk.Coin { return sdk.NewCoin("usdx", sdkmath.NewInt(amount*USDX_CF)) }
+	ukava := func(amount int64) sdk.Coin { return sdk.NewCoin("ukava", sdkmath.NewInt(amount*KAVA_CF)) }
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{sdk.NewCoins(ukava(100), usdx(50))},
+		[]sdk.AccAddress{owner},
+	)
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("ukava",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.5")),
+				"kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")),
+				"usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.Require().NoError(tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(usdx(1000))))
+
+	keeper := tApp.GetHardKeeper()
+
+	err := keeper.SetCollateralEnabled(ctx, owner, "bnb", false)
+	suite.Require().ErrorIs(err, types.ErrMarketNotFound)
+
+	// $200 of kava supports $100 of borrows, and $50 of usdx supports another $40
+	suite.Require().NoError(keeper.Deposit(ctx, owner, sdk.NewCoins(ukava(100), usdx(50))))
+	suite.Require().True(keeper.IsCollateralEnabled(ctx, owner, "usdx"))
+	suite.Require().NoError(keeper.SetCollateralEnabled(ctx, owner, "usdx", false))
+	suite.Require().False(keeper.IsCollateralEnabled(ctx, owner, "usdx"))
+	suite.Require().Equal(types.DisabledCollaterals{types.NewDisabledCollateral(owner, "usdx")}, keeper.GetAllDisabledCollateral(ctx))
+
+	// the usdx deposit no longer counts towards the borrow limit
+	err = keeper.Borrow(ctx, owner, sdk.NewCoins(usdx(101)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	suite.Require().NoError(keeper.Borrow(ctx, owner, sdk.NewCoins(usdx(90))))
+
+	ltv, err := keeper.GetStoreLTV(ctx, owner)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.45"), ltv)
+
+	// disabled deposits can be withdrawn without affecting the position
+	suite.Require().NoError(keeper.Withdraw(ctx, owner, sdk.NewCoins(usdx(10))))
+
+	// collateral the position depends on can't be disabled
+	err = keeper.SetCollateralEnabled(ctx, owner, "ukava", false)
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	suite.Require().NoError(keeper.SetCollateralEnabled(ctx, owner, "usdx", true))
+	suite.Require().NoError(keeper.SetCollateralEnabled(ctx, owner, "usdx", false))
+
+	// at $1.50 the $150 of kava only supports $75 of borrows
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pricefeedKeeper.SetPrice(ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("1.5"), ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, "kava:usd"))
+
+	// only the kava is seized, and the usdx deposit is left with the owner
+	suite.Require().NoError(keeper.AttemptKeeperLiquidation(ctx, liquidator, owner))
+	deposit, found := keeper.GetDeposit(ctx, owner)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(usdx(40)), deposit.Amount)
+	suite.Require().Len(deposit.Index, 1)
+	_, found = keeper.GetBorrow(ctx, owner)
+	suite.Require().False(found)
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetCreditDelegation returns the credit delegation from a delegator to a delegatee
+func (k Keeper) GetCreditDelegation(ctx sdk.Context, delegator, delegatee sdk.AccAddress) (types.CreditDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	bz := store.Get(types.CreditDelegationKey(delegator, delegatee))
+	if len(bz) == 0 {
+		return types.CreditDelegation{}, false
+	}
+	var creditDelegation types.CreditDelegation
+	k.cdc.MustUnmarshal(bz, &creditDelegation)
+	return creditDelegation, true
+}
+
+// SetCreditDelegation stores a credit delegation, or deletes it if the delegatee can't borrow and owes nothing
+func (k Keeper) SetCreditDelegation(ctx sdk.Context, creditDelegation types.CreditDelegation) {
+	if creditDelegation.IsEmpty() {
+		k.DeleteCreditDelegation(ctx, creditDelegation)
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	bz := k.cdc.MustMarshal(&creditDelegation)
+	store.Set(types.CreditDelegationKey(creditDelegation.Delegator, creditDelegation.Delegatee), bz)
+}
+
+// DeleteCreditDelegation deletes a credit delegation from the store
+func (k Keeper) DeleteCreditDelegation(ctx sdk.Context, creditDelegation types.CreditDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	store.Delete(types.CreditDelegationKey(creditDelegation.Delegator, creditDelegation.Delegatee))
+}
+
+// IterateCreditDelegations iterates over all credit delegations
+func (k Keeper) IterateCreditDelegations(ctx sdk.Context, cb func(creditDelegation types.CreditDelegation) (stop bool)) {
+	k.iterateCreditDelegations(ctx, []byte{}, cb)
+}
+
+// IterateCreditDelegationsByDelegator iterates over the credit delegations of a delegator
+func (k Keeper) IterateCreditDelegationsByDelegator(ctx sdk.Context, delegator sdk.AccAddress, cb func(creditDelegation types.CreditDelegation) (stop bool)) {
+	k.iterateCreditDelegations(ctx, types.CreditDelegatorIteratorKey(delegator), cb)
+}
+
+func (k Keeper) iterateCreditDelegations(ctx sdk.Context, keyPrefix []byte, cb func(creditDelegation types.CreditDelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var creditDelegation types.CreditDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &creditDelegation)
+		if cb(creditDelegation) {
+			break
+		}
+	}
+}
+
+// GetAllCreditDelegations returns all credit delegations
+func (k Keeper) GetAllCreditDelegations(ctx sdk.Context) types.CreditDelegations {
+	creditDelegations := types.CreditDelegations{}
+	k.IterateCreditDelegations(ctx, func(creditDelegation types.CreditDelegation) bool {
+		creditDelegations = append(creditDelegations, creditDelegation)
+		return false
+	})
+	return creditDelegations
+}
+
+// ApproveCreditDelegation sets how much of a denom a delegatee can borrow against the delegator's deposits,
+// replacing any previous allowance for the denom. Debt the delegatee already owes is unaffected.
+func (k Keeper) ApproveCreditDelegation(ctx sdk.Context, delegator, delegatee sdk.AccAddress, amount sdk.Coin) error {
+	if _, found := k.GetMoneyMarket(ctx, amount.Denom); !found {
+		return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", amount.Denom)
+	}
+
+	creditDelegation, found := k.GetCreditDelegation(ctx, delegator, delegatee)
+	if !found {
+		creditDelegation = types.NewCreditDelegation(delegator, delegatee, sdk.NewCoins(), sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+	allowance := sdk.NewCoins()
+	for _, coin := range creditDelegation.Allowance {
+		if coin.Denom != amount.Denom {
+			allowance = allowance.Add(coin)
+		}
+	}
+	creditDelegation.Allowance = allowance.Add(amount)
+	k.SetCreditDelegation(ctx, creditDelegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardApproveCreditDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegatee.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, amount.String()),
+		),
+	)
+	return nil
+}
+
+// DelegatedBorrow borrows coins against the delegator's deposits and sends them to the delegatee. The debt is
+// added to the delegator's borrow, and to the amount the delegatee owes through the credit delegation, which accrues
+// interest at the same rate.
+func (k Keeper) DelegatedBorrow(ctx sdk.Context, delegatee, delegator sdk.AccAddress, coins sdk.Coins) error {
+	creditDelegation, found := k.GetCreditDelegation(ctx, delegator, delegatee)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCreditDelegationNotFound, "no credit delegation from %s to %s", delegator, delegatee)
+	}
+	allowance, isNegative := creditDelegation.Allowance.SafeSub(coins...)
+	if isNegative {
+		return errorsmod.Wrapf(types.ErrExceedsCreditDelegation, "borrow %s > allowance %s", coins, creditDelegation.Allowance)
+	}
+
+	if err := k.borrow(ctx, delegator, delegatee, coins); err != nil {
+		return err
+	}
+
+	creditDelegation = k.syncCreditDelegationDebt(ctx, creditDelegation, coins)
+	creditDelegation.Allowance = allowance
+	k.SetCreditDelegation(ctx, creditDelegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardDelegatedBorrow,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegatee.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, coins.String()),
+		),
+	)
+	return nil
+}
+
+// updateCreditDelegationDebts records a repayment of a delegator's borrow. A repayment sent by a delegatee
+// reduces what the delegatee owes, and no delegatee can owe more of a denom than the delegator still has
+// borrowed, which also clears delegated debt when a borrow is fully repaid or liquidated.
+func (k Keeper) updateCreditDelegationDebts(ctx sdk.Context, delegator, sender sdk.AccAddress, payment sdk.Coins) {
+	remaining := sdk.NewCoins()
+	if borrow, found := k.GetBorrow(ctx, delegator); found {
+		remaining = borrow.Amount
+	}
+
+	k.IterateCreditDelegationsByDelegator(ctx, delegator, func(creditDelegation types.CreditDelegation) bool {
+		// Interest is added before the repayment, as it is to the delegator's borrow
+		creditDelegation = k.syncCreditDelegationDebt(ctx, creditDelegation, sdk.NewCoins())
+
+		borrowed := sdk.NewCoins()
+		for _, coin := range creditDelegation.Borrowed {
+			amount := coin.Amount
+			if creditDelegation.Delegatee.Equals(sender) {
+				amount = amount.Sub(payment.AmountOf(coin.Denom))
+			}
+			amount = sdk.MinInt(amount, remaining.AmountOf(coin.Denom))
+			if amount.IsPositive() {
+				borrowed = borrowed.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		index := types.BorrowInterestFactors{}
+		for _, factor := range creditDelegation.Index {
+			if borrowed.AmountOf(factor.Denom).IsPositive() {
+				index = append(index, factor)
+			}
+		}
+		creditDelegation.Borrowed = borrowed
+		creditDelegation.Index = index
+		k.SetCreditDelegation(ctx, creditDelegation)
+		return false
+	})
+}
+
+// syncCreditDelegationDebt adds the interest accrued on what a delegatee owes since it was last synced, then adds the
+// newly borrowed coins. The interest factors are set to the current global factors, but state is not updated.
+func (k Keeper) syncCreditDelegationDebt(ctx sdk.Context, creditDelegation types.CreditDelegation, newBorrowed sdk.Coins) types.CreditDelegation {
+	borrowed := sdk.NewCoins()
+	for _, coin := range creditDelegation.Borrowed {
+		amount := coin.Amount
+		interestFactorValue, foundInterestFactorValue := k.GetBorrowInterestFactor(ctx, coin.Denom)
+		lastInterestFactor, foundLastInterestFactor := creditDelegation.Index.GetInterestFactor(coin.Denom)
+		if foundInterestFactorValue && foundLastInterestFactor {
+			amount = sdk.NewDecFromInt(amount).Quo(lastInterestFactor).Mul(interestFactorValue).TruncateInt()
+		}
+		borrowed = borrowed.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	borrowed = borrowed.Add(newBorrowed...)
+
+	index := types.BorrowInterestFactors{}
+	for _, coin := range borrowed {
+		interestFactorValue, found := k.GetBorrowInterestFactor(ctx, coin.Denom)
+		if !found {
+			interestFactorValue = sdk.OneDec()
+		}
+		index = append(index, types.NewBorrowInterestFactor(coin.Denom, interestFactorValue))
+	}
+
+	creditDelegation.Borrowed = borrowed
+	creditDelegation.Index = index
+	return creditDelegation
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestCreditDelegation() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	delegator := sdk.AccAddress(crypto.AddressHash([]byte("testdelegator")))
+	delegatee := sdk.AccAddress(crypto.AddressHash([]byte("testdelegatee")))
+	usdx := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(amount*USDX_CF))) }
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)), sdk.NewCoin("usdx", sdkmath.NewInt(20*USDX_CF))),
+			usdx(10),
+		},
+		[]sdk.AccAddress{delegator, delegatee},
+	)
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("ukava",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.5")),
+				"kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")),
+				"usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.Require().NoError(tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, usdx(1000)))
+
+	keeper := tApp.GetHardKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+
+	// $200 of collateral supports $100 of borrows
+	suite.Require().NoError(keeper.Deposit(ctx, delegator, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))))
+
+	err := keeper.DelegatedBorrow(ctx, delegatee, delegator, usdx(10))
+	suite.Require().ErrorIs(err, types.ErrCreditDelegationNotFound)
+
+	suite.Require().NoError(keeper.ApproveCreditDelegation(ctx, delegator, delegatee, sdk.NewCoin("usdx", sdkmath.NewInt(60*USDX_CF))))
+
+	err = keeper.DelegatedBorrow(ctx, delegatee, delegator, usdx(61))
+	suite.Require().ErrorIs(err, types.ErrExceedsCreditDelegation)
+
+	// the delegatee receives the coins, and the debt is added to the delegator's borrow
+	suite.Require().NoError(keeper.DelegatedBorrow(ctx, delegatee, delegator, usdx(40)))
+	suite.Require().Equal(usdx(50), bankKeeper.GetAllBalances(ctx, delegatee))
+	borrow, found := keeper.GetBorrow(ctx, delegator)
+	suite.Require().True(found)
+	suite.Require().Equal(usdx(40), borrow.Amount)
+	_, found = keeper.GetBorrow(ctx, delegatee)
+	suite.Require().False(found)
+
+	creditDelegation, found := keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().True(found)
+	suite.Require().Equal(usdx(20), creditDelegation.Allowance)
+	suite.Require().Equal(usdx(40), creditDelegation.Borrowed)
+
+	// the delegated debt counts against the delegator's borrow limit
+	suite.Require().NoError(keeper.Borrow(ctx, delegator, usdx(50)))
+	err = keeper.DelegatedBorrow(ctx, delegatee, delegator, usdx(20))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	// repayments by the delegatee reduce what it owes
+	suite.Require().NoError(keeper.Repay(ctx, delegatee, delegator, usdx(15)))
+	creditDelegation, _ = keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().Equal(usdx(25), creditDelegation.Borrowed)
+
+	// repayments by the delegator don't, until the delegator's borrow is less than what the delegatee owes
+	suite.Require().NoError(keeper.Repay(ctx, delegator, delegator, usdx(50)))
+	creditDelegation, _ = keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().Equal(usdx(25), creditDelegation.Borrowed)
+	suite.Require().NoError(keeper.Repay(ctx, delegator, delegator, usdx(15)))
+	creditDelegation, _ = keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().Equal(usdx(10), creditDelegation.Borrowed)
+
+	// once the allowance is removed and the debt repaid, the credit delegation is deleted
+	suite.Require().NoError(keeper.ApproveCreditDelegation(ctx, delegator, delegatee, sdk.NewCoin("usdx", sdk.ZeroInt())))
+	suite.Require().NoError(keeper.Repay(ctx, delegatee, delegator, usdx(10)))
+	_, found = keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().False(found)
+
+	// delegated debt accrues interest with the delegator's borrow
+	suite.Require().NoError(keeper.ApproveCreditDelegation(ctx, delegator, delegatee, sdk.NewCoin("usdx", sdkmath.NewInt(20*USDX_CF))))
+	suite.Require().NoError(keeper.AccrueInterest(ctx, "usdx"))
+	suite.Require().NoError(keeper.DelegatedBorrow(ctx, delegatee, delegator, usdx(20)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(72 * time.Hour))
+	suite.Require().NoError(keeper.AccrueInterest(ctx, "usdx"))
+	borrow, found = keeper.GetSyncedBorrow(ctx, delegator)
+	suite.Require().True(found)
+	suite.Require().True(borrow.Amount.IsAllGT(usdx(20)))
+
+	// the delegatee owes the interest, and repays it along with the principal
+	suite.Require().NoError(keeper.Repay(ctx, delegatee, delegator, usdx(10)))
+	creditDelegation, found = keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().True(found)
+	owed := borrow.Amount.Sub(usdx(10)...)
+	suite.Require().Equal(owed, creditDelegation.Borrowed)
+	suite.Require().True(owed.IsAllGT(usdx(10)))
+
+	borrow, _ = keeper.GetBorrow(ctx, delegator)
+	suite.Require().Equal(borrow.Amount, creditDelegation.Borrowed)
+	suite.Require().NoError(keeper.Repay(ctx, delegatee, delegator, owed))
+	_, found = keeper.GetCreditDelegation(ctx, delegator, delegatee)
+	suite.Require().False(found)
+}
@@ -111,11 +111,35 @@ func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
 		if !foundMm {
 			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, "money market denom %s not found", depCoin.Denom)
 		}
+
+		headroom, capped := k.GetSupplyHeadroom(ctx, depCoin.Denom)
+		if capped && depCoin.Amount.GT(headroom) {
+			return errorsmod.Wrapf(types.ErrExceedsSupplyCap, "deposit of %s exceeds the remaining supply cap of %s%s", depCoin, headroom, depCoin.Denom)
+		}
 	}
 
 	return nil
 }
 
+// GetSupplyHeadroom returns the amount of a denom that can still be supplied before its money market's supply cap
+// is reached. The boolean is false if the money market has no supply cap.
+func (k Keeper) GetSupplyHeadroom(ctx sdk.Context, denom string) (sdkmath.Int, bool) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found || mm.SupplyCap == nil {
+		return sdkmath.Int{}, false
+	}
+
+	suppliedCoins, found := k.GetSuppliedCoins(ctx)
+	if !found {
+		suppliedCoins = sdk.NewCoins()
+	}
+	headroom := mm.SupplyCap.Sub(suppliedCoins.AmountOf(denom))
+	if headroom.IsNegative() {
+		return sdk.ZeroInt(), true
+	}
+	return headroom, true
+}
+
 // GetTotalDeposited returns the total amount deposited for the input deposit type and deposit denom
 func (k Keeper) GetTotalDeposited(ctx sdk.Context, depositDenom string) (total sdkmath.Int) {
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// FlashLoan lends coins to the borrower without collateral, executes msgs, then collects the coins plus a
+// fee from the borrower. Any failure, including the borrower not holding enough to repay, fails the whole
+// loan. The borrower's Borrow and the module's borrowed coins are never modified.
+func (k Keeper) FlashLoan(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) (sdk.Coins, [][]byte, error) {
+	if amount.IsZero() {
+		return nil, nil, types.ErrBorrowEmptyCoins
+	}
+
+	for _, coin := range amount {
+		if _, found := k.GetMoneyMarket(ctx, coin.Denom); !found {
+			return nil, nil, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		// interest accrues before the module's cash is lent out, so the loan doesn't move interest rates
+		if err := k.AccrueInterest(ctx, coin.Denom); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// The reserve coins aren't available for flash loans
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+	reserveCoins, foundReserveCoins := k.GetTotalReserves(ctx)
+	if !foundReserveCoins {
+		reserveCoins = sdk.NewCoins()
+	}
+	fundsAvailableToBorrow, isNegative := hardMaccCoins.SafeSub(reserveCoins...)
+	if isNegative {
+		return nil, nil, errorsmod.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s", reserveCoins, hardMaccCoins)
+	}
+	if amount.IsAnyGT(fundsAvailableToBorrow) {
+		return nil, nil, errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested flash loan %s > available to borrow %s", amount, fundsAvailableToBorrow)
+	}
+
+	fee := k.CalculateFlashLoanFee(ctx, amount)
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, err := k.executeFlashLoanMsgs(ctx, borrower, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.ModuleAccountName, amount.Add(fee...))
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrFlashLoanNotRepaid, "%s plus fee %s: %s", amount, fee, err)
+	}
+
+	k.distributeFlashLoanFee(ctx, fee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardFlashLoan,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fee.String()),
+		),
+	)
+
+	return fee, results, nil
+}
+
+// CalculateFlashLoanFee returns the fee owed on a flash loan, rounded up
+func (k Keeper) CalculateFlashLoanFee(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
+	feeRate := k.GetParams(ctx).FlashLoanFee
+	fee := sdk.NewCoins()
+	for _, coin := range amount {
+		feeAmount := sdk.NewDecFromInt(coin.Amount).Mul(feeRate).Ceil().TruncateInt()
+		fee = fee.Add(sdk.NewCoin(coin.Denom, feeAmount))
+	}
+	return fee
+}
+
+// executeFlashLoanMsgs runs msgs on behalf of the borrower, who must be the only signer of each.
+// The msgs skip the ante handler, so only the msg types allowed in flash loans can be run.
+func (k Keeper) executeFlashLoanMsgs(ctx sdk.Context, borrower sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		if !k.isFlashLoanMsgAllowed(sdk.MsgTypeURL(msg)) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFlashLoanMsgs, "msg %d has type %s, which is not allowed in flash loans", i, sdk.MsgTypeURL(msg))
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFlashLoanMsgs, "msg %d must be signed only by the borrower %s", i, borrower)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute flash loan message %d", i)
+		}
+		results[i] = res.Data
+
+		events := make(sdk.Events, 0, len(res.Events))
+		for _, event := range res.Events {
+			e := event
+			e.Attributes = append(e.Attributes, abci.EventAttribute{Key: types.AttributeKeyFlashLoanMsgIndex, Value: strconv.Itoa(i)})
+			events = append(events, sdk.Event(e))
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+	return results, nil
+}
+
+func (k Keeper) isFlashLoanMsgAllowed(msgTypeURL string) bool {
+	for _, allowedType := range k.flashLoanMsgTypes {
+		if msgTypeURL == allowedType {
+			return true
+		}
+	}
+	return false
+}
+
+// distributeFlashLoanFee splits a repaid flash loan fee between reserves and suppliers using each
+// money market's reserve factor. The supplier share is paid out by increasing the supply interest factor.
+func (k Keeper) distributeFlashLoanFee(ctx sdk.Context, fee sdk.Coins) {
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	reserves, found := k.GetTotalReserves(ctx)
+	if !found {
+		reserves = sdk.NewCoins()
+	}
+	borrowedCoins, found := k.GetBorrowedCoins(ctx)
+	if !found {
+		borrowedCoins = sdk.NewCoins()
+	}
+	suppliedCoins, found := k.GetSuppliedCoins(ctx)
+	if !found {
+		suppliedCoins = sdk.NewCoins()
+	}
+
+	newReserves := sdk.NewCoins()
+	for _, coin := range fee {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		reserveAmount := sdk.NewDecFromInt(coin.Amount).Mul(mm.ReserveFactor).TruncateInt()
+		supplierAmount := coin.Amount.Sub(reserveAmount)
+
+		// with no suppliers to pay, the whole fee goes to reserves
+		if suppliedCoins.AmountOf(coin.Denom).IsZero() {
+			reserveAmount = coin.Amount
+			supplierAmount = sdk.ZeroInt()
+		}
+		newReserves = newReserves.Add(sdk.NewCoin(coin.Denom, reserveAmount))
+		if !supplierAmount.IsPositive() {
+			continue
+		}
+
+		// cash is measured before the fee was paid in, as the fee is the new interest
+		cash := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), coin.Denom).Amount.Sub(coin.Amount)
+		supplyInterestFactorPrior, found := k.GetSupplyInterestFactor(ctx, coin.Denom)
+		if !found {
+			supplyInterestFactorPrior = sdk.OneDec()
+		}
+		supplyInterestFactor := CalculateSupplyInterestFactor(
+			sdk.NewDecFromInt(supplierAmount),
+			sdk.NewDecFromInt(cash),
+			sdk.NewDecFromInt(borrowedCoins.AmountOf(coin.Denom)),
+			sdk.NewDecFromInt(reserves.AmountOf(coin.Denom)),
+		)
+		k.SetSupplyInterestFactor(ctx, coin.Denom, supplyInterestFactorPrior.Mul(supplyInterestFactor))
+		k.IncrementSuppliedCoins(ctx, sdk.NewCoins(sdk.NewCoin(coin.Denom, supplierAmount)))
+	}
+	k.SetTotalReserves(ctx, reserves.Add(newReserves...))
+}
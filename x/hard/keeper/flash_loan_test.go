@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashLoan() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	other := sdk.AccAddress(crypto.AddressHash([]byte("testother")))
+	usdx := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(amount*USDX_CF))) }
+
+	testCases := []struct {
+		name        string
+		amount      sdk.Coins
+		msgs        func(loan sdk.Coins) []sdk.Msg
+		expectedErr error
+	}{
+		{
+			"valid: loaned funds are deposited and withdrawn",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				deposit := types.NewMsgDeposit(borrower, loan)
+				withdraw := types.NewMsgWithdraw(borrower, loan)
+				return []sdk.Msg{&deposit, &withdraw}
+			},
+			nil,
+		},
+		{
+			"invalid: loan is not repaid",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(borrower, other, loan)}
+			},
+			types.ErrFlashLoanNotRepaid,
+		},
+		{
+			"invalid: msg signed by another account",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(other, borrower, loan)}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: exceeds available liquidity",
+			usdx(1001),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(borrower, other, loan)}
+			},
+			types.ErrExceedsProtocolBorrowableBalance,
+		},
+		{
+			"invalid: MsgEthereumTx is not allowed",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{&evmtypes.MsgEthereumTx{}}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: MsgCreateVestingAccount is not allowed",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{vestingtypes.NewMsgCreateVestingAccount(borrower, other, loan, tmtime.Now().Add(time.Hour).Unix(), false)}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: MsgCreatePermanentLockedAccount is not allowed",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{vestingtypes.NewMsgCreatePermanentLockedAccount(borrower, other, loan)}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: MsgCreatePeriodicVestingAccount is not allowed",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				periods := vestingtypes.Periods{{Length: 3600, Amount: loan}}
+				return []sdk.Msg{vestingtypes.NewMsgCreatePeriodicVestingAccount(borrower, other, tmtime.Now().Unix(), periods)}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: nested flash loan",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				send := banktypes.NewMsgSend(borrower, other, loan)
+				nested, err := types.NewMsgFlashLoan(borrower, loan, []sdk.Msg{send})
+				suite.Require().NoError(err)
+				return []sdk.Msg{&nested}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: flash loan nested in MsgExec",
+			usdx(500),
+			func(loan sdk.Coins) []sdk.Msg {
+				send := banktypes.NewMsgSend(borrower, other, loan)
+				nested, err := types.NewMsgFlashLoan(borrower, loan, []sdk.Msg{send})
+				suite.Require().NoError(err)
+				exec := authz.NewMsgExec(borrower, []sdk.Msg{&nested})
+				return []sdk.Msg{&exec}
+			},
+			types.ErrInvalidFlashLoanMsgs,
+		},
+		{
+			"invalid: no money market",
+			sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(BNB_CF))),
+			func(loan sdk.Coins) []sdk.Msg {
+				return []sdk.Msg{banktypes.NewMsgSend(borrower, other, loan)}
+			},
+			types.ErrMarketNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewFundedGenStateWithCoins(
+				tApp.AppCodec(),
+				[]sdk.Coins{usdx(1000), usdx(10)},
+				[]sdk.AccAddress{depositor, borrower},
+			)
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")),
+						"usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.05")),
+				},
+				sdk.NewDec(10),
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+				},
+			}
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+			keeper := tApp.GetHardKeeper()
+			bankKeeper := tApp.GetBankKeeper()
+			suite.Require().NoError(keeper.Deposit(ctx, depositor, usdx(1000)))
+
+			supplyFactorBefore, _ := keeper.GetSupplyInterestFactor(ctx, "usdx")
+			suppliedBefore, _ := keeper.GetSuppliedCoins(ctx)
+
+			// a failed flash loan reverts like a failed tx
+			cacheCtx, write := ctx.CacheContext()
+			fee, results, err := keeper.FlashLoan(cacheCtx, borrower, tc.amount, tc.msgs(tc.amount))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			write()
+
+			// 0.09% of 500 usdx
+			expectedFee := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(450_000)))
+			suite.Require().Equal(expectedFee, fee)
+			suite.Require().Len(results, 2)
+			suite.Require().Equal(usdx(10).Sub(expectedFee...), bankKeeper.GetAllBalances(ctx, borrower))
+
+			// the borrower's position and the module's borrowed coins are untouched
+			_, found := keeper.GetBorrow(ctx, borrower)
+			suite.Require().False(found)
+			_, found = keeper.GetDeposit(ctx, borrower)
+			suite.Require().False(found)
+			borrowed, _ := keeper.GetBorrowedCoins(ctx)
+			suite.Require().True(borrowed.IsZero())
+
+			// 20% of the fee goes to reserves, the rest to suppliers
+			reserves, _ := keeper.GetTotalReserves(ctx)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(90_000))), reserves)
+			supplied, _ := keeper.GetSuppliedCoins(ctx)
+			suite.Require().Equal(suppliedBefore.Add(sdk.NewCoin("usdx", sdkmath.NewInt(360_000))), supplied)
+			supplyFactor, _ := keeper.GetSupplyInterestFactor(ctx, "usdx")
+			suite.Require().Equal(supplyFactorBefore.Mul(sdk.MustNewDecFromStr("1.00036")), supplyFactor)
+
+			// the depositor can withdraw their share of the fee
+			deposit, _ := keeper.GetSyncedDeposit(ctx, depositor)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF+360_000))), deposit.Amount)
+		})
+	}
+}
@@ -544,3 +544,273 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) AccountHealth(ctx context.Context, req *types.QueryAccountHealthRequest) (*types.QueryAccountHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	deposit, _ := s.keeper.GetSyncedDeposit(sdkCtx, owner)
+	deposit.Depositor = owner
+	borrow, _ := s.keeper.GetSyncedBorrow(sdkCtx, owner)
+	borrow.Borrower = owner
+
+	health, err := s.accountHealth(sdkCtx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountHealthResponse{
+		AccountHealth: health,
+	}, nil
+}
+
+func (s queryServer) LiquidationCandidates(ctx context.Context, req *types.QueryLiquidationCandidatesRequest) (*types.QueryLiquidationCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	candidates := []types.AccountHealthResponse{}
+	var err error
+	s.keeper.IterateBorrows(sdkCtx, func(borrow types.Borrow) (stop bool) {
+		syncedBorrow, _ := s.keeper.GetSyncedBorrow(sdkCtx, borrow.Borrower)
+		syncedDeposit, _ := s.keeper.GetSyncedDeposit(sdkCtx, borrow.Borrower)
+		syncedDeposit.Depositor = borrow.Borrower
+
+		// positions that can't be valued, for example due to a missing price, can't be liquidated either
+		isWithinRange, valueErr := s.keeper.IsWithinValidLtvRange(sdkCtx, syncedDeposit, syncedBorrow)
+		if valueErr != nil || isWithinRange {
+			return false
+		}
+
+		var health types.AccountHealthResponse
+		health, err = s.accountHealth(sdkCtx, syncedDeposit, syncedBorrow)
+		if err != nil {
+			return true
+		}
+		candidates = append(candidates, health)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(candidates)
+	start, end := client.Paginate(total, page, limit, 100)
+	if start < 0 || end < 0 {
+		candidates = []types.AccountHealthResponse{}
+	} else {
+		candidates = candidates[start:end]
+	}
+
+	return &types.QueryLiquidationCandidatesResponse{
+		Candidates: candidates,
+		Pagination: &query.PageResponse{Total: uint64(total)},
+	}, nil
+}
+
+// accountHealth values a synced position at current prices, using the limits of its asset category if it has one.
+// Deposits that aren't used as collateral are left out of the valuation.
+func (s queryServer) accountHealth(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (types.AccountHealthResponse, error) {
+	collateral := s.keeper.GetCollateral(ctx, deposit)
+	liqMap, err := s.keeper.LoadLiquidationData(ctx, collateral, borrow)
+	if err != nil {
+		return types.AccountHealthResponse{}, err
+	}
+
+	depositedValue, borrowLimit, liquidationThreshold := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for _, coin := range collateral.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		depositedValue = depositedValue.Add(usdValue)
+		borrowLimit = borrowLimit.Add(usdValue.Mul(lData.ltv))
+		liquidationThreshold = liquidationThreshold.Add(usdValue.Mul(lData.liquidationThreshold))
+	}
+
+	borrowedValue := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		borrowedValue = borrowedValue.Add(usdValue)
+	}
+
+	ltv := sdk.ZeroDec()
+	if depositedValue.IsPositive() {
+		ltv = borrowedValue.Quo(depositedValue)
+	}
+
+	// The position can be liquidated once the borrowed value exceeds the liquidation threshold. With all other
+	// prices fixed, both are linear in the price of any one asset, so each asset has a single liquidation price.
+	liquidationPrices := []types.LiquidationPrice{}
+	if !borrow.Amount.IsZero() {
+		for _, denom := range removeDuplicates(getDenoms(collateral.Amount), getDenoms(borrow.Amount)) {
+			lData := liqMap[denom]
+			depositUnits := sdk.NewDecFromInt(collateral.Amount.AmountOf(denom)).Quo(sdk.NewDecFromInt(lData.conversionFactor))
+			borrowUnits := sdk.NewDecFromInt(borrow.Amount.AmountOf(denom)).Quo(sdk.NewDecFromInt(lData.conversionFactor))
+
+			coefficient := depositUnits.Mul(lData.liquidationThreshold).Sub(borrowUnits)
+			if coefficient.IsZero() {
+				continue
+			}
+			otherThreshold := liquidationThreshold.Sub(depositUnits.Mul(lData.price).Mul(lData.liquidationThreshold))
+			otherBorrowed := borrowedValue.Sub(borrowUnits.Mul(lData.price))
+
+			price := otherBorrowed.Sub(otherThreshold).Quo(coefficient)
+			if price.IsPositive() {
+				liquidationPrices = append(liquidationPrices, types.LiquidationPrice{
+					Denom: denom,
+					Price: price.String(),
+				})
+			}
+		}
+	}
+
+	owner := deposit.Depositor
+	if owner.Empty() {
+		owner = borrow.Borrower
+	}
+
+	return types.AccountHealthResponse{
+		Address:              owner.String(),
+		Deposited:            deposit.Amount,
+		Borrowed:             borrow.Amount,
+		Ltv:                  ltv.String(),
+		BorrowLimit:          borrowLimit.String(),
+		LiquidationThreshold: liquidationThreshold.String(),
+		LiquidationPrices:    liquidationPrices,
+		Collateral:           collateral.Amount,
+	}, nil
+}
+
+func (s queryServer) Headroom(ctx context.Context, req *types.QueryHeadroomRequest) (*types.QueryHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var owner sdk.AccAddress
+	if len(req.Owner) > 0 {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	var moneyMarkets types.MoneyMarkets
+	if len(req.Denom) > 0 {
+		moneyMarket, found := s.keeper.GetMoneyMarket(sdkCtx, req.Denom)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no money market found for denom %s", req.Denom)
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	} else {
+		s.keeper.IterateMoneyMarkets(sdkCtx, func(denom string, moneyMarket types.MoneyMarket) (stop bool) {
+			moneyMarkets = append(moneyMarkets, moneyMarket)
+			return false
+		})
+	}
+
+	headroom := []types.MoneyMarketHeadroom{}
+	for _, moneyMarket := range moneyMarkets {
+		mmHeadroom := types.MoneyMarketHeadroom{
+			Denom: moneyMarket.Denom,
+		}
+		if supplyHeadroom, capped := s.keeper.GetSupplyHeadroom(sdkCtx, moneyMarket.Denom); capped {
+			mmHeadroom.SupplyCap = moneyMarket.SupplyCap.String()
+			mmHeadroom.SupplyHeadroom = supplyHeadroom.String()
+		}
+		if moneyMarket.AccountBorrowCap != nil {
+			mmHeadroom.AccountBorrowCap = moneyMarket.AccountBorrowCap.String()
+			if !owner.Empty() {
+				borrowHeadroom, _ := s.keeper.GetAccountBorrowHeadroom(sdkCtx, owner, moneyMarket.Denom)
+				mmHeadroom.AccountBorrowHeadroom = borrowHeadroom.String()
+			}
+		}
+		headroom = append(headroom, mmHeadroom)
+	}
+
+	return &types.QueryHeadroomResponse{
+		Headroom: headroom,
+	}, nil
+}
+
+func (s queryServer) CreditDelegations(ctx context.Context, req *types.QueryCreditDelegationsRequest) (*types.QueryCreditDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var delegator, delegatee sdk.AccAddress
+	var err error
+	if len(req.Delegator) > 0 {
+		delegator, err = sdk.AccAddressFromBech32(req.Delegator)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+	if len(req.Delegatee) > 0 {
+		delegatee, err = sdk.AccAddressFromBech32(req.Delegatee)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	var creditDelegations types.CreditDelegations
+	switch {
+	case !delegator.Empty() && !delegatee.Empty():
+		creditDelegation, found := s.keeper.GetCreditDelegation(sdkCtx, delegator, delegatee)
+		if found {
+			creditDelegations = append(creditDelegations, creditDelegation)
+		}
+	case !delegator.Empty():
+		s.keeper.IterateCreditDelegationsByDelegator(sdkCtx, delegator, func(creditDelegation types.CreditDelegation) (stop bool) {
+			creditDelegations = append(creditDelegations, creditDelegation)
+			return false
+		})
+	default:
+		s.keeper.IterateCreditDelegations(sdkCtx, func(creditDelegation types.CreditDelegation) (stop bool) {
+			if delegatee.Empty() || creditDelegation.Delegatee.Equals(delegatee) {
+				creditDelegations = append(creditDelegations, creditDelegation)
+			}
+			return false
+		})
+	}
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(creditDelegations), page, limit, 100)
+	if start < 0 || end < 0 {
+		creditDelegations = types.CreditDelegations{}
+	} else {
+		creditDelegations = creditDelegations[start:end]
+	}
+
+	// Include the interest accrued since the debts were last synced
+	for i, creditDelegation := range creditDelegations {
+		creditDelegations[i] = s.keeper.syncCreditDelegationDebt(sdkCtx, creditDelegation, sdk.NewCoins())
+	}
+
+	return &types.QueryCreditDelegationsResponse{
+		CreditDelegations: creditDelegations.ToResponse(),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/app"
@@ -513,6 +514,115 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryInterestFactors() {
 	}, res)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryAccountHealth() {
+	suite.addDeposits()
+	suite.addBorrows()
+
+	res, err := suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{
+		Address: suite.addrs[1].String(),
+	})
+	suite.Require().NoError(err)
+
+	// 20 bnb at $618.13 backs 20 usdx, with a loan-to-value of 0.5
+	suite.Equal(types.AccountHealthResponse{
+		Address:              suite.addrs[1].String(),
+		Deposited:            cs(c("bnb", 20000000)),
+		Collateral:           cs(c("bnb", 20000000)),
+		Borrowed:             cs(c("usdx", 20000000)),
+		Ltv:                  sdk.NewDec(20).Quo(sdk.MustNewDecFromStr("12362.6")).String(),
+		BorrowLimit:          sdk.MustNewDecFromStr("6181.3").String(),
+		LiquidationThreshold: sdk.MustNewDecFromStr("6181.3").String(),
+		LiquidationPrices: []types.LiquidationPrice{
+			{Denom: "bnb", Price: sdk.MustNewDecFromStr("2").String()},
+			{Denom: "usdx", Price: sdk.MustNewDecFromStr("309.065").String()},
+		},
+	}, res.AccountHealth)
+
+	_, err = suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{
+		Address: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryAccountHealth_Empty() {
+	res, err := suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{
+		Address: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(suite.addrs[0].String(), res.AccountHealth.Address)
+	suite.Equal(sdk.ZeroDec().String(), res.AccountHealth.Ltv)
+	suite.Empty(res.AccountHealth.LiquidationPrices)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryLiquidationCandidates() {
+	suite.addDeposits()
+	suite.addBorrows()
+
+	res, err := suite.queryServer.LiquidationCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationCandidatesRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(res.Candidates)
+
+	// below $2 the second account's 20 bnb no longer back its 20 usdx
+	pricefeedKeeper := suite.tApp.GetPriceFeedKeeper()
+	_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("1.5"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	res, err = suite.queryServer.LiquidationCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationCandidatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Candidates, 1)
+	suite.Equal(suite.addrs[1].String(), res.Candidates[0].Address)
+	suite.Equal(sdk.MustNewDecFromStr("15").String(), res.Candidates[0].LiquidationThreshold)
+	suite.Equal(uint64(1), res.Pagination.Total)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryHeadroom() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, mm := range params.MoneyMarkets {
+		if mm.Denom == "bnb" {
+			supplyCap := sdkmath.NewInt(150000000)
+			params.MoneyMarkets[i].SupplyCap = &supplyCap
+		}
+		if mm.Denom == "usdx" {
+			accountBorrowCap := sdkmath.NewInt(60000000)
+			params.MoneyMarkets[i].AccountBorrowCap = &accountBorrowCap
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.ApplyInterestRateUpdates(suite.ctx)
+
+	suite.addDeposits()
+	suite.addBorrows()
+
+	res, err := suite.queryServer.Headroom(sdk.WrapSDKContext(suite.ctx), &types.QueryHeadroomRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.MoneyMarketHeadroom{
+		{Denom: "bnb", SupplyCap: "150000000", SupplyHeadroom: "30000000"},
+		{Denom: "busd"},
+		{Denom: "usdx", AccountBorrowCap: "60000000", AccountBorrowHeadroom: "10000000"},
+	}, res.Headroom)
+
+	res, err = suite.queryServer.Headroom(sdk.WrapSDKContext(suite.ctx), &types.QueryHeadroomRequest{
+		Denom: "usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.MoneyMarketHeadroom{
+		{Denom: "usdx", AccountBorrowCap: "60000000"},
+	}, res.Headroom)
+
+	// deposits and borrows beyond the remaining caps are rejected
+	err = suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("bnb", 30000001)))
+	suite.Require().ErrorIs(err, types.ErrExceedsSupplyCap)
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("bnb", 30000000))))
+
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 10000001)))
+	suite.Require().ErrorIs(err, types.ErrExceedsAccountBorrowCap)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 10000000))))
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryReserves() {
 	suite.addDeposits()
 	suite.addBorrows()
@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -21,27 +22,35 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
-	hooks           types.HARDHooks
+	swapKeeper      types.SwapKeeper
+	router          *baseapp.MsgServiceRouter
+	// flashLoanMsgTypes is the type urls of the msgs that can be executed within a flash loan.
+	flashLoanMsgTypes []string
+	hooks             types.HARDHooks
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, sk types.SwapKeeper, router *baseapp.MsgServiceRouter,
+	flashLoanMsgTypes []string,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		key:             key,
-		cdc:             cdc,
-		paramSubspace:   paramstore,
-		accountKeeper:   ak,
-		bankKeeper:      bk,
-		pricefeedKeeper: pfk,
-		auctionKeeper:   auk,
-		hooks:           nil,
+		key:               key,
+		cdc:               cdc,
+		paramSubspace:     paramstore,
+		accountKeeper:     ak,
+		bankKeeper:        bk,
+		pricefeedKeeper:   pfk,
+		auctionKeeper:     auk,
+		swapKeeper:        sk,
+		router:            router,
+		flashLoanMsgTypes: flashLoanMsgTypes,
+		hooks:             nil,
 	}
 }
 
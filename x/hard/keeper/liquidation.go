@@ -12,9 +12,10 @@ import (
 
 // LiqData holds liquidation-related data
 type LiqData struct {
-	price            sdk.Dec
-	ltv              sdk.Dec
-	conversionFactor sdkmath.Int
+	price                sdk.Dec
+	ltv                  sdk.Dec
+	conversionFactor     sdkmath.Int
+	liquidationThreshold sdk.Dec
 }
 
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
@@ -55,28 +56,45 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 	}
 
 	// Sending coins to auction module with keeper address getting % of the profits
+	collateral := k.GetCollateral(ctx, deposit)
 	borrowDenoms := getDenoms(borrow.Amount)
-	depositDenoms := getDenoms(deposit.Amount)
-	err = k.SeizeDeposits(ctx, keeper, deposit, borrow, depositDenoms, borrowDenoms)
+	depositDenoms := getDenoms(collateral.Amount)
+	err = k.SeizeDeposits(ctx, keeper, collateral, borrow, depositDenoms, borrowDenoms)
 	if err != nil {
 		return err
 	}
 
-	deposit.Amount = sdk.NewCoins()
-	k.DeleteDeposit(ctx, deposit)
+	// Deposits that aren't used as collateral are left with the borrower
+	for _, coin := range collateral.Amount {
+		depositIndex, removed := deposit.Index.RemoveInterestFactor(coin.Denom)
+		if !removed {
+			return errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+		}
+		deposit.Index = depositIndex
+	}
+	deposit.Amount = deposit.Amount.Sub(collateral.Amount...)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
 	k.AfterDepositModified(ctx, deposit)
 
 	borrow.Amount = sdk.NewCoins()
 	k.DeleteBorrow(ctx, borrow)
 	k.AfterBorrowModified(ctx, borrow)
+
+	k.updateCreditDelegationDebts(ctx, borrower, borrower, sdk.NewCoins())
 	return nil
 }
 
-// SeizeDeposits seizes a list of deposits and sends them to auction
+// SeizeDeposits seizes a list of deposits and sends them to auction. Deposits the depositor doesn't use as
+// collateral are not seized.
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
 	borrow types.Borrow, dDenoms, bDenoms []string,
 ) error {
-	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	deposit = k.GetCollateral(ctx, deposit)
+	liqMap, err := k.loadPositionLiquidationData(ctx, deposit, borrow, true)
 	if err != nil {
 		return err
 	}
@@ -146,7 +164,8 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	return err
 }
 
-// StartAuctions attempts to start auctions for seized assets
+// StartAuctions attempts to start auctions for seized assets, or to sell them through x/swap where the
+// money market of the seized asset allows it
 func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows, deposits sdk.Coins,
 	depositCoinValues, borrowCoinValues types.ValuationMap, ltv sdk.Dec, liqMap map[string]LiqData,
 ) (sdk.Coins, error) {
@@ -154,11 +173,6 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 	bKeys := borrowCoinValues.GetSortedKeys()
 	dKeys := depositCoinValues.GetSortedKeys()
 
-	// Set up auction constants
-	returnAddrs := []sdk.AccAddress{borrower}
-	weights := []sdkmath.Int{sdkmath.NewInt(100)}
-	debt := sdk.NewCoin("debt", sdk.ZeroInt())
-
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	maccCoins := k.bankKeeper.SpendableCoins(ctx, macc.GetAddress())
 
@@ -193,8 +207,8 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 					return liquidatedCoins, types.ErrInsufficientCoins
 				}
 
-				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				// Start auction or swap: bid = full borrow amount, lot = maxLotSize
+				err := k.liquidateLot(ctx, borrower, lot, bid, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -244,8 +258,8 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 					return liquidatedCoins, types.ErrInsufficientCoins
 				}
 
-				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				// Start auction or swap: bid = maxBid, lot = whole deposit amount
+				err := k.liquidateLot(ctx, borrower, lot, bid, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -294,18 +308,116 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 	return liquidatedCoins, nil
 }
 
-// IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices
+// liquidateLot sells a lot of seized collateral for the borrowed asset through x/swap when the
+// collateral's money market allows it, and otherwise starts a collateral auction for it
+func (k Keeper) liquidateLot(ctx sdk.Context, borrower sdk.AccAddress, lot, bid sdk.Coin, liqMap map[string]LiqData) error {
+	if k.attemptSwapLiquidation(ctx, borrower, lot, bid, liqMap) {
+		return nil
+	}
+
+	returnAddrs := []sdk.AccAddress{borrower}
+	weights := []sdkmath.Int{sdkmath.NewInt(100)}
+	debt := sdk.NewCoin("debt", sdk.ZeroInt())
+	_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+	return err
+}
+
+// attemptSwapLiquidation sells a lot for the bid through the x/swap pool of the pair. Only as much of the
+// lot as is needed to buy the bid is sold, with the remainder returned to the borrower. It returns false,
+// leaving state unchanged, if the money market doesn't allow swap liquidations or the pool can't fill the
+// sale within the money market's slippage limit of oracle prices.
+func (k Keeper) attemptSwapLiquidation(ctx sdk.Context, borrower sdk.AccAddress, lot, bid sdk.Coin, liqMap map[string]LiqData) bool {
+	mm, found := k.GetMoneyMarket(ctx, lot.Denom)
+	if !found || mm.SwapLiquidation == nil {
+		return false
+	}
+	lotData, bidData := liqMap[lot.Denom], liqMap[bid.Denom]
+	if !lotData.price.IsPositive() || !bidData.price.IsPositive() {
+		return false
+	}
+
+	// the amount of collateral worth the bid at oracle prices
+	bidUsdValue := sdk.NewDecFromInt(bid.Amount).Quo(sdk.NewDecFromInt(bidData.conversionFactor)).Mul(bidData.price)
+	expectedInput := bidUsdValue.Quo(lotData.price).MulInt(lotData.conversionFactor).Ceil().TruncateInt()
+
+	cacheCtx, write := ctx.CacheContext()
+	var sold, proceeds sdk.Coin
+	if expectedInput.LTE(lot.Amount) {
+		input, err := k.swapKeeper.SwapForExactTokensFromModule(cacheCtx, types.ModuleAccountName, sdk.NewCoin(lot.Denom, expectedInput), bid, mm.SwapLiquidation.MaxSlippage)
+		if err != nil || input.Amount.GT(lot.Amount) {
+			return false
+		}
+		sold, proceeds = input, bid
+
+		if remainder := lot.Sub(input); remainder.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleAccountName, borrower, sdk.NewCoins(remainder)); err != nil {
+				return false
+			}
+		}
+	} else {
+		// the lot is worth less than the bid, so all of it is sold
+		lotUsdValue := sdk.NewDecFromInt(lot.Amount).Quo(sdk.NewDecFromInt(lotData.conversionFactor)).Mul(lotData.price)
+		expectedOutput := lotUsdValue.Quo(bidData.price).MulInt(bidData.conversionFactor).TruncateInt()
+		if !expectedOutput.IsPositive() {
+			return false
+		}
+		output, err := k.swapKeeper.SwapExactForTokensFromModule(cacheCtx, types.ModuleAccountName, lot, sdk.NewCoin(bid.Denom, expectedOutput), mm.SwapLiquidation.MaxSlippage)
+		if err != nil {
+			return false
+		}
+		sold, proceeds = lot, output
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSwapLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeySoldCoins, sold.String()),
+			sdk.NewAttribute(types.AttributeKeyProceeds, proceeds.String()),
+		),
+	)
+	return true
+}
+
+// IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at liquidation
+// prices. Positions in an asset category are compared against the category's liquidation threshold. Only deposits
+// used as collateral are counted.
 func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
+	deposit = k.GetCollateral(ctx, deposit)
+	liqMap, err := k.loadPositionLiquidationData(ctx, deposit, borrow, true)
+	if err != nil {
+		return false, err
+	}
+
+	return isWithinLtvRange(liqMap, deposit, borrow, true), nil
+}
+
+// IsWithinBorrowLimit compares a borrow and deposit to see if the borrow is within the deposit's borrowable
+// amount at current prices. Positions in an asset category are compared against the category's loan-to-value.
+// Only deposits used as collateral are counted.
+func (k Keeper) IsWithinBorrowLimit(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
+	deposit = k.GetCollateral(ctx, deposit)
 	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
 	if err != nil {
 		return false, err
 	}
 
+	return isWithinLtvRange(liqMap, deposit, borrow, false), nil
+}
+
+// isWithinLtvRange checks if the borrowed USD value is at most the deposit's borrowable USD value, using either
+// the loan-to-value or the liquidation threshold of each deposited asset
+func isWithinLtvRange(liqMap map[string]LiqData, deposit types.Deposit, borrow types.Borrow, useLiquidationThreshold bool) bool {
 	totalBorrowableUSDAmount := sdk.ZeroDec()
 	for _, depCoin := range deposit.Amount {
 		lData := liqMap[depCoin.Denom]
 		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
-		borrowableUSDAmountForDeposit := usdValue.Mul(lData.ltv)
+		ltv := lData.ltv
+		if useLiquidationThreshold {
+			ltv = lData.liquidationThreshold
+		}
+		borrowableUSDAmountForDeposit := usdValue.Mul(ltv)
 		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(borrowableUSDAmountForDeposit)
 	}
 
@@ -317,11 +429,7 @@ func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, bo
 	}
 
 	// Check if the user's has borrowed more than they're allowed to
-	if totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount) {
-		return false, nil
-	}
-
-	return true, nil
+	return totalBorrowedUSDAmount.LTE(totalBorrowableUSDAmount)
 }
 
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
@@ -344,7 +452,11 @@ func (k Keeper) GetStoreLTV(ctx sdk.Context, addr sdk.AccAddress) (sdk.Dec, erro
 
 // CalculateLtv calculates the potential LTV given a user's deposits and borrows.
 // The boolean returned indicates if the LTV should be added to the store's LTV index.
+// The ratio is the same whether or not an asset category applies, as categories only change the limits it
+// is compared against. Only deposits used as collateral are counted.
 func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	deposit = k.GetCollateral(ctx, deposit)
+
 	// Load required liquidation data for every deposit/borrow denom
 	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
 	if err != nil {
@@ -377,8 +489,37 @@ func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow type
 	return borrowCoinValues.Sum().Quo(sumDeposits), nil
 }
 
-// LoadLiquidationData returns liquidation data, deposit, borrow
+// LoadLiquidationData returns liquidation data, deposit, borrow. The loan-to-value and liquidation threshold of
+// each asset are taken from the position's asset category when one applies.
 func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (map[string]LiqData, error) {
+	return k.loadPositionLiquidationData(ctx, deposit, borrow, false)
+}
+
+// loadPositionLiquidationData returns liquidation data for a position using the limits of its asset category, at
+// either current prices or the pricefeed liquidation prices
+func (k Keeper) loadPositionLiquidationData(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow, useLiquidationPrices bool) (map[string]LiqData, error) {
+	owner := deposit.Depositor
+	if owner.Empty() {
+		owner = borrow.Borrower
+	}
+
+	var category *types.AssetCategory
+	if c, found := k.GetEffectiveAssetCategory(ctx, owner, deposit.Amount, borrow.Amount); found {
+		category = &c
+	}
+	return k.loadLiquidationData(ctx, deposit, borrow, category, useLiquidationPrices)
+}
+
+// loadLiquidationData returns liquidation data for a position using the limits of an asset category, or of
+// each asset's money market if the category is nil. Liquidation prices are the market TWAPs for markets that
+// set a liquidation TWAP window.
+func (k Keeper) loadLiquidationData(
+	ctx sdk.Context,
+	deposit types.Deposit,
+	borrow types.Borrow,
+	category *types.AssetCategory,
+	useLiquidationPrices bool,
+) (map[string]LiqData, error) {
 	liqMap := make(map[string]LiqData)
 
 	borrowDenoms := getDenoms(borrow.Amount)
@@ -392,12 +533,20 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, errorsmod.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", denom)
 		}
 
-		priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		getPrice := k.pricefeedKeeper.GetCurrentPrice
+		if useLiquidationPrices {
+			getPrice = k.pricefeedKeeper.GetLiquidationPrice
+		}
+		priceData, err := getPrice(ctx, mm.SpotMarketID)
 		if err != nil {
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		ltv, liquidationThreshold := mm.BorrowLimit.LoanToValue, mm.BorrowLimit.LoanToValue
+		if category != nil {
+			ltv, liquidationThreshold = category.LoanToValue, category.LiquidationThreshold
+		}
+		liqMap[denom] = LiqData{priceData.Price, ltv, mm.ConversionFactor, liquidationThreshold}
 	}
 
 	return liqMap, nil
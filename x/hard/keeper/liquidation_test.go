@@ -763,3 +763,80 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeperLiquidation_LiquidationTwap() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+
+	tApp := app.NewTestApp()
+	start := time.Date(2022, 7, 20, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: start})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))},
+		[]sdk.AccAddress{borrower},
+	)
+
+	moneyMarket := func(denom, marketID string, conversionFactor int64) types.MoneyMarket {
+		return types.NewMoneyMarket(denom,
+			types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.5")),
+			marketID, sdkmath.NewInt(conversionFactor), model, reserveFactor, sdk.MustNewDecFromStr("0.05"))
+	}
+	hardGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				moneyMarket("ukava", "kava:usd", KAVA_CF),
+				moneyMarket("usdx", "usdx:usd", USDX_CF),
+			},
+			sdk.NewDec(10),
+		),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	// kava is liquidated at its TWAP over 10 minutes
+	snapshots := pricefeedtypes.NewSnapshotParams(time.Minute, time.Hour, 10*time.Minute)
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, Snapshots: &snapshots},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: start.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: start.Add(100 * time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF))))
+	suite.Require().NoError(err)
+
+	hardKeeper := tApp.GetHardKeeper()
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = hardKeeper
+
+	suite.setKavaPrice(ctx, sdk.MustNewDecFromStr("2.00"))
+	suite.Require().NoError(hardKeeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(hardKeeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(90*USDX_CF)))))
+
+	// A crash that lasts a single block doesn't make the position liquidatable
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Minute))
+	suite.setKavaPrice(ctx, sdk.MustNewDecFromStr("1.00"))
+	err = hardKeeper.AttemptKeeperLiquidation(ctx, keeper, borrower)
+	suite.Require().ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	// Once the price has held for the window the position is liquidated
+	ctx = ctx.WithBlockTime(start.Add(20 * time.Minute))
+	suite.Require().NoError(hardKeeper.AttemptKeeperLiquidation(ctx, keeper, borrower))
+	_, found := hardKeeper.GetBorrow(ctx, borrower)
+	suite.Require().False(found)
+}
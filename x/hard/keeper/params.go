@@ -10,6 +10,13 @@ import (
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var p types.Params
 	k.paramSubspace.GetParamSet(ctx, &p)
+	// the param store decodes an empty list as nil
+	if p.AssetCategories == nil {
+		p.AssetCategories = types.AssetCategories{}
+	}
+	if p.ReserveBuyback.Thresholds == nil {
+		p.ReserveBuyback.Thresholds = sdk.Coins{}
+	}
 	return p
 }
 
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// HandleHardReserveSpendProposal is a handler for executing a passed reserve spend proposal.
+func HandleHardReserveSpendProposal(ctx sdk.Context, k Keeper, p *types.HardReserveSpendProposal) error {
+	var recipient sdk.AccAddress
+	if p.Recipient != "" {
+		addr, err := sdk.AccAddressFromBech32(p.Recipient)
+		if err != nil {
+			return err
+		}
+		recipient = addr
+	}
+	return k.SpendReserves(ctx, recipient, p.Amount)
+}
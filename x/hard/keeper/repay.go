@@ -66,6 +66,8 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 		return err
 	}
 
+	k.updateCreditDelegationDebts(ctx, owner, sender, payment)
+
 	// Call incentive hook
 	k.AfterBorrowModified(ctx, borrow)
 
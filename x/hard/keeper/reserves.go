@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetPreviousReserveBuybackTime returns the last time reserves were bought back
+func (k Keeper) GetPreviousReserveBuybackTime(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PreviousReserveBuybackTimeKey)
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	var previousBuybackTime time.Time
+	if err := previousBuybackTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return previousBuybackTime, true
+}
+
+// SetPreviousReserveBuybackTime sets the last time reserves were bought back
+func (k Keeper) SetPreviousReserveBuybackTime(ctx sdk.Context, previousBuybackTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz, err := previousBuybackTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.PreviousReserveBuybackTimeKey, bz)
+}
+
+// SpendReserves transfers reserves from the hard module account to a recipient, or to the community pool if
+// the recipient is empty
+func (k Keeper) SpendReserves(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	reserves, _ := k.GetTotalReserves(ctx)
+	if !amount.IsAllLTE(reserves) {
+		return errorsmod.Wrapf(types.ErrInsufficientReserves, "cannot spend %s, reserves are %s", amount, reserves)
+	}
+
+	if recipient.Empty() {
+		recipient = k.accountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, communitytypes.ModuleAccountName, amount); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, recipient, amount); err != nil {
+			return err
+		}
+	}
+	k.SetTotalReserves(ctx, reserves.Sub(amount...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardReserveSpend,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// ApplyReserveBuyback sells the reserves above each threshold of the reserve buyback param for its target
+// denom, at most once per interval. Sales that can't be made within the slippage limit of oracle prices are
+// skipped until the next buyback.
+func (k Keeper) ApplyReserveBuyback(ctx sdk.Context) {
+	buyback := k.GetParams(ctx).ReserveBuyback
+	if !buyback.IsEnabled() {
+		return
+	}
+	previousBuybackTime, found := k.GetPreviousReserveBuybackTime(ctx)
+	if found && ctx.BlockTime().Before(previousBuybackTime.Add(buyback.Interval)) {
+		return
+	}
+	k.SetPreviousReserveBuybackTime(ctx, ctx.BlockTime())
+
+	reserves, _ := k.GetTotalReserves(ctx)
+	for _, threshold := range buyback.Thresholds {
+		excess := reserves.AmountOf(threshold.Denom).Sub(threshold.Amount)
+		if !excess.IsPositive() {
+			continue
+		}
+		k.attemptReserveSale(ctx, sdk.NewCoin(threshold.Denom, excess), buyback.TargetDenom, buyback.MaxSlippage)
+	}
+}
+
+// attemptReserveSale sells reserves for the target denom through x/swap, adding the proceeds to reserves. It
+// returns false, leaving state unchanged, if either asset can't be priced or the sale can't be filled within
+// the slippage limit of oracle prices.
+func (k Keeper) attemptReserveSale(ctx sdk.Context, lot sdk.Coin, targetDenom string, maxSlippage sdk.Dec) bool {
+	lotMoneyMarket, found := k.GetMoneyMarket(ctx, lot.Denom)
+	if !found {
+		return false
+	}
+	targetMoneyMarket, found := k.GetMoneyMarket(ctx, targetDenom)
+	if !found {
+		return false
+	}
+	lotPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, lotMoneyMarket.SpotMarketID)
+	if err != nil || !lotPrice.Price.IsPositive() {
+		return false
+	}
+	targetPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, targetMoneyMarket.SpotMarketID)
+	if err != nil || !targetPrice.Price.IsPositive() {
+		return false
+	}
+
+	// the amount of the target denom worth the lot at oracle prices
+	lotUsdValue := sdk.NewDecFromInt(lot.Amount).Quo(sdk.NewDecFromInt(lotMoneyMarket.ConversionFactor)).Mul(lotPrice.Price)
+	expectedOutput := lotUsdValue.Quo(targetPrice.Price).MulInt(targetMoneyMarket.ConversionFactor).TruncateInt()
+	if !expectedOutput.IsPositive() {
+		return false
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	proceeds, err := k.swapKeeper.SwapExactForTokensFromModule(cacheCtx, types.ModuleAccountName, lot, sdk.NewCoin(targetDenom, expectedOutput), maxSlippage)
+	if err != nil {
+		return false
+	}
+	write()
+
+	reserves, _ := k.GetTotalReserves(ctx)
+	k.SetTotalReserves(ctx, reserves.Sub(lot).Add(proceeds))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardReserveBuyback,
+			sdk.NewAttribute(types.AttributeKeySoldCoins, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyProceeds, proceeds.String()),
+		),
+	)
+	return true
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *KeeperTestSuite) TestSpendReserves() {
+	recipient := sdk.AccAddress(crypto.AddressHash([]byte("testrecipient")))
+
+	testCases := []struct {
+		name        string
+		recipient   sdk.AccAddress
+		amount      sdk.Coins
+		expectPass  bool
+		expectedErr error
+	}{
+		{
+			"valid: spend to an account",
+			recipient,
+			sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(40*USDX_CF))),
+			true,
+			nil,
+		},
+		{
+			"valid: spend to the community pool",
+			nil,
+			sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF))),
+			true,
+			nil,
+		},
+		{
+			"invalid: more than the reserves",
+			recipient,
+			sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(101*USDX_CF))),
+			false,
+			types.ErrInsufficientReserves,
+		},
+		{
+			"invalid: denom without reserves",
+			recipient,
+			sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1))),
+			false,
+			types.ErrInsufficientReserves,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupReserves(sdk.MustNewDecFromStr("2.00"), 1)
+			reserves := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))
+
+			err := suite.keeper.SpendReserves(suite.ctx, tc.recipient, tc.amount)
+			if !tc.expectPass {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				totalReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+				suite.Require().Equal(reserves, totalReserves)
+				return
+			}
+			suite.Require().NoError(err)
+
+			totalReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+			suite.Require().Equal(reserves.Sub(tc.amount...), totalReserves)
+
+			recipientAddr := tc.recipient
+			if recipientAddr.Empty() {
+				recipientAddr = suite.app.GetAccountKeeper().GetModuleAddress(communitytypes.ModuleAccountName)
+			}
+			suite.Require().Equal(tc.amount, suite.app.GetBankKeeper().GetAllBalances(suite.ctx, recipientAddr))
+			suite.Require().True(suite.eventsContainType(suite.ctx.EventManager().Events(), types.EventTypeHardReserveSpend))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReserveBuyback() {
+	testCases := []struct {
+		name       string
+		kavaPrice  sdk.Dec
+		expectSale bool
+	}{
+		{
+			"valid: reserves above the threshold are sold",
+			sdk.MustNewDecFromStr("2.00"),
+			true,
+		},
+		{
+			"valid: sale outside the slippage limit is skipped",
+			sdk.MustNewDecFromStr("2.50"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupReserves(tc.kavaPrice, 10000)
+			buyback := types.NewReserveBuyback("usdx", sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+				time.Hour, sdk.MustNewDecFromStr("0.05"))
+			params := suite.keeper.GetParams(suite.ctx)
+			params.ReserveBuyback = buyback
+			suite.keeper.SetParams(suite.ctx, params)
+
+			kavaReserves := sdk.NewCoin("ukava", sdkmath.NewInt(110*KAVA_CF))
+			err := suite.app.GetBankKeeper().MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(kavaReserves))
+			suite.Require().NoError(err)
+			reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+			reserves = reserves.Add(kavaReserves)
+			suite.keeper.SetTotalReserves(suite.ctx, reserves)
+
+			suite.keeper.ApplyReserveBuyback(suite.ctx)
+
+			previousBuybackTime, found := suite.keeper.GetPreviousReserveBuybackTime(suite.ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.ctx.BlockTime(), previousBuybackTime)
+
+			totalReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+			if !tc.expectSale {
+				suite.Require().Equal(reserves, totalReserves)
+				suite.Require().False(suite.eventsContainType(suite.ctx.EventManager().Events(), types.EventTypeHardReserveBuyback))
+				return
+			}
+
+			// the 10 kava above the threshold are sold for around $20 of usdx
+			suite.Require().Equal(sdkmath.NewInt(100*KAVA_CF), totalReserves.AmountOf("ukava"))
+			proceeds := totalReserves.AmountOf("usdx").Sub(reserves.AmountOf("usdx"))
+			suite.Require().True(proceeds.GT(sdkmath.NewInt(19 * USDX_CF)))
+			suite.Require().True(proceeds.LT(sdkmath.NewInt(20 * USDX_CF)))
+			suite.Require().True(suite.eventsContainType(suite.ctx.EventManager().Events(), types.EventTypeHardReserveBuyback))
+
+			// reserves are not sold again until the interval has passed
+			err = suite.app.GetBankKeeper().MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(kavaReserves))
+			suite.Require().NoError(err)
+			suite.keeper.SetTotalReserves(suite.ctx, totalReserves.Add(kavaReserves))
+
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+			suite.keeper.ApplyReserveBuyback(suite.ctx)
+			skippedReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+			suite.Require().Equal(totalReserves.Add(kavaReserves), skippedReserves)
+
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+			suite.keeper.ApplyReserveBuyback(suite.ctx)
+			soldReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+			suite.Require().Equal(sdkmath.NewInt(100*KAVA_CF), soldReserves.AmountOf("ukava"))
+		})
+	}
+}
+
+// setupReserves starts an app with ukava and usdx money markets, 100 usdx of reserves and a ukava:usdx swap
+// pool priced at $2 kava
+func (suite *KeeperTestSuite) setupReserves(kavaPrice sdk.Dec, poolKava int64) {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	liquidityProvider := sdk.AccAddress(crypto.AddressHash([]byte("testprovider")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(
+				sdk.NewCoin("ukava", sdkmath.NewInt(poolKava*KAVA_CF)),
+				sdk.NewCoin("usdx", sdkmath.NewInt(2*poolKava*USDX_CF)),
+			),
+		},
+		[]sdk.AccAddress{liquidityProvider},
+	)
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("ukava",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.5")),
+				"kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")),
+				"usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: kavaPrice, Expiry: time.Now().Add(100 * time.Hour)},
+		},
+	}
+	swapGS := swaptypes.NewGenesisState(
+		swaptypes.NewParams(swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")), sdk.ZeroDec()),
+		swaptypes.DefaultPoolRecords, swaptypes.DefaultShareRecords,
+	)
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+		app.GenesisState{swaptypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&swapGS)})
+
+	reserves := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))
+	err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, reserves)
+	suite.Require().NoError(err)
+
+	keeper := tApp.GetHardKeeper()
+	keeper.SetTotalReserves(ctx, reserves)
+
+	err = tApp.GetSwapKeeper().Deposit(ctx, liquidityProvider,
+		sdk.NewCoin("ukava", sdkmath.NewInt(poolKava*KAVA_CF)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(2*poolKava*USDX_CF)),
+		sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = keeper
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapLiquidation() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeperAddr := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	liquidityProvider := sdk.AccAddress(crypto.AddressHash([]byte("testprovider")))
+
+	testCases := []struct {
+		name            string
+		swapLiquidation *types.SwapLiquidation
+		poolKava        int64
+		poolUsdx        int64
+		expectSwap      bool
+	}{
+		{
+			"valid: seized collateral is sold through the pool",
+			&types.SwapLiquidation{MaxSlippage: sdk.MustNewDecFromStr("0.05")},
+			10000,
+			18000,
+			true,
+		},
+		{
+			"valid: shallow pool falls back to an auction",
+			&types.SwapLiquidation{MaxSlippage: sdk.MustNewDecFromStr("0.05")},
+			100,
+			180,
+			false,
+		},
+		{
+			"valid: money market without swap liquidations starts an auction",
+			nil,
+			10000,
+			18000,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewFundedGenStateWithCoins(
+				tApp.AppCodec(),
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+					sdk.NewCoins(
+						sdk.NewCoin("ukava", sdkmath.NewInt(tc.poolKava*KAVA_CF)),
+						sdk.NewCoin("usdx", sdkmath.NewInt(tc.poolUsdx*USDX_CF)),
+					),
+				},
+				[]sdk.AccAddress{borrower, liquidityProvider},
+			)
+
+			kavaMarket := types.NewMoneyMarket("ukava",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.5")),
+				"kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+			kavaMarket.SwapLiquidation = tc.swapLiquidation
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{
+					kavaMarket,
+					types.NewMoneyMarket("usdx",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")),
+						"usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+				},
+				sdk.NewDec(10),
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+					{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(100 * time.Hour)},
+				},
+			}
+			swapGS := swaptypes.NewGenesisState(
+				swaptypes.NewParams(swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")), sdk.ZeroDec()),
+				swaptypes.DefaultPoolRecords, swaptypes.DefaultShareRecords,
+			)
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+				app.GenesisState{swaptypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&swapGS)})
+
+			err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*USDX_CF))))
+			suite.Require().NoError(err)
+
+			// the pool is priced at the kava price the position becomes liquidatable at
+			err = tApp.GetSwapKeeper().Deposit(ctx, liquidityProvider,
+				sdk.NewCoin("ukava", sdkmath.NewInt(tc.poolKava*KAVA_CF)),
+				sdk.NewCoin("usdx", sdkmath.NewInt(tc.poolUsdx*USDX_CF)),
+				sdk.MustNewDecFromStr("0.01"))
+			suite.Require().NoError(err)
+
+			keeper := tApp.GetHardKeeper()
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = keeper
+
+			suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))))
+			suite.Require().NoError(keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(100*USDX_CF)))))
+
+			suite.setKavaPrice(ctx, sdk.MustNewDecFromStr("1.80"))
+			hardMaccBefore := tApp.GetBankKeeper().GetAllBalances(ctx, tApp.GetAccountKeeper().GetModuleAddress(types.ModuleAccountName))
+
+			suite.Require().NoError(keeper.AttemptKeeperLiquidation(ctx, keeperAddr, borrower))
+
+			_, found := keeper.GetDeposit(ctx, borrower)
+			suite.Require().False(found)
+			_, found = keeper.GetBorrow(ctx, borrower)
+			suite.Require().False(found)
+
+			auctions := tApp.GetAuctionKeeper().GetAllAuctions(ctx)
+			if !tc.expectSwap {
+				suite.Require().Len(auctions, 1)
+				suite.Require().False(suite.eventsContainType(ctx.EventManager().Events(), types.EventTypeHardSwapLiquidation))
+				return
+			}
+			suite.Require().Empty(auctions)
+
+			// the borrowed usdx is bought back into the module
+			hardMacc := tApp.GetBankKeeper().GetAllBalances(ctx, tApp.GetAccountKeeper().GetModuleAddress(types.ModuleAccountName))
+			suite.Require().Equal(hardMaccBefore.AmountOf("usdx").Add(sdkmath.NewInt(100*USDX_CF)), hardMacc.AmountOf("usdx"))
+			suite.Require().True(hardMacc.AmountOf("ukava").IsZero())
+
+			borrowerBalance := tApp.GetBankKeeper().GetBalance(ctx, borrower, "ukava")
+			// around 56 of the 95 kava lot is sold for $100, and the rest is returned to the borrower
+			suite.Require().True(borrowerBalance.Amount.GT(sdkmath.NewInt(38 * KAVA_CF)))
+			suite.Require().True(borrowerBalance.Amount.LT(sdkmath.NewInt(40 * KAVA_CF)))
+
+			suite.Require().True(suite.eventsContainType(ctx.EventManager().Events(), types.EventTypeHardSwapLiquidation))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) eventsContainType(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
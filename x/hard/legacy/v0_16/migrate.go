@@ -55,10 +55,7 @@ func migrateParams(params v015hard.Params) v016hard.Params {
 	}
 	moneyMarkets = append(moneyMarkets, atomMoneyMarket)
 
-	return v016hard.Params{
-		MoneyMarkets:          moneyMarkets,
-		MinimumBorrowUSDValue: params.MinimumBorrowUSDValue,
-	}
+	return v016hard.NewParams(moneyMarkets, params.MinimumBorrowUSDValue)
 }
 
 func migrateDeposits(oldDeposits v015hard.Deposits) v016hard.Deposits {
@@ -152,6 +152,9 @@ func (s *migrateTestSuite) TestMigrate_GenState() {
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
 				},
 			},
+			AssetCategories: v016hard.DefaultAssetCategories,
+			FlashLoanFee:    v016hard.DefaultFlashLoanFee,
+			ReserveBuyback:  v016hard.DefaultReserveBuyback,
 		},
 		PreviousAccumulationTimes: v016hard.GenesisAccumulationTimes{
 			{
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAssetCategory returns a new AssetCategory
+func NewAssetCategory(name string, denoms []string, loanToValue, liquidationThreshold sdk.Dec) AssetCategory {
+	return AssetCategory{
+		Name:                 name,
+		Denoms:               denoms,
+		LoanToValue:          loanToValue,
+		LiquidationThreshold: liquidationThreshold,
+	}
+}
+
+// Validate AssetCategory param
+func (ac AssetCategory) Validate() error {
+	if strings.TrimSpace(ac.Name) == "" {
+		return errors.New("asset category name cannot be blank")
+	}
+	if len(ac.Denoms) == 0 {
+		return fmt.Errorf("asset category %s has no denoms", ac.Name)
+	}
+	seenDenoms := make(map[string]bool)
+	for _, denom := range ac.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("asset category %s: %w", ac.Name, err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("asset category %s has duplicate denom %s", ac.Name, denom)
+		}
+		seenDenoms[denom] = true
+	}
+	if ac.LoanToValue.IsNil() || !ac.LoanToValue.IsPositive() || ac.LoanToValue.GT(sdk.OneDec()) {
+		return fmt.Errorf("asset category %s loan-to-value must be in the range (0.0, 1.0]: %s", ac.Name, ac.LoanToValue)
+	}
+	if ac.LiquidationThreshold.IsNil() || ac.LiquidationThreshold.LT(ac.LoanToValue) || ac.LiquidationThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("asset category %s liquidation threshold must be between its loan-to-value and 1.0: %s", ac.Name, ac.LiquidationThreshold)
+	}
+	return nil
+}
+
+// Contains returns true if the denom is in the category
+func (ac AssetCategory) Contains(denom string) bool {
+	for _, d := range ac.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll returns true if every coin denom is in the category
+func (ac AssetCategory) ContainsAll(coins ...sdk.Coins) bool {
+	for _, cs := range coins {
+		for _, coin := range cs {
+			if !ac.Contains(coin.Denom) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// AssetCategories slice of AssetCategory
+type AssetCategories []AssetCategory
+
+// Validate asset categories
+func (acs AssetCategories) Validate() error {
+	seenNames := make(map[string]bool)
+	for _, category := range acs {
+		if err := category.Validate(); err != nil {
+			return err
+		}
+		if seenNames[category.Name] {
+			return fmt.Errorf("duplicate asset category %s", category.Name)
+		}
+		seenNames[category.Name] = true
+	}
+	return nil
+}
+
+// Get returns the asset category with the given name
+func (acs AssetCategories) Get(name string) (AssetCategory, bool) {
+	for _, category := range acs {
+		if category.Name == name {
+			return category, true
+		}
+	}
+	return AssetCategory{}, false
+}
+
+// NewAccountAssetCategory returns a new AccountAssetCategory
+func NewAccountAssetCategory(address sdk.AccAddress, category string) AccountAssetCategory {
+	return AccountAssetCategory{
+		Address:  address,
+		Category: category,
+	}
+}
+
+// Validate performs a basic check of an AccountAssetCategory
+func (aac AccountAssetCategory) Validate() error {
+	if aac.Address.Empty() {
+		return errors.New("account asset category address cannot be empty")
+	}
+	if strings.TrimSpace(aac.Category) == "" {
+		return fmt.Errorf("account %s asset category cannot be blank", aac.Address)
+	}
+	return nil
+}
+
+// AccountAssetCategories slice of AccountAssetCategory
+type AccountAssetCategories []AccountAssetCategory
+
+// Validate performs a basic check of AccountAssetCategories
+func (aacs AccountAssetCategories) Validate() error {
+	seenAddresses := make(map[string]bool)
+	for _, aac := range aacs {
+		if err := aac.Validate(); err != nil {
+			return err
+		}
+		if seenAddresses[aac.Address.String()] {
+			return fmt.Errorf("duplicate asset category for account %s", aac.Address)
+		}
+		seenAddresses[aac.Address.String()] = true
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -15,6 +16,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgSetAssetCategory{}, "hard/MsgSetAssetCategory", nil)
+	cdc.RegisterConcrete(&MsgFlashLoan{}, "hard/MsgFlashLoan", nil)
+	cdc.RegisterConcrete(&MsgApproveCreditDelegation{}, "hard/MsgApproveCreditDelegation", nil)
+	cdc.RegisterConcrete(&MsgDelegatedBorrow{}, "hard/MsgDelegatedBorrow", nil)
+	cdc.RegisterConcrete(&MsgSetCollateralEnabled{}, "hard/MsgSetCollateralEnabled", nil)
+
+	cdc.RegisterConcrete(&HardReserveSpendProposal{}, "kava/HardReserveSpendProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +32,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgSetAssetCategory{},
+		&MsgFlashLoan{},
+		&MsgApproveCreditDelegation{},
+		&MsgDelegatedBorrow{},
+		&MsgSetCollateralEnabled{},
+	)
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&HardReserveSpendProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDisabledCollateral returns a new DisabledCollateral
+func NewDisabledCollateral(address sdk.AccAddress, denom string) DisabledCollateral {
+	return DisabledCollateral{
+		Address: address,
+		Denom:   denom,
+	}
+}
+
+// Validate performs a basic check of a DisabledCollateral
+func (dc DisabledCollateral) Validate() error {
+	if dc.Address.Empty() {
+		return errors.New("disabled collateral address cannot be empty")
+	}
+	if err := sdk.ValidateDenom(dc.Denom); err != nil {
+		return fmt.Errorf("account %s disabled collateral: %w", dc.Address, err)
+	}
+	return nil
+}
+
+// DisabledCollaterals slice of DisabledCollateral
+type DisabledCollaterals []DisabledCollateral
+
+// Validate performs a basic check of DisabledCollaterals
+func (dcs DisabledCollaterals) Validate() error {
+	seen := make(map[string]bool)
+	for _, dc := range dcs {
+		if err := dc.Validate(); err != nil {
+			return err
+		}
+		key := dc.Address.String() + "/" + dc.Denom
+		if seen[key] {
+			return fmt.Errorf("duplicate disabled collateral %s for account %s", dc.Denom, dc.Address)
+		}
+		seen[key] = true
+	}
+	return nil
+}
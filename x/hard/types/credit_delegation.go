@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCreditDelegation returns a new CreditDelegation
+func NewCreditDelegation(delegator, delegatee sdk.AccAddress, allowance, borrowed sdk.Coins, index BorrowInterestFactors) CreditDelegation {
+	return CreditDelegation{
+		Delegator: delegator,
+		Delegatee: delegatee,
+		Allowance: allowance,
+		Borrowed:  borrowed,
+		Index:     index,
+	}
+}
+
+// Validate performs a basic check of a CreditDelegation
+func (cd CreditDelegation) Validate() error {
+	if cd.Delegator.Empty() {
+		return errors.New("credit delegation delegator cannot be empty")
+	}
+	if cd.Delegatee.Empty() {
+		return errors.New("credit delegation delegatee cannot be empty")
+	}
+	if cd.Delegator.Equals(cd.Delegatee) {
+		return fmt.Errorf("account %s cannot delegate credit to itself", cd.Delegator)
+	}
+	if !cd.Allowance.IsValid() {
+		return fmt.Errorf("invalid credit delegation allowance: %s", cd.Allowance)
+	}
+	if !cd.Borrowed.IsValid() {
+		return fmt.Errorf("invalid credit delegation borrowed coins: %s", cd.Borrowed)
+	}
+	return cd.Index.Validate()
+}
+
+// IsEmpty returns true if the delegatee can't borrow any more and owes nothing
+func (cd CreditDelegation) IsEmpty() bool {
+	return cd.Allowance.IsZero() && cd.Borrowed.IsZero()
+}
+
+// ToResponse converts a CreditDelegation to a CreditDelegationResponse
+func (cd CreditDelegation) ToResponse() CreditDelegationResponse {
+	return CreditDelegationResponse{
+		Delegator: cd.Delegator.String(),
+		Delegatee: cd.Delegatee.String(),
+		Allowance: cd.Allowance,
+		Borrowed:  cd.Borrowed,
+	}
+}
+
+// CreditDelegations slice of CreditDelegation
+type CreditDelegations []CreditDelegation
+
+// Validate performs a basic check of CreditDelegations
+func (cds CreditDelegations) Validate() error {
+	seen := make(map[string]bool)
+	for _, cd := range cds {
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+		key := cd.Delegator.String() + "/" + cd.Delegatee.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate credit delegation from %s to %s", cd.Delegator, cd.Delegatee)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ToResponse converts CreditDelegations to CreditDelegationResponses
+func (cds CreditDelegations) ToResponse() CreditDelegationResponses {
+	responses := CreditDelegationResponses{}
+	for _, cd := range cds {
+		responses = append(responses, cd.ToResponse())
+	}
+	return responses
+}
+
+// CreditDelegationResponses is a slice of CreditDelegationResponse
+type CreditDelegationResponses []CreditDelegationResponse
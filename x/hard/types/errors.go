@@ -67,4 +67,22 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrInvalidAssetCategory error for when an asset category does not exist
+	ErrInvalidAssetCategory = errorsmod.Register(ModuleName, 33, "invalid asset category")
+	// ErrDenomNotInAssetCategory error for when an account in an asset category uses an asset outside of it
+	ErrDenomNotInAssetCategory = errorsmod.Register(ModuleName, 34, "denom not in account's asset category")
+	// ErrInvalidFlashLoanMsgs error for when the msgs executed with a flash loan are invalid
+	ErrInvalidFlashLoanMsgs = errorsmod.Register(ModuleName, 35, "invalid flash loan messages")
+	// ErrFlashLoanNotRepaid error for when a flash loan and its fee are not repaid by the end of its msgs
+	ErrFlashLoanNotRepaid = errorsmod.Register(ModuleName, 36, "flash loan not repaid")
+	// ErrExceedsSupplyCap error for when a deposit would take a money market's total supply above its supply cap
+	ErrExceedsSupplyCap = errorsmod.Register(ModuleName, 37, "exceeds supply cap")
+	// ErrExceedsAccountBorrowCap error for when a borrow would take an account above a money market's account borrow cap
+	ErrExceedsAccountBorrowCap = errorsmod.Register(ModuleName, 38, "exceeds account borrow cap")
+	// ErrCreditDelegationNotFound error for when a credit delegation does not exist
+	ErrCreditDelegationNotFound = errorsmod.Register(ModuleName, 39, "credit delegation not found")
+	// ErrExceedsCreditDelegation error for when a delegated borrow is more than the delegatee's allowance
+	ErrExceedsCreditDelegation = errorsmod.Register(ModuleName, 40, "exceeds credit delegation allowance")
+	// ErrInsufficientReserves error for when more reserves are spent than the module holds
+	ErrInsufficientReserves = errorsmod.Register(ModuleName, 41, "insufficient reserves")
 )
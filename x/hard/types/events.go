@@ -2,24 +2,42 @@ package types
 
 // Event types for hard module
 const (
-	EventTypeHardDeposit          = "hard_deposit"
-	EventTypeHardWithdrawal       = "hard_withdrawal"
-	EventTypeHardBorrow           = "hard_borrow"
-	EventTypeHardLiquidation      = "hard_liquidation"
-	EventTypeHardRepay            = "hard_repay"
-	AttributeValueCategory        = ModuleName
-	AttributeKeyDeposit           = "deposit"
-	AttributeKeyDepositDenom      = "deposit_denom"
-	AttributeKeyDepositCoins      = "deposit_coins"
-	AttributeKeyDepositor         = "depositor"
-	AttributeKeyBorrow            = "borrow"
-	AttributeKeyBorrower          = "borrower"
-	AttributeKeyBorrowCoins       = "borrow_coins"
-	AttributeKeySender            = "sender"
-	AttributeKeyRepayCoins        = "repay_coins"
-	AttributeKeyLiquidatedOwner   = "liquidated_owner"
-	AttributeKeyLiquidatedCoins   = "liquidated_coins"
-	AttributeKeyKeeper            = "keeper"
-	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
-	AttributeKeyOwner             = "owner"
+	EventTypeHardDeposit                 = "hard_deposit"
+	EventTypeHardWithdrawal              = "hard_withdrawal"
+	EventTypeHardBorrow                  = "hard_borrow"
+	EventTypeHardLiquidation             = "hard_liquidation"
+	EventTypeHardRepay                   = "hard_repay"
+	EventTypeHardSetAssetCategory        = "hard_set_asset_category"
+	EventTypeHardFlashLoan               = "hard_flash_loan"
+	EventTypeHardSwapLiquidation         = "hard_swap_liquidation"
+	EventTypeHardApproveCreditDelegation = "hard_approve_credit_delegation"
+	EventTypeHardDelegatedBorrow         = "hard_delegated_borrow"
+	EventTypeHardSetCollateralEnabled    = "hard_set_collateral_enabled"
+	EventTypeHardReserveSpend            = "hard_reserve_spend"
+	EventTypeHardReserveBuyback          = "hard_reserve_buyback"
+	AttributeValueCategory               = ModuleName
+	AttributeKeyDeposit                  = "deposit"
+	AttributeKeyDepositDenom             = "deposit_denom"
+	AttributeKeyDepositCoins             = "deposit_coins"
+	AttributeKeyDepositor                = "depositor"
+	AttributeKeyBorrow                   = "borrow"
+	AttributeKeyBorrower                 = "borrower"
+	AttributeKeyBorrowCoins              = "borrow_coins"
+	AttributeKeySender                   = "sender"
+	AttributeKeyRepayCoins               = "repay_coins"
+	AttributeKeyLiquidatedOwner          = "liquidated_owner"
+	AttributeKeyLiquidatedCoins          = "liquidated_coins"
+	AttributeKeyKeeper                   = "keeper"
+	AttributeKeyKeeperRewardCoins        = "keeper_reward_coins"
+	AttributeKeyOwner                    = "owner"
+	AttributeKeyAssetCategory            = "asset_category"
+	AttributeKeyFlashLoanFee             = "flash_loan_fee"
+	AttributeKeyFlashLoanMsgIndex        = "flash_loan_msg_index"
+	AttributeKeySoldCoins                = "sold_coins"
+	AttributeKeyProceeds                 = "proceeds"
+	AttributeKeyDelegator                = "delegator"
+	AttributeKeyDelegatee                = "delegatee"
+	AttributeKeyAllowance                = "allowance"
+	AttributeKeyCollateralEnabled        = "collateral_enabled"
+	AttributeKeyRecipient                = "recipient"
 )
@@ -42,6 +42,7 @@ type StakingKeeper interface {
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	GetLiquidationPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
 }
 
 // AuctionKeeper expected interface for the auction keeper (noalias)
@@ -49,6 +50,12 @@ type AuctionKeeper interface {
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin) (uint64, error)
 }
 
+// SwapKeeper defines the expected interface for selling seized collateral through x/swap
+type SwapKeeper interface {
+	SwapExactForTokensFromModule(ctx sdk.Context, senderModule string, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) (sdk.Coin, error)
+	SwapForExactTokensFromModule(ctx sdk.Context, senderModule string, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) (sdk.Coin, error)
+}
+
 // HARDHooks event hooks for other keepers to run code in response to HARD modifications
 type HARDHooks interface {
 	AfterDepositCreated(ctx sdk.Context, deposit Deposit)
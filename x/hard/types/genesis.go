@@ -20,6 +20,9 @@ func NewGenesisState(
 		TotalSupplied:             totalSupplied,
 		TotalBorrowed:             totalBorrowed,
 		TotalReserves:             totalReserves,
+		AccountAssetCategories:    DefaultAccountAssetCategories,
+		CreditDelegations:         DefaultCreditDelegations,
+		DisabledCollateral:        DefaultDisabledCollaterals,
 	}
 }
 
@@ -33,6 +36,9 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		AccountAssetCategories:    DefaultAccountAssetCategories,
+		CreditDelegations:         DefaultCreditDelegations,
+		DisabledCollateral:        DefaultDisabledCollaterals,
 	}
 }
 
@@ -61,6 +67,15 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+	if err := gs.AccountAssetCategories.Validate(); err != nil {
+		return err
+	}
+	if err := gs.CreditDelegations.Validate(); err != nil {
+		return err
+	}
+	if err := gs.DisabledCollateral.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32,13 +32,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the hard module's genesis state.
 type GenesisState struct {
-	Params                    Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PreviousAccumulationTimes GenesisAccumulationTimes                 `protobuf:"bytes,2,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
-	Deposits                  Deposits                                 `protobuf:"bytes,3,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
-	Borrows                   Borrows                                  `protobuf:"bytes,4,rep,name=borrows,proto3,castrepeated=Borrows" json:"borrows"`
-	TotalSupplied             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
-	TotalBorrowed             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
-	TotalReserves             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	Params                     Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PreviousAccumulationTimes  GenesisAccumulationTimes                 `protobuf:"bytes,2,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
+	Deposits                   Deposits                                 `protobuf:"bytes,3,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Borrows                    Borrows                                  `protobuf:"bytes,4,rep,name=borrows,proto3,castrepeated=Borrows" json:"borrows"`
+	TotalSupplied              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
+	TotalBorrowed              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
+	TotalReserves              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	AccountAssetCategories     AccountAssetCategories                   `protobuf:"bytes,8,rep,name=account_asset_categories,json=accountAssetCategories,proto3,castrepeated=AccountAssetCategories" json:"account_asset_categories"`
+	CreditDelegations          CreditDelegations                        `protobuf:"bytes,9,rep,name=credit_delegations,json=creditDelegations,proto3,castrepeated=CreditDelegations" json:"credit_delegations"`
+	DisabledCollateral         DisabledCollaterals                      `protobuf:"bytes,10,rep,name=disabled_collateral,json=disabledCollateral,proto3,castrepeated=DisabledCollaterals" json:"disabled_collateral"`
+	PreviousReserveBuybackTime time.Time                                `protobuf:"bytes,11,opt,name=previous_reserve_buyback_time,json=previousReserveBuybackTime,proto3,stdtime" json:"previous_reserve_buyback_time"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +127,34 @@ func (m *GenesisState) GetTotalReserves() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetAccountAssetCategories() AccountAssetCategories {
+	if m != nil {
+		return m.AccountAssetCategories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditDelegations() CreditDelegations {
+	if m != nil {
+		return m.CreditDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledCollateral() DisabledCollaterals {
+	if m != nil {
+		return m.DisabledCollateral
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreviousReserveBuybackTime() time.Time {
+	if m != nil {
+		return m.PreviousReserveBuybackTime
+	}
+	return time.Time{}
+}
+
 // GenesisAccumulationTime stores the previous distribution time and its corresponding denom.
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -186,44 +218,54 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/genesis.proto", fileDescriptor_20a1f6c2cf728e74) }
 
 var fileDescriptor_20a1f6c2cf728e74 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x6d, 0xc2, 0x16, 0x5a, 0xb0, 0x2a, 0x70, 0x02, 0xb2, 0xa3, 0x1e, 0x20,
-	0x42, 0x8a, 0x4d, 0xcb, 0x81, 0x0b, 0x07, 0x30, 0x11, 0x1f, 0x37, 0xe4, 0xe6, 0xc4, 0xc5, 0x5a,
-	0x3b, 0x5b, 0xd7, 0xaa, 0x9d, 0xb5, 0x76, 0xd6, 0x81, 0xbc, 0x03, 0x42, 0x7d, 0x0e, 0xce, 0x3c,
-	0x01, 0xa7, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x8b, 0x92, 0x17, 0x41, 0xfb, 0x91, 0xa4, 0x28, 0x89,
-	0xc4, 0x81, 0x9e, 0xe2, 0xdd, 0xfd, 0xcf, 0xff, 0x37, 0x3b, 0x3b, 0x13, 0xe4, 0x9c, 0xe0, 0x21,
-	0xf6, 0x8e, 0x31, 0xeb, 0x7b, 0xc3, 0xfd, 0x88, 0x70, 0xbc, 0xef, 0x25, 0x64, 0x40, 0x20, 0x05,
-	0xb7, 0x60, 0x94, 0x53, 0xf3, 0x8e, 0x10, 0xb8, 0x42, 0xe0, 0x6a, 0x41, 0xd3, 0x8e, 0x29, 0xe4,
-	0x14, 0xbc, 0x08, 0x03, 0x99, 0x45, 0xc5, 0x34, 0x1d, 0xa8, 0x90, 0x66, 0x43, 0x9d, 0x87, 0x72,
-	0xe5, 0xa9, 0x85, 0x3e, 0xda, 0x4d, 0x68, 0x42, 0xd5, 0xbe, 0xf8, 0xd2, 0xbb, 0x4e, 0x42, 0x69,
-	0x92, 0x11, 0x4f, 0xae, 0xa2, 0xf2, 0xc8, 0xe3, 0x69, 0x4e, 0x80, 0xe3, 0xbc, 0xd0, 0x82, 0x07,
-	0x8b, 0x59, 0xca, 0x8c, 0xe4, 0xe9, 0xde, 0xf7, 0x0d, 0x74, 0xf3, 0x8d, 0x4a, 0xfa, 0x90, 0x63,
-	0x4e, 0xcc, 0x67, 0x68, 0xb3, 0xc0, 0x0c, 0xe7, 0x60, 0x19, 0x2d, 0xa3, 0xbd, 0x75, 0xd0, 0x70,
-	0x17, 0x2e, 0xe1, 0xbe, 0x97, 0x02, 0x7f, 0xfd, 0xec, 0xc2, 0xa9, 0x04, 0x5a, 0x6e, 0x7e, 0x36,
-	0xd0, 0xfd, 0x82, 0x91, 0x61, 0x4a, 0x4b, 0x08, 0x71, 0x1c, 0x97, 0x79, 0x99, 0x61, 0x9e, 0xd2,
-	0x41, 0x28, 0x33, 0xb2, 0xd6, 0x5a, 0xd5, 0xf6, 0xd6, 0xc1, 0xe3, 0x25, 0x76, 0x9a, 0xff, 0xf2,
-	0x4a, 0x4c, 0x2f, 0xcd, 0x89, 0xdf, 0x12, 0xfe, 0x5f, 0x2f, 0x1d, 0x6b, 0x85, 0x00, 0x82, 0xc6,
-	0x14, 0xb8, 0x70, 0x64, 0xbe, 0x45, 0xf5, 0x3e, 0x29, 0x28, 0xa4, 0x1c, 0xac, 0xaa, 0x44, 0x37,
-	0x97, 0xa0, 0xbb, 0x4a, 0xe2, 0xdf, 0xd6, 0xa8, 0xba, 0xde, 0x80, 0x60, 0x16, 0x6d, 0x76, 0x51,
-	0x2d, 0xa2, 0x8c, 0xd1, 0x8f, 0x60, 0xad, 0x4b, 0xa3, 0x65, 0x25, 0xf1, 0xa5, 0xc2, 0xdf, 0xd1,
-	0x3e, 0x35, 0xb5, 0x86, 0x60, 0x1a, 0x6a, 0x32, 0xb4, 0xcd, 0x29, 0xc7, 0x59, 0x08, 0x65, 0x51,
-	0x64, 0x29, 0xe9, 0x5b, 0x1b, 0xda, 0x4c, 0x3f, 0xb2, 0xe8, 0x88, 0x99, 0xdd, 0x2b, 0x9a, 0x0e,
-	0xfc, 0x27, 0xda, 0xac, 0x9d, 0xa4, 0xfc, 0xb8, 0x8c, 0xdc, 0x98, 0xe6, 0xba, 0x23, 0xf4, 0x4f,
-	0x07, 0xfa, 0x27, 0x1e, 0x1f, 0x15, 0x04, 0x64, 0x00, 0x04, 0xb7, 0x24, 0xe2, 0x50, 0x13, 0xe6,
-	0x4c, 0x95, 0x04, 0xe9, 0x5b, 0x9b, 0xd7, 0xc5, 0xf4, 0x35, 0x61, 0xce, 0x64, 0x04, 0x08, 0x1b,
-	0x12, 0xb0, 0x6a, 0xd7, 0xc5, 0x0c, 0x34, 0x61, 0xef, 0x4b, 0x15, 0xdd, 0x5b, 0xd1, 0x23, 0xe6,
-	0x23, 0xb4, 0x13, 0xd3, 0x2c, 0xc3, 0x9c, 0x30, 0x9c, 0x85, 0xc2, 0x44, 0x36, 0xf6, 0x8d, 0x60,
-	0x7b, 0xbe, 0xdd, 0x1b, 0x15, 0xc4, 0x8c, 0x50, 0x73, 0x75, 0xfb, 0x5a, 0x6b, 0x72, 0x18, 0x9a,
-	0xae, 0x9a, 0x36, 0x77, 0x3a, 0x6d, 0x6e, 0x6f, 0x3a, 0x6d, 0x7e, 0x5d, 0xdc, 0xe2, 0xf4, 0xd2,
-	0x31, 0x02, 0x6b, 0x55, 0x57, 0x9a, 0x0c, 0xdd, 0x95, 0xcf, 0x3f, 0x0a, 0xd3, 0x01, 0x27, 0x8c,
-	0x00, 0x0f, 0x8f, 0x70, 0xcc, 0x29, 0xb3, 0xaa, 0x22, 0x27, 0xff, 0xb9, 0xf0, 0xf8, 0x75, 0xe1,
-	0x3c, 0xfc, 0x87, 0x4a, 0x74, 0x49, 0xfc, 0xe3, 0x5b, 0x07, 0xe9, 0xaa, 0x76, 0x49, 0x1c, 0xec,
-	0x2a, 0xef, 0x77, 0xda, 0xfa, 0xb5, 0x74, 0x16, 0x4c, 0xf5, 0xfc, 0x0b, 0xcc, 0xf5, 0xff, 0xc1,
-	0x54, 0xde, 0x7f, 0x33, 0xfd, 0x17, 0x67, 0x63, 0xdb, 0x38, 0x1f, 0xdb, 0xc6, 0xef, 0xb1, 0x6d,
-	0x9c, 0x4e, 0xec, 0xca, 0xf9, 0xc4, 0xae, 0xfc, 0x9c, 0xd8, 0x95, 0x0f, 0x57, 0x29, 0x62, 0x8a,
-	0x3a, 0x19, 0x8e, 0x40, 0x7e, 0x79, 0x9f, 0xd4, 0x9f, 0x94, 0x24, 0x45, 0x9b, 0xb2, 0xc2, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x16, 0xde, 0x3f, 0x0d, 0x64, 0x05, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0xc2, 0x42, 0x18, 0x76, 0x61, 0x31, 0x88, 0x9d, 0x84, 0x5d, 0x27, 0x62, 0xb5,
+	0x0b, 0xaa, 0x84, 0x5d, 0xe8, 0xa1, 0x97, 0x1e, 0x8a, 0x13, 0xf5, 0xc7, 0xad, 0x32, 0x9c, 0x7a,
+	0xb1, 0xc6, 0xf6, 0x60, 0x2c, 0xec, 0x8c, 0x35, 0x6f, 0x9c, 0x36, 0xc7, 0xde, 0xab, 0x0a, 0xa9,
+	0xff, 0x45, 0xcf, 0xfd, 0x23, 0x38, 0xa2, 0x9e, 0xaa, 0x1e, 0xa0, 0x82, 0x7f, 0xa4, 0xf2, 0xcc,
+	0x24, 0xa1, 0x24, 0x91, 0x5a, 0xa9, 0x9c, 0xe2, 0x79, 0xf3, 0x7d, 0xdf, 0xcf, 0xb3, 0xe7, 0xcd,
+	0x0b, 0x6a, 0x9e, 0x90, 0x1e, 0x71, 0x8e, 0x09, 0x8f, 0x9c, 0xde, 0x6e, 0x40, 0x05, 0xd9, 0x75,
+	0x62, 0xda, 0xa5, 0x90, 0x80, 0x9d, 0x73, 0x26, 0x98, 0xb9, 0x52, 0x0a, 0xec, 0x52, 0x60, 0x6b,
+	0x41, 0xc3, 0x0a, 0x19, 0x64, 0x0c, 0x9c, 0x80, 0x00, 0x1d, 0x66, 0x85, 0x2c, 0xe9, 0xaa, 0x94,
+	0x46, 0x5d, 0xed, 0xfb, 0x72, 0xe5, 0xa8, 0x85, 0xde, 0x5a, 0x8b, 0x59, 0xcc, 0x54, 0xbc, 0x7c,
+	0xd2, 0xd1, 0x66, 0xcc, 0x58, 0x9c, 0x52, 0x47, 0xae, 0x82, 0xe2, 0xc8, 0x11, 0x49, 0x46, 0x41,
+	0x90, 0x2c, 0xd7, 0x82, 0xbf, 0xc7, 0xab, 0x94, 0x15, 0xc9, 0xdd, 0xcd, 0xf7, 0x0b, 0xe8, 0xf7,
+	0xa7, 0xaa, 0xe8, 0x03, 0x41, 0x04, 0x35, 0x1f, 0xa2, 0xb9, 0x9c, 0x70, 0x92, 0x01, 0x36, 0x5a,
+	0xc6, 0xf6, 0xe2, 0x5e, 0xdd, 0x1e, 0x7b, 0x09, 0xfb, 0x85, 0x14, 0xb8, 0xb3, 0x67, 0x17, 0xcd,
+	0x8a, 0xa7, 0xe5, 0xe6, 0x5b, 0x03, 0x6d, 0xe4, 0x9c, 0xf6, 0x12, 0x56, 0x80, 0x4f, 0xc2, 0xb0,
+	0xc8, 0x8a, 0x94, 0x88, 0x84, 0x75, 0x7d, 0x59, 0x11, 0x9e, 0x69, 0x55, 0xb7, 0x17, 0xf7, 0xee,
+	0x4d, 0xb0, 0xd3, 0xfc, 0xfd, 0x1b, 0x39, 0x87, 0x49, 0x46, 0xdd, 0x56, 0xe9, 0xff, 0xe1, 0xb2,
+	0x89, 0xa7, 0x08, 0xc0, 0xab, 0x0f, 0x80, 0x63, 0x5b, 0xe6, 0x33, 0x54, 0x8b, 0x68, 0xce, 0x20,
+	0x11, 0x80, 0xab, 0x12, 0xdd, 0x98, 0x80, 0xee, 0x28, 0x89, 0xfb, 0xa7, 0x46, 0xd5, 0x74, 0x00,
+	0xbc, 0x61, 0xb6, 0xd9, 0x41, 0xf3, 0x01, 0xe3, 0x9c, 0xbd, 0x02, 0x3c, 0xdb, 0xaa, 0x4e, 0xf9,
+	0x24, 0xae, 0x54, 0xb8, 0xcb, 0xda, 0x67, 0x5e, 0xad, 0xc1, 0x1b, 0xa4, 0x9a, 0x1c, 0x2d, 0x09,
+	0x26, 0x48, 0xea, 0x43, 0x91, 0xe7, 0x69, 0x42, 0x23, 0xfc, 0x9b, 0x36, 0xd3, 0x87, 0x5c, 0x76,
+	0xc4, 0xd0, 0xae, 0xcd, 0x92, 0xae, 0x7b, 0x5f, 0x9b, 0x6d, 0xc7, 0x89, 0x38, 0x2e, 0x02, 0x3b,
+	0x64, 0x99, 0xee, 0x08, 0xfd, 0xb3, 0x03, 0xd1, 0x89, 0x23, 0xfa, 0x39, 0x05, 0x99, 0x00, 0xde,
+	0x1f, 0x12, 0x71, 0xa0, 0x09, 0x23, 0xa6, 0x2a, 0x82, 0x46, 0x78, 0xee, 0xae, 0x98, 0xae, 0x26,
+	0x8c, 0x98, 0x9c, 0x02, 0xe5, 0x3d, 0x0a, 0x78, 0xfe, 0xae, 0x98, 0x9e, 0x26, 0x98, 0x6f, 0x0c,
+	0x84, 0x49, 0x18, 0xb2, 0xa2, 0x2b, 0x7c, 0x02, 0x40, 0x85, 0x1f, 0x12, 0x41, 0x63, 0xc6, 0x13,
+	0x0a, 0xb8, 0x26, 0xf1, 0x5b, 0x13, 0xce, 0x6c, 0x5f, 0xa5, 0xec, 0x97, 0x19, 0x6d, 0x95, 0xd0,
+	0x77, 0x2d, 0x5d, 0xcc, 0xfa, 0x84, 0xdd, 0x84, 0x82, 0xb7, 0x4e, 0x26, 0xc6, 0xcd, 0x0c, 0x99,
+	0x21, 0xa7, 0x51, 0x22, 0xfc, 0x88, 0xa6, 0x34, 0x96, 0x9d, 0x08, 0x78, 0x41, 0xc2, 0xff, 0x9d,
+	0x00, 0x6f, 0x4b, 0x71, 0x67, 0xa8, 0x75, 0xeb, 0x1a, 0xbc, 0x72, 0x7b, 0x07, 0xbc, 0x95, 0xf0,
+	0x76, 0xc8, 0x04, 0xb4, 0x1a, 0x25, 0x40, 0x82, 0x94, 0x46, 0x7e, 0xc8, 0xd2, 0x94, 0x08, 0xca,
+	0x49, 0x8a, 0x91, 0xe4, 0xfd, 0x37, 0xa9, 0xd3, 0xb5, 0xba, 0x3d, 0x14, 0xbb, 0x1b, 0x9a, 0xb8,
+	0x3a, 0xbe, 0x07, 0x9e, 0x19, 0x8d, 0x05, 0xcd, 0x18, 0xfd, 0x33, 0xbc, 0xe1, 0xfa, 0x78, 0xfd,
+	0xa0, 0xe8, 0x07, 0x24, 0x3c, 0x91, 0x97, 0x1c, 0x2f, 0xca, 0x91, 0xd1, 0xb0, 0xd5, 0x4c, 0xb2,
+	0x07, 0x33, 0xc9, 0x3e, 0x1c, 0xcc, 0x24, 0xb7, 0x56, 0x32, 0x4f, 0x2f, 0x9b, 0x86, 0xd7, 0x18,
+	0x58, 0xe9, 0x63, 0x74, 0x95, 0x51, 0x29, 0xdd, 0x7c, 0x57, 0x45, 0x7f, 0x4d, 0xb9, 0xf4, 0xe6,
+	0x16, 0x5a, 0x1e, 0xbd, 0xb0, 0x5f, 0x76, 0x85, 0x9c, 0x54, 0x0b, 0xde, 0xd2, 0x28, 0x7c, 0xd8,
+	0xcf, 0xa9, 0x19, 0xa0, 0xc6, 0xf4, 0x79, 0x84, 0x67, 0x7e, 0xa2, 0x54, 0x3c, 0x6d, 0xcc, 0x98,
+	0x1c, 0xad, 0xcb, 0xfb, 0xdc, 0xf7, 0x93, 0xae, 0xa0, 0x9c, 0x82, 0xf0, 0x8f, 0x48, 0x28, 0x18,
+	0xc7, 0xd5, 0xb2, 0x26, 0xf7, 0x51, 0xe9, 0xf1, 0xe5, 0xa2, 0xf9, 0xff, 0x0f, 0xb4, 0x76, 0x87,
+	0x86, 0x9f, 0x3e, 0xee, 0x20, 0x15, 0x2f, 0x57, 0xde, 0x9a, 0xf2, 0x7e, 0xae, 0xad, 0x9f, 0x48,
+	0xe7, 0x92, 0xa9, 0xee, 0xf3, 0x18, 0x73, 0xf6, 0x57, 0x30, 0x95, 0xf7, 0xf7, 0x4c, 0xf7, 0xf1,
+	0xd9, 0x95, 0x65, 0x9c, 0x5f, 0x59, 0xc6, 0xd7, 0x2b, 0xcb, 0x38, 0xbd, 0xb6, 0x2a, 0xe7, 0xd7,
+	0x56, 0xe5, 0xf3, 0xb5, 0x55, 0x79, 0x79, 0x93, 0x52, 0x76, 0xdd, 0x4e, 0x4a, 0x02, 0x90, 0x4f,
+	0xce, 0x6b, 0xf5, 0xaf, 0x23, 0x49, 0xc1, 0x9c, 0xfc, 0xc2, 0x0f, 0xbe, 0x0d, 0x00, 0x34, 0x42,
+	0x8a, 0x20, 0x35, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +288,56 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousReserveBuybackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousReserveBuybackTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.DisabledCollateral) > 0 {
+		for iNdEx := len(m.DisabledCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisabledCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for iNdEx := len(m.CreditDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AccountAssetCategories) > 0 {
+		for iNdEx := len(m.AccountAssetCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAssetCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TotalReserves) > 0 {
 		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -383,12 +475,12 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
@@ -456,6 +548,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccountAssetCategories) > 0 {
+		for _, e := range m.AccountAssetCategories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for _, e := range m.CreditDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DisabledCollateral) > 0 {
+		for _, e := range m.DisabledCollateral {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousReserveBuybackTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -750,6 +862,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAssetCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAssetCategories = append(m.AccountAssetCategories, AccountAssetCategory{})
+			if err := m.AccountAssetCategories[len(m.AccountAssetCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditDelegations = append(m.CreditDelegations, CreditDelegation{})
+			if err := m.CreditDelegations[len(m.CreditDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledCollateral = append(m.DisabledCollateral, DisabledCollateral{})
+			if err := m.DisabledCollateral[len(m.DisabledCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReserveBuybackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreviousReserveBuybackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
 	MinimumBorrowUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minimum_borrow_usd_value,json=minimumBorrowUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_borrow_usd_value"`
+	AssetCategories       AssetCategories                        `protobuf:"bytes,3,rep,name=asset_categories,json=assetCategories,proto3,castrepeated=AssetCategories" json:"asset_categories"`
+	// flash_loan_fee is the fraction of a flash loan charged as a fee, shared between reserves and suppliers
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	// reserve_buyback periodically sells reserves above a threshold for a target denom through x/swap. It is
+	// disabled when the target denom is empty.
+	ReserveBuyback ReserveBuyback `protobuf:"bytes,5,opt,name=reserve_buyback,json=reserveBuyback,proto3" json:"reserve_buyback"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,6 +75,223 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ReserveBuyback sells the hard module's excess reserves through x/swap on a schedule. The proceeds are added
+// to the reserves of the target denom.
+type ReserveBuyback struct {
+	// target_denom is the denom reserves are sold for, which must have a money market
+	TargetDenom string `protobuf:"bytes,1,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	// thresholds are the reserves of each denom that are kept. Reserves above a threshold are sold, and
+	// reserves of denoms without one are not.
+	Thresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=thresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"thresholds"`
+	// interval is the minimum time between buybacks
+	Interval time.Duration `protobuf:"bytes,3,opt,name=interval,proto3,stdduration" json:"interval"`
+	// max_slippage is the maximum shortfall of the swap price relative to the oracle price
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+}
+
+func (m *ReserveBuyback) Reset()         { *m = ReserveBuyback{} }
+func (m *ReserveBuyback) String() string { return proto.CompactTextString(m) }
+func (*ReserveBuyback) ProtoMessage()    {}
+func (*ReserveBuyback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{1}
+}
+func (m *ReserveBuyback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveBuyback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveBuyback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveBuyback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveBuyback.Merge(m, src)
+}
+func (m *ReserveBuyback) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveBuyback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveBuyback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveBuyback proto.InternalMessageInfo
+
+// AssetCategory is a group of correlated assets, such as ukava and its liquid staking derivatives.
+// Accounts that opt into a category and only hold category assets borrow at the category's limits.
+type AssetCategory struct {
+	// name is the unique identifier of the category
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// denoms are the money market denoms in the category
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// loan_to_value is the maximum borrowed value relative to deposited value
+	LoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	// liquidation_threshold is the borrowed value relative to deposited value above which a position can be liquidated
+	LiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_threshold"`
+}
+
+func (m *AssetCategory) Reset()         { *m = AssetCategory{} }
+func (m *AssetCategory) String() string { return proto.CompactTextString(m) }
+func (*AssetCategory) ProtoMessage()    {}
+func (*AssetCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{2}
+}
+func (m *AssetCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetCategory.Merge(m, src)
+}
+func (m *AssetCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetCategory proto.InternalMessageInfo
+
+// AccountAssetCategory records the asset category an account has opted into.
+type AccountAssetCategory struct {
+	Address  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Category string                                        `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *AccountAssetCategory) Reset()         { *m = AccountAssetCategory{} }
+func (m *AccountAssetCategory) String() string { return proto.CompactTextString(m) }
+func (*AccountAssetCategory) ProtoMessage()    {}
+func (*AccountAssetCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{3}
+}
+func (m *AccountAssetCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAssetCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAssetCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAssetCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAssetCategory.Merge(m, src)
+}
+func (m *AccountAssetCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAssetCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAssetCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAssetCategory proto.InternalMessageInfo
+
+// CreditDelegation lets a delegatee borrow against a delegator's deposits. The debt is added to the
+// delegator's borrow.
+type CreditDelegation struct {
+	Delegator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Delegatee github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegatee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegatee,omitempty"`
+	// allowance is the amount the delegatee can still borrow
+	Allowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=allowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allowance"`
+	// borrowed is the amount the delegatee owes, including interest up to the borrow interest factors in index
+	Borrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=borrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrowed"`
+	// index is the borrow interest factor of each borrowed denom when interest was last added to borrowed
+	Index BorrowInterestFactors `protobuf:"bytes,5,rep,name=index,proto3,castrepeated=BorrowInterestFactors" json:"index"`
+}
+
+func (m *CreditDelegation) Reset()         { *m = CreditDelegation{} }
+func (m *CreditDelegation) String() string { return proto.CompactTextString(m) }
+func (*CreditDelegation) ProtoMessage()    {}
+func (*CreditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{4}
+}
+func (m *CreditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditDelegation.Merge(m, src)
+}
+func (m *CreditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditDelegation proto.InternalMessageInfo
+
+// DisabledCollateral records a deposited asset an account has chosen not to use as collateral.
+type DisabledCollateral struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Denom   string                                        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DisabledCollateral) Reset()         { *m = DisabledCollateral{} }
+func (m *DisabledCollateral) String() string { return proto.CompactTextString(m) }
+func (*DisabledCollateral) ProtoMessage()    {}
+func (*DisabledCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{5}
+}
+func (m *DisabledCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisabledCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisabledCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisabledCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisabledCollateral.Merge(m, src)
+}
+func (m *DisabledCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisabledCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisabledCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisabledCollateral proto.InternalMessageInfo
+
 // MoneyMarket is a money market for an individual asset.
 type MoneyMarket struct {
 	Denom                  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -74,13 +301,21 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// swap_liquidation, if set, sells this asset through x/swap when it is seized in a liquidation
+	// instead of auctioning it. Liquidations fall back to auctions when the swap can't be made.
+	SwapLiquidation *SwapLiquidation `protobuf:"bytes,8,opt,name=swap_liquidation,json=swapLiquidation,proto3" json:"swap_liquidation,omitempty"`
+	// supply_cap, if set, is the maximum amount of the asset that can be supplied, including supply interest
+	SupplyCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=supply_cap,json=supplyCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply_cap,omitempty"`
+	// account_borrow_cap, if set, is the maximum amount of the asset a single account can borrow, including
+	// borrow interest
+	AccountBorrowCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=account_borrow_cap,json=accountBorrowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account_borrow_cap,omitempty"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
 func (m *MoneyMarket) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarket) ProtoMessage()    {}
 func (*MoneyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{1}
+	return fileDescriptor_23a5de800263a2ff, []int{6}
 }
 func (m *MoneyMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -109,6 +344,45 @@ func (m *MoneyMarket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MoneyMarket proto.InternalMessageInfo
 
+// SwapLiquidation configures selling seized collateral for the borrowed asset through an x/swap pool.
+type SwapLiquidation struct {
+	// max_slippage is the maximum shortfall of the swap price relative to the oracle price
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+}
+
+func (m *SwapLiquidation) Reset()         { *m = SwapLiquidation{} }
+func (m *SwapLiquidation) String() string { return proto.CompactTextString(m) }
+func (*SwapLiquidation) ProtoMessage()    {}
+func (*SwapLiquidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{7}
+}
+func (m *SwapLiquidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapLiquidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapLiquidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapLiquidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapLiquidation.Merge(m, src)
+}
+func (m *SwapLiquidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapLiquidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapLiquidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapLiquidation proto.InternalMessageInfo
+
 // BorrowLimit enforces restrictions on a money market.
 type BorrowLimit struct {
 	HasMaxLimit  bool                                   `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
@@ -120,7 +394,7 @@ func (m *BorrowLimit) Reset()         { *m = BorrowLimit{} }
 func (m *BorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*BorrowLimit) ProtoMessage()    {}
 func (*BorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{2}
+	return fileDescriptor_23a5de800263a2ff, []int{8}
 }
 func (m *BorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +435,7 @@ func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+	return fileDescriptor_23a5de800263a2ff, []int{9}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +475,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_23a5de800263a2ff, []int{10}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +515,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_23a5de800263a2ff, []int{11}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +554,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_23a5de800263a2ff, []int{12}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +593,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_23a5de800263a2ff, []int{13}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +631,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_23a5de800263a2ff, []int{14}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +662,13 @@ var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.hard.v1beta1.Params")
+	proto.RegisterType((*ReserveBuyback)(nil), "kava.hard.v1beta1.ReserveBuyback")
+	proto.RegisterType((*AssetCategory)(nil), "kava.hard.v1beta1.AssetCategory")
+	proto.RegisterType((*AccountAssetCategory)(nil), "kava.hard.v1beta1.AccountAssetCategory")
+	proto.RegisterType((*CreditDelegation)(nil), "kava.hard.v1beta1.CreditDelegation")
+	proto.RegisterType((*DisabledCollateral)(nil), "kava.hard.v1beta1.DisabledCollateral")
 	proto.RegisterType((*MoneyMarket)(nil), "kava.hard.v1beta1.MoneyMarket")
+	proto.RegisterType((*SwapLiquidation)(nil), "kava.hard.v1beta1.SwapLiquidation")
 	proto.RegisterType((*BorrowLimit)(nil), "kava.hard.v1beta1.BorrowLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "kava.hard.v1beta1.InterestRateModel")
 	proto.RegisterType((*Deposit)(nil), "kava.hard.v1beta1.Deposit")
@@ -401,64 +681,94 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
 
 var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+	// 1383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0xfd, 0x47, 0xb1, 0x47, 0x92, 0xff, 0x6c, 0xec, 0x3c, 0x26, 0x78, 0x4f, 0x72, 0x84,
+	0x87, 0xf7, 0x7c, 0xb1, 0xd4, 0xa4, 0x68, 0xd1, 0x43, 0x81, 0xc2, 0xb4, 0x90, 0xd6, 0x68, 0x04,
+	0x18, 0x74, 0xd2, 0x22, 0x41, 0x01, 0x76, 0x49, 0xae, 0x25, 0x56, 0x24, 0x97, 0xe1, 0xae, 0x64,
+	0xeb, 0xd6, 0x6b, 0x2f, 0x41, 0x0e, 0x45, 0xd1, 0x0f, 0xd0, 0x53, 0x6f, 0x05, 0xf2, 0x09, 0x7a,
+	0xca, 0x31, 0xc8, 0x29, 0xe8, 0xc1, 0x69, 0x9d, 0x5b, 0x3f, 0x42, 0x4f, 0xc5, 0xfe, 0x91, 0x44,
+	0x39, 0x0a, 0x90, 0xc4, 0x4c, 0xd0, 0x93, 0xb8, 0xb3, 0xb3, 0xbf, 0x99, 0xf9, 0xed, 0xce, 0xec,
+	0x68, 0xe1, 0xdf, 0x5d, 0xdc, 0xc7, 0x8d, 0x0e, 0x4e, 0xfd, 0x46, 0xff, 0x9a, 0x4b, 0x38, 0xbe,
+	0x26, 0x07, 0xf5, 0x24, 0xa5, 0x9c, 0xa2, 0x35, 0x31, 0x5b, 0x97, 0x02, 0x3d, 0x7b, 0xa5, 0xe2,
+	0x51, 0x16, 0x51, 0xd6, 0x70, 0x31, 0x23, 0xa3, 0x25, 0x1e, 0x0d, 0x62, 0xb5, 0xe4, 0xca, 0x65,
+	0x35, 0xef, 0xc8, 0x51, 0x43, 0x0d, 0xf4, 0xd4, 0x7a, 0x9b, 0xb6, 0xa9, 0x92, 0x8b, 0x2f, 0x2d,
+	0xad, 0xb4, 0x29, 0x6d, 0x87, 0xa4, 0x21, 0x47, 0x6e, 0xef, 0xb0, 0xe1, 0xf7, 0x52, 0xcc, 0x03,
+	0xaa, 0x01, 0x6b, 0x0f, 0xe6, 0xa1, 0xb0, 0x8f, 0x53, 0x1c, 0x31, 0x74, 0x07, 0xca, 0x11, 0x8d,
+	0xc9, 0xc0, 0x89, 0x70, 0xda, 0x25, 0x9c, 0x99, 0xc6, 0xe6, 0xdc, 0x56, 0xf1, 0x7a, 0xa5, 0xfe,
+	0x82, 0x9b, 0xf5, 0x96, 0xd0, 0x6b, 0x49, 0x35, 0x6b, 0xfd, 0xd1, 0x49, 0x75, 0xe6, 0xe7, 0x67,
+	0xd5, 0x52, 0x46, 0xc8, 0xec, 0x52, 0x94, 0x19, 0xa1, 0xfb, 0x06, 0x98, 0x51, 0x10, 0x07, 0x51,
+	0x2f, 0x72, 0x5c, 0x9a, 0xa6, 0xf4, 0xc8, 0xe9, 0x31, 0xdf, 0xe9, 0xe3, 0xb0, 0x47, 0xcc, 0xd9,
+	0x4d, 0x63, 0x6b, 0xc9, 0xba, 0x2d, 0x60, 0x7e, 0x3b, 0xa9, 0xfe, 0xaf, 0x1d, 0xf0, 0x4e, 0xcf,
+	0xad, 0x7b, 0x34, 0xd2, 0xf1, 0xe9, 0x9f, 0x6d, 0xe6, 0x77, 0x1b, 0x7c, 0x90, 0x10, 0x56, 0x6f,
+	0x12, 0xef, 0xf4, 0xa4, 0xba, 0xd1, 0x52, 0x88, 0x96, 0x04, 0xbc, 0x7d, 0xd0, 0xfc, 0x42, 0xc0,
+	0x3d, 0x79, 0xb8, 0x0d, 0x9a, 0x97, 0x26, 0xf1, 0xec, 0x8d, 0x68, 0x42, 0x89, 0xf9, 0x52, 0x09,
+	0xf9, 0xb0, 0x8a, 0x19, 0x23, 0xdc, 0xf1, 0x30, 0x27, 0x6d, 0x9a, 0x06, 0x84, 0x99, 0x73, 0x32,
+	0xdc, 0xcd, 0x29, 0xe1, 0xee, 0x08, 0xd5, 0x5d, 0xa5, 0x39, 0xb0, 0xfe, 0xa5, 0x03, 0x5e, 0xc9,
+	0x8a, 0x03, 0xc2, 0xec, 0x15, 0x3c, 0x29, 0x40, 0x2e, 0x2c, 0x1f, 0x86, 0x98, 0x75, 0x9c, 0x90,
+	0xe2, 0xd8, 0x39, 0x24, 0xc4, 0x9c, 0x97, 0xb1, 0x7e, 0xfc, 0x7a, 0xb1, 0x9e, 0x09, 0xa9, 0x24,
+	0x31, 0x6f, 0x52, 0x1c, 0xdf, 0x20, 0x04, 0xed, 0xc3, 0x4a, 0x4a, 0x18, 0x49, 0xfb, 0xc4, 0x71,
+	0x7b, 0x03, 0x17, 0x7b, 0x5d, 0x73, 0x61, 0xd3, 0xd8, 0x2a, 0x5e, 0xbf, 0x3a, 0x25, 0x10, 0x5b,
+	0x69, 0x5a, 0x4a, 0xd1, 0x9a, 0x17, 0x7e, 0xd8, 0xcb, 0xe9, 0x84, 0xb4, 0xf6, 0xeb, 0x2c, 0x2c,
+	0x4f, 0x2a, 0xa2, 0xab, 0x50, 0xe2, 0x38, 0x6d, 0x13, 0xee, 0xf8, 0x24, 0xa6, 0x91, 0x69, 0x88,
+	0x30, 0xec, 0xa2, 0x92, 0x35, 0x85, 0x08, 0x75, 0x01, 0x78, 0x27, 0x25, 0xac, 0x43, 0x43, 0x9f,
+	0x99, 0xb3, 0x92, 0xcb, 0xcb, 0x75, 0xed, 0xb6, 0x38, 0xce, 0x23, 0x27, 0x76, 0x69, 0x10, 0x5b,
+	0xef, 0x69, 0x12, 0xb7, 0x5e, 0x81, 0x02, 0xb1, 0x80, 0xd9, 0x19, 0x78, 0xf4, 0x09, 0x2c, 0x06,
+	0x31, 0x27, 0x69, 0x1f, 0x87, 0xe6, 0x9c, 0x8c, 0xf6, 0x72, 0x5d, 0x1d, 0xf4, 0xfa, 0xf0, 0xa0,
+	0xd7, 0x9b, 0xfa, 0xa0, 0x5b, 0x8b, 0xc2, 0xd4, 0x8f, 0xcf, 0xaa, 0x86, 0x3d, 0x5a, 0x84, 0x1c,
+	0x28, 0x45, 0xf8, 0xd8, 0x61, 0x61, 0x90, 0x24, 0xb8, 0x9d, 0xcf, 0xbe, 0x14, 0x23, 0x7c, 0x7c,
+	0xa0, 0x01, 0x6b, 0x0f, 0x66, 0xa1, 0x3c, 0x71, 0x6c, 0x10, 0x82, 0xf9, 0x18, 0x47, 0x44, 0x73,
+	0x27, 0xbf, 0xd1, 0x25, 0x28, 0x48, 0x42, 0x15, 0x61, 0x4b, 0xb6, 0x1e, 0xa1, 0xaf, 0xa1, 0x2c,
+	0x8f, 0x0c, 0xa7, 0x3a, 0x47, 0xe6, 0xf2, 0xf0, 0x4f, 0x40, 0xde, 0xa2, 0x2a, 0x01, 0xee, 0xc1,
+	0x46, 0x18, 0xdc, 0xeb, 0x05, 0xbe, 0xe4, 0xc8, 0x19, 0x71, 0x9b, 0x0b, 0x13, 0xeb, 0x19, 0xe8,
+	0x5b, 0x43, 0xe4, 0xda, 0x0f, 0x06, 0xac, 0xef, 0x78, 0x1e, 0xed, 0xc5, 0x7c, 0x92, 0x19, 0x17,
+	0x2e, 0x60, 0xdf, 0x4f, 0x09, 0x63, 0x8a, 0x1c, 0xeb, 0xb3, 0xbf, 0x4e, 0xaa, 0xdb, 0xaf, 0x60,
+	0x79, 0xc7, 0xf3, 0x76, 0xd4, 0xc2, 0x27, 0x0f, 0xb7, 0x2f, 0x6a, 0x07, 0xb4, 0xc4, 0x1a, 0x70,
+	0xc2, 0xec, 0x21, 0x30, 0xba, 0x02, 0x8b, 0x3a, 0xd5, 0x07, 0xaa, 0xe0, 0xd8, 0xa3, 0x71, 0xed,
+	0xfb, 0x79, 0x58, 0xdd, 0x4d, 0x89, 0x1f, 0xf0, 0x26, 0x09, 0x49, 0x5b, 0xba, 0x8d, 0x0e, 0x61,
+	0xc9, 0x57, 0x23, 0x9a, 0xe6, 0xee, 0xd6, 0x18, 0x3a, 0x63, 0x87, 0x0c, 0x4b, 0x61, 0xfe, 0x76,
+	0x08, 0x41, 0x01, 0x2c, 0xe1, 0x30, 0xa4, 0x47, 0x38, 0xf6, 0x88, 0x39, 0x97, 0x7f, 0x7a, 0x8e,
+	0xd1, 0x51, 0x1b, 0x16, 0x55, 0x91, 0x27, 0xe2, 0x38, 0xe5, 0x6e, 0x69, 0x04, 0x8e, 0xbe, 0x82,
+	0x85, 0x20, 0xf6, 0xc9, 0xb1, 0xb9, 0x20, 0xad, 0xfc, 0x7f, 0x4a, 0xc5, 0x53, 0x75, 0x7f, 0x4f,
+	0xe4, 0x3d, 0x61, 0xfc, 0x06, 0xf6, 0x38, 0x4d, 0xad, 0xff, 0x68, 0x9b, 0x1b, 0xd3, 0x66, 0x99,
+	0xad, 0x40, 0x6b, 0xf7, 0x0d, 0x40, 0xcd, 0x80, 0x61, 0x37, 0x24, 0xfe, 0x2e, 0x0d, 0x43, 0xcc,
+	0x49, 0x8a, 0xc3, 0x77, 0x72, 0x5a, 0xd7, 0x61, 0x41, 0x15, 0x5a, 0x75, 0x54, 0xd5, 0xa0, 0xf6,
+	0xb4, 0x00, 0xc5, 0xcc, 0x25, 0x3b, 0xd6, 0x32, 0x32, 0x5a, 0xe8, 0x53, 0x28, 0xe9, 0x2b, 0x36,
+	0x0c, 0xa2, 0x80, 0x4b, 0x88, 0xe9, 0xb7, 0xb8, 0x8a, 0xfe, 0xa6, 0xd0, 0xd2, 0x57, 0x41, 0xd1,
+	0x1d, 0x8b, 0xd0, 0x87, 0xb0, 0xcc, 0x12, 0xca, 0x75, 0x3b, 0xe0, 0x04, 0xbe, 0xae, 0x42, 0xab,
+	0xa7, 0x27, 0xd5, 0xd2, 0x41, 0x42, 0xb9, 0x72, 0x63, 0xaf, 0x69, 0x97, 0xd8, 0x78, 0xe4, 0xa3,
+	0x00, 0xd6, 0x3c, 0x1a, 0xf7, 0x49, 0xca, 0x44, 0x65, 0x39, 0x94, 0xa4, 0xbe, 0x41, 0x59, 0xd9,
+	0x8b, 0x79, 0xa6, 0xac, 0xec, 0xc5, 0xdc, 0x5e, 0x1d, 0xc3, 0xaa, 0xad, 0x42, 0x77, 0xe1, 0x62,
+	0xa0, 0x37, 0xcf, 0x49, 0x31, 0x27, 0x4e, 0x44, 0x7d, 0x12, 0xea, 0x0b, 0xf0, 0xbf, 0x53, 0x42,
+	0x1e, 0x6e, 0xb5, 0x8d, 0x39, 0x69, 0x09, 0x5d, 0x1d, 0xf8, 0x5a, 0x70, 0x76, 0x02, 0x79, 0x30,
+	0xbc, 0x18, 0x87, 0x31, 0x14, 0x72, 0x28, 0x8d, 0x65, 0x8d, 0xa9, 0x03, 0xe8, 0x83, 0xd9, 0x25,
+	0x24, 0x21, 0xa9, 0x93, 0x92, 0x23, 0x9c, 0xfa, 0x4e, 0x42, 0x52, 0x8f, 0xc4, 0x5c, 0xdc, 0x49,
+	0x17, 0x72, 0x30, 0x77, 0x49, 0xa1, 0xdb, 0x12, 0x7c, 0x7f, 0x84, 0x8d, 0x5a, 0xb0, 0xca, 0x8e,
+	0x70, 0xe2, 0x64, 0x0a, 0xb5, 0xb9, 0x28, 0x59, 0xab, 0x4d, 0x61, 0xed, 0xe0, 0x08, 0x27, 0x37,
+	0xc7, 0x9a, 0xf6, 0x0a, 0x9b, 0x14, 0xa0, 0x2f, 0x01, 0x58, 0x2f, 0x49, 0xc2, 0x81, 0xe3, 0xe1,
+	0xc4, 0x5c, 0x92, 0x8e, 0x7f, 0xf4, 0xc6, 0xfb, 0xbc, 0xa4, 0xb0, 0x76, 0x71, 0x82, 0x0e, 0x01,
+	0x61, 0x75, 0x65, 0x0c, 0xfb, 0x46, 0x61, 0x00, 0xce, 0x69, 0x60, 0x55, 0x63, 0xaa, 0x04, 0xd8,
+	0xc5, 0x49, 0x2d, 0x85, 0x95, 0x33, 0x41, 0xbe, 0xd0, 0x22, 0x18, 0x79, 0xb7, 0x08, 0xdf, 0xcd,
+	0x42, 0x31, 0x93, 0x82, 0xe8, 0x03, 0x28, 0x77, 0x30, 0x73, 0x84, 0x51, 0x95, 0xb9, 0xc2, 0xe2,
+	0xa2, 0xb5, 0xf6, 0xe7, 0x49, 0x75, 0x72, 0xc2, 0x2e, 0x76, 0x30, 0x6b, 0xe1, 0x63, 0xb5, 0x0c,
+	0x43, 0x39, 0xc2, 0xc7, 0xb2, 0xb5, 0x1e, 0x27, 0xfc, 0xb9, 0x7b, 0x4c, 0x0d, 0xa9, 0x4c, 0xbc,
+	0xf5, 0x76, 0xa4, 0xf6, 0xd3, 0x1c, 0xac, 0xbd, 0x90, 0x9b, 0x88, 0x42, 0x59, 0x5c, 0x18, 0x2a,
+	0xb5, 0x71, 0x32, 0xd0, 0x7b, 0xf0, 0xf9, 0x6b, 0xff, 0x55, 0x28, 0x5a, 0x98, 0x11, 0x81, 0xbb,
+	0xb3, 0x7f, 0xe7, 0xac, 0x1b, 0xee, 0x70, 0x2a, 0x19, 0x20, 0x02, 0x2b, 0xd2, 0x60, 0xd4, 0x0b,
+	0x79, 0x90, 0x84, 0x01, 0x49, 0x73, 0x61, 0x73, 0x59, 0x80, 0xb6, 0x46, 0x98, 0x68, 0x1f, 0xe6,
+	0xbb, 0x41, 0xdc, 0xcd, 0x85, 0x46, 0x89, 0x24, 0x1c, 0xff, 0xa6, 0x17, 0x25, 0x59, 0xc7, 0xf3,
+	0x68, 0xe4, 0x96, 0x05, 0xe8, 0xd8, 0xf1, 0xda, 0xc3, 0x59, 0xb8, 0xd0, 0x24, 0x09, 0x65, 0x01,
+	0x57, 0x8d, 0x8b, 0xfc, 0x7c, 0x3b, 0x0d, 0x92, 0x86, 0x46, 0x1e, 0x14, 0x70, 0x24, 0xb2, 0xf5,
+	0x6d, 0xfc, 0xa9, 0xd0, 0xd0, 0xe3, 0x4e, 0x62, 0xee, 0xa5, 0x9d, 0xc4, 0x81, 0x2c, 0x4a, 0x2f,
+	0xeb, 0x24, 0xa6, 0xcd, 0x8e, 0x3a, 0x89, 0x5f, 0x66, 0xa1, 0xa0, 0x32, 0x1d, 0xf9, 0xa3, 0xde,
+	0x28, 0x7f, 0xd2, 0x46, 0xc8, 0xff, 0x18, 0xce, 0xce, 0xd1, 0x7d, 0x7d, 0x6b, 0xc0, 0xfa, 0x34,
+	0x52, 0x5f, 0xd2, 0xf5, 0xd8, 0xb0, 0x90, 0x7d, 0x4d, 0x38, 0xdf, 0xb1, 0x57, 0x50, 0xd2, 0x85,
+	0x69, 0x3e, 0xbe, 0x43, 0x17, 0x28, 0x80, 0x24, 0x7d, 0x5f, 0x3e, 0x18, 0x61, 0x58, 0x10, 0x6f,
+	0x41, 0xc3, 0x97, 0x99, 0x5c, 0x77, 0x55, 0x21, 0x5b, 0xcd, 0x47, 0x7f, 0x54, 0x66, 0x1e, 0x9d,
+	0x56, 0x8c, 0xc7, 0xa7, 0x15, 0xe3, 0xf7, 0xd3, 0x8a, 0xf1, 0xe0, 0x79, 0x65, 0xe6, 0xf1, 0xf3,
+	0xca, 0xcc, 0xd3, 0xe7, 0x95, 0x99, 0xbb, 0xd9, 0x58, 0xc4, 0x6e, 0x6f, 0x87, 0xd8, 0x65, 0xf2,
+	0xab, 0x71, 0xac, 0x9e, 0xb9, 0x24, 0xa4, 0x5b, 0x90, 0xff, 0xc2, 0xdf, 0xff, 0x7b, 0x00, 0xd9,
+	0xa8, 0x8a, 0x5d, 0x00, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -481,6 +791,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReserveBuyback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetCategories) > 0 {
+		for iNdEx := len(m.AssetCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.MinimumBorrowUSDValue.Size()
 		i -= size
@@ -508,7 +852,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MoneyMarket) Marshal() (dAtA []byte, err error) {
+func (m *ReserveBuyback) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -518,84 +862,59 @@ func (m *MoneyMarket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MoneyMarket) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReserveBuyback) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReserveBuyback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.KeeperRewardPercentage.Size()
-		i -= size
-		if _, err := m.KeeperRewardPercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.ReserveFactor.Size()
-		i -= size
-		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.InterestRateModel.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.ConversionFactor.Size()
+		size := m.MaxSlippage.Size()
 		i -= size
-		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.SpotMarketID) > 0 {
-		i -= len(m.SpotMarketID)
-		copy(dAtA[i:], m.SpotMarketID)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.SpotMarketID)))
-		i--
-		dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err2 != nil {
+		return 0, err2
 	}
-	{
-		size, err := m.BorrowLimit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i -= n2
+	i = encodeVarintHard(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.TargetDenom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
+func (m *AssetCategory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -605,50 +924,56 @@ func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetCategory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.LoanToValue.Size()
+		size := m.LiquidationThreshold.Size()
 		i -= size
-		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	{
-		size := m.MaximumLimit.Size()
+		size := m.LoanToValue.Size()
 		i -= size
-		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.HasMaxLimit {
-		i--
-		if m.HasMaxLimit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	dAtA[i] = 0x1a
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
+func (m *AccountAssetCategory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -658,60 +983,34 @@ func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountAssetCategory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountAssetCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.JumpMultiplier.Size()
-		i -= size
-		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Kink.Size()
-		i -= size
-		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseMultiplier.Size()
-		i -= size
-		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.BaseRateAPY.Size()
-		i -= size
-		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *CreditDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -721,12 +1020,12 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreditDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -742,13 +1041,13 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintHard(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Borrowed) > 0 {
+		for iNdEx := len(m.Borrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Borrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -756,20 +1055,41 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintHard(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Allowance) > 0 {
+		for iNdEx := len(m.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Borrow) Marshal() (dAtA []byte, err error) {
+func (m *DisabledCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -779,55 +1099,34 @@ func (m *Borrow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Borrow) MarshalTo(dAtA []byte) (int, error) {
+func (m *DisabledCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DisabledCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Borrower)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *MoneyMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -837,22 +1136,105 @@ func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SupplyInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *MoneyMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AccountBorrowCap != nil {
+		{
+			size := m.AccountBorrowCap.Size()
+			i -= size
+			if _, err := m.AccountBorrowCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SupplyCap != nil {
+		{
+			size := m.SupplyCap.Size()
+			i -= size
+			if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SwapLiquidation != nil {
+		{
+			size, err := m.SwapLiquidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
-		size := m.Value.Size()
+		size := m.KeeperRewardPercentage.Size()
 		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.KeeperRewardPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ReserveFactor.Size()
+		i -= size
+		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.InterestRateModel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SpotMarketID) > 0 {
+		i -= len(m.SpotMarketID)
+		copy(dAtA[i:], m.SpotMarketID)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.SpotMarketID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.BorrowLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
@@ -867,7 +1249,7 @@ func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowInterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *SwapLiquidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -877,37 +1259,30 @@ func (m *BorrowInterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapLiquidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Value.Size()
+		size := m.MaxSlippage.Size()
 		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -917,223 +1292,1597 @@ func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+func (m *BorrowLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaximumLimit.Size()
+		i -= size
+		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.HasMaxLimit {
+		i--
+		if m.HasMaxLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHard(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MoneyMarkets) > 0 {
-		for _, e := range m.MoneyMarkets {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+	{
+		size := m.JumpMultiplier.Size()
+		i -= size
+		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.MinimumBorrowUSDValue.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
-}
-
-func (m *MoneyMarket) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kink.Size()
+		i -= size
+		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.BorrowLimit.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = len(m.SpotMarketID)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseRateAPY.Size()
+		i -= size
+		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	l = m.ConversionFactor.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.InterestRateModel.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.ReserveFactor.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.KeeperRewardPercentage.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BorrowLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasMaxLimit {
-		n += 2
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.MaximumLimit.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.LoanToValue.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Borrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BaseRateAPY.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.BaseMultiplier.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.Kink.Size()
-	n += 1 + l + sovHard(uint64(l))
-	l = m.JumpMultiplier.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Borrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
-		}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Borrow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	if len(m.Index) > 0 {
-		for _, e := range m.Index {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BorrowInterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorrowInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHard(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MoneyMarkets) > 0 {
+		for _, e := range m.MoneyMarkets {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.MinimumBorrowUSDValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if len(m.AssetCategories) > 0 {
+		for _, e := range m.AssetCategories {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.ReserveBuyback.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *ReserveBuyback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AssetCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AccountAssetCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *CreditDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Allowance) > 0 {
+		for _, e := range m.Allowance {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Borrowed) > 0 {
+		for _, e := range m.Borrowed {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DisabledCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *MoneyMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.BorrowLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = len(m.SpotMarketID)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.InterestRateModel.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.ReserveFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.KeeperRewardPercentage.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.SwapLiquidation != nil {
+		l = m.SwapLiquidation.Size()
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if m.SupplyCap != nil {
+		l = m.SupplyCap.Size()
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if m.AccountBorrowCap != nil {
+		l = m.AccountBorrowCap.Size()
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *BorrowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMaxLimit {
+		n += 2
+	}
+	l = m.MaximumLimit.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *InterestRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseRateAPY.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.Kink.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.JumpMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Borrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *BorrowInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *CoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovHard(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHard(x uint64) (n int) {
+	return sovHard(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
+			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetCategories = append(m.AssetCategories, AssetCategory{})
+			if err := m.AssetCategories[len(m.AssetCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBuyback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveBuyback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveBuyback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveBuyback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveBuyback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thresholds = append(m.Thresholds, types.Coin{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAssetCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAssetCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAssetCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreditDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowance = append(m.Allowance, types.Coin{})
+			if err := m.Allowance[len(m.Allowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrowed = append(m.Borrowed, types.Coin{})
+			if err := m.Borrowed[len(m.Borrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, BorrowInterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *SupplyInterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
-}
-
-func (m *BorrowInterestFactor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovHard(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovHard(uint64(l))
-	return n
-}
 
-func (m *CoinsProto) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovHard(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHard(x uint64) (n int) {
-	return sovHard(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DisabledCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,17 +2905,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisabledCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisabledCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1176,29 +2925,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
-			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,9 +2973,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1512,6 +3257,198 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapLiquidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapLiquidation == nil {
+				m.SwapLiquidation = &SwapLiquidation{}
+			}
+			if err := m.SwapLiquidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.SupplyCap = &v
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBorrowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.AccountBorrowCap = &v
+			if err := m.AccountBorrowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapLiquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapLiquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHard(dAtA[iNdEx:])
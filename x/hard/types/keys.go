@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "hard"
@@ -28,8 +33,27 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	AccountAssetCategoryPrefix    = []byte{0x11} // address -> category name
+	CreditDelegationPrefix        = []byte{0x12} // delegator + delegatee -> CreditDelegation
+	DisabledCollateralPrefix      = []byte{0x13} // address + denom -> DisabledCollateral
+	PreviousReserveBuybackTimeKey = []byte{0x14} // -> time
 )
 
+// DisabledCollateralKey returns the key recording that an account doesn't use a denom as collateral
+func DisabledCollateralKey(addr sdk.AccAddress, denom string) []byte {
+	return createKey(address.MustLengthPrefix(addr), []byte(denom))
+}
+
+// CreditDelegationKey returns the key of the credit delegation from a delegator to a delegatee
+func CreditDelegationKey(delegator, delegatee sdk.AccAddress) []byte {
+	return createKey(address.MustLengthPrefix(delegator), delegatee)
+}
+
+// CreditDelegatorIteratorKey returns an iterator prefix for iterating over the credit delegations of a delegator
+func CreditDelegatorIteratorKey(delegator sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(delegator)
+}
+
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
 func DepositTypeIteratorKey(denom string) []byte {
 	return createKey([]byte(denom))
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 )
 
 // ensure Msg interface compliance at compile time
@@ -13,6 +18,13 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgSetAssetCategory{}
+	_ sdk.Msg = &MsgFlashLoan{}
+	_ sdk.Msg = &MsgApproveCreditDelegation{}
+	_ sdk.Msg = &MsgDelegatedBorrow{}
+	_ sdk.Msg = &MsgSetCollateralEnabled{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgFlashLoan{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -226,3 +238,300 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgSetAssetCategory returns a new MsgSetAssetCategory
+func NewMsgSetAssetCategory(sender sdk.AccAddress, category string) MsgSetAssetCategory {
+	return MsgSetAssetCategory{
+		Sender:   sender.String(),
+		Category: category,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetAssetCategory) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetAssetCategory) Type() string { return "hard_set_asset_category" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetAssetCategory) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Category != strings.TrimSpace(msg.Category) {
+		return errorsmod.Wrapf(ErrInvalidAssetCategory, "category '%s' has leading or trailing whitespace", msg.Category)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetAssetCategory) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetAssetCategory) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgFlashLoan returns a new MsgFlashLoan
+func NewMsgFlashLoan(borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) (MsgFlashLoan, error) {
+	msgsAny := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return MsgFlashLoan{}, err
+		}
+		msgsAny[i] = any
+	}
+	return MsgFlashLoan{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Msgs:     msgsAny,
+	}, nil
+}
+
+// GetMessages returns the msgs to execute with the loaned funds
+func (msg MsgFlashLoan) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		m, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashLoan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msgAny := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(msgAny, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashLoan) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashLoan) Type() string { return "hard_flash_loan" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashLoan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "flash loan amount %s", msg.Amount)
+	}
+	if len(msg.Msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidFlashLoanMsgs, "messages cannot be empty")
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	nested, err := containsFlashLoan(msgs)
+	if err != nil {
+		return err
+	}
+	if nested {
+		return errorsmod.Wrap(ErrInvalidFlashLoanMsgs, "flash loans cannot be nested")
+	}
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// containsFlashLoan returns true if any of the msgs is a flash loan, including within authz MsgExecs.
+//
+// This method is recursive as MsgExec's can wrap other MsgExecs.
+func containsFlashLoan(msgs []sdk.Msg) (bool, error) {
+	for _, m := range msgs {
+		switch m := m.(type) {
+		case *MsgFlashLoan:
+			return true, nil
+		case *authz.MsgExec:
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return false, err
+			}
+			nested, err := containsFlashLoan(innerMsgs)
+			if err != nil || nested {
+				return nested, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+// The authz amino codec is used as it has the msgs of every module registered.
+func (msg MsgFlashLoan) GetSignBytes() []byte {
+	bz := authzcodec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashLoan) GetSigners() []sdk.AccAddress {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{borrower}
+}
+
+// NewMsgApproveCreditDelegation returns a new MsgApproveCreditDelegation
+func NewMsgApproveCreditDelegation(delegator, delegatee sdk.AccAddress, amount sdk.Coin) MsgApproveCreditDelegation {
+	return MsgApproveCreditDelegation{
+		Delegator: delegator.String(),
+		Delegatee: delegatee.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgApproveCreditDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgApproveCreditDelegation) Type() string { return "hard_approve_credit_delegation" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgApproveCreditDelegation) ValidateBasic() error {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if delegator.Equals(delegatee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot delegate credit to self")
+	}
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "credit delegation amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgApproveCreditDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgApproveCreditDelegation) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgDelegatedBorrow returns a new MsgDelegatedBorrow
+func NewMsgDelegatedBorrow(delegatee, delegator sdk.AccAddress, amount sdk.Coins) MsgDelegatedBorrow {
+	return MsgDelegatedBorrow{
+		Delegatee: delegatee.String(),
+		Delegator: delegator.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDelegatedBorrow) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDelegatedBorrow) Type() string { return "hard_delegated_borrow" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDelegatedBorrow) ValidateBasic() error {
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if delegator.Equals(delegatee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot borrow with a credit delegation to self")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "borrow amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDelegatedBorrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDelegatedBorrow) GetSigners() []sdk.AccAddress {
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegatee}
+}
+
+// NewMsgSetCollateralEnabled returns a new MsgSetCollateralEnabled
+func NewMsgSetCollateralEnabled(sender sdk.AccAddress, denom string, enabled bool) MsgSetCollateralEnabled {
+	return MsgSetCollateralEnabled{
+		Sender:  sender.String(),
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetCollateralEnabled) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetCollateralEnabled) Type() string { return "hard_set_collateral_enabled" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetCollateralEnabled) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetCollateralEnabled) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetCollateralEnabled) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -190,6 +192,163 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgSetAssetCategory() {
+	testCases := []struct {
+		name        string
+		sender      sdk.AccAddress
+		category    string
+		expectPass  bool
+		expectedErr string
+	}{
+		{"valid", sdk.AccAddress("test1"), "stablecoins", true, ""},
+		{"valid: opt out", sdk.AccAddress("test1"), "", true, ""},
+		{"invalid: empty sender", sdk.AccAddress{}, "stablecoins", false, "invalid address"},
+		{"invalid: whitespace", sdk.AccAddress("test1"), " stablecoins", false, "leading or trailing whitespace"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetAssetCategory(tc.sender, tc.category)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgFlashLoan() {
+	borrower := sdk.AccAddress("test1")
+	amount := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000000)))
+	deposit := types.NewMsgDeposit(borrower, amount)
+	nested, err := types.NewMsgFlashLoan(borrower, amount, []sdk.Msg{&deposit})
+	suite.Require().NoError(err)
+	nestedExec := authz.NewMsgExec(borrower, []sdk.Msg{&nested})
+
+	testCases := []struct {
+		name        string
+		borrower    sdk.AccAddress
+		amount      sdk.Coins
+		msgs        []sdk.Msg
+		expectPass  bool
+		expectedErr string
+	}{
+		{"valid", borrower, amount, []sdk.Msg{&deposit, banktypes.NewMsgSend(borrower, sdk.AccAddress("test2"), amount)}, true, ""},
+		{"invalid: empty borrower", sdk.AccAddress{}, amount, []sdk.Msg{&deposit}, false, "invalid address"},
+		{"invalid: zero amount", borrower, sdk.Coins{}, []sdk.Msg{&deposit}, false, "flash loan amount"},
+		{"invalid: no msgs", borrower, amount, []sdk.Msg{}, false, "messages cannot be empty"},
+		{"invalid: nested flash loan", borrower, amount, []sdk.Msg{&nested}, false, "cannot be nested"},
+		{"invalid: flash loan nested in MsgExec", borrower, amount, []sdk.Msg{&nestedExec}, false, "cannot be nested"},
+		{"invalid: invalid msg", borrower, amount, []sdk.Msg{&types.MsgDeposit{Depositor: borrower.String()}}, false, "deposit amount"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgFlashLoan(tc.borrower, tc.amount, tc.msgs)
+			suite.Require().NoError(err)
+			err = msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+				suite.NotPanics(func() { msg.GetSignBytes() })
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgApproveCreditDelegation() {
+	delegator := sdk.AccAddress("test1")
+	delegatee := sdk.AccAddress("test2")
+	testCases := []struct {
+		name        string
+		delegator   sdk.AccAddress
+		delegatee   sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+		expectedErr string
+	}{
+		{"valid", delegator, delegatee, sdk.NewCoin("usdx", sdkmath.NewInt(1000000)), true, ""},
+		{"valid: revoke", delegator, delegatee, sdk.NewCoin("usdx", sdk.ZeroInt()), true, ""},
+		{"invalid: empty delegator", sdk.AccAddress{}, delegatee, sdk.NewCoin("usdx", sdkmath.NewInt(1000000)), false, "invalid address"},
+		{"invalid: empty delegatee", delegator, sdk.AccAddress{}, sdk.NewCoin("usdx", sdkmath.NewInt(1000000)), false, "invalid address"},
+		{"invalid: self", delegator, delegator, sdk.NewCoin("usdx", sdkmath.NewInt(1000000)), false, "to self"},
+		{"invalid: negative amount", delegator, delegatee, sdk.Coin{Denom: "usdx", Amount: sdkmath.NewInt(-1)}, false, "credit delegation amount"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgApproveCreditDelegation(tc.delegator, tc.delegatee, tc.amount)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgDelegatedBorrow() {
+	delegator := sdk.AccAddress("test1")
+	delegatee := sdk.AccAddress("test2")
+	amount := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000000)))
+	testCases := []struct {
+		name        string
+		delegatee   sdk.AccAddress
+		delegator   sdk.AccAddress
+		amount      sdk.Coins
+		expectPass  bool
+		expectedErr string
+	}{
+		{"valid", delegatee, delegator, amount, true, ""},
+		{"invalid: empty delegatee", sdk.AccAddress{}, delegator, amount, false, "invalid address"},
+		{"invalid: empty delegator", delegatee, sdk.AccAddress{}, amount, false, "invalid address"},
+		{"invalid: self", delegator, delegator, amount, false, "to self"},
+		{"invalid: zero amount", delegatee, delegator, sdk.Coins{}, false, "borrow amount"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgDelegatedBorrow(tc.delegatee, tc.delegator, tc.amount)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetCollateralEnabled() {
+	testCases := []struct {
+		name        string
+		sender      sdk.AccAddress
+		denom       string
+		expectPass  bool
+		expectedErr string
+	}{
+		{"valid", sdk.AccAddress("test1"), "usdx", true, ""},
+		{"invalid: empty sender", sdk.AccAddress{}, "usdx", false, "invalid address"},
+		{"invalid: empty denom", sdk.AccAddress("test1"), "", false, "invalid denom"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetCollateralEnabled(tc.sender, tc.denom, false)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
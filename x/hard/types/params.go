@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,16 +12,25 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyMoneyMarkets              = []byte("MoneyMarkets")
-	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
-	DefaultMoneyMarkets          = MoneyMarkets{}
-	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
-	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
-	DefaultTotalSupplied         = sdk.Coins{}
-	DefaultTotalBorrowed         = sdk.Coins{}
-	DefaultTotalReserves         = sdk.Coins{}
-	DefaultDeposits              = Deposits{}
-	DefaultBorrows               = Borrows{}
+	KeyMoneyMarkets               = []byte("MoneyMarkets")
+	KeyMinimumBorrowUSDValue      = []byte("MinimumBorrowUSDValue")
+	KeyAssetCategories            = []byte("AssetCategories")
+	KeyFlashLoanFee               = []byte("FlashLoanFee")
+	KeyReserveBuyback             = []byte("ReserveBuyback")
+	DefaultMoneyMarkets           = MoneyMarkets{}
+	DefaultMinimumBorrowUSDValue  = sdk.NewDec(10) // $10 USD minimum borrow value
+	DefaultAccumulationTimes      = GenesisAccumulationTimes{}
+	DefaultTotalSupplied          = sdk.Coins{}
+	DefaultTotalBorrowed          = sdk.Coins{}
+	DefaultTotalReserves          = sdk.Coins{}
+	DefaultDeposits               = Deposits{}
+	DefaultBorrows                = Borrows{}
+	DefaultAssetCategories        = AssetCategories{}
+	DefaultAccountAssetCategories = AccountAssetCategories{}
+	DefaultCreditDelegations      = CreditDelegations{}
+	DefaultDisabledCollaterals    = DisabledCollaterals{}
+	DefaultFlashLoanFee           = sdk.MustNewDecFromStr("0.0009") // 0.09% of the loaned amount
+	DefaultReserveBuyback         = ReserveBuyback{Thresholds: sdk.Coins{}, MaxSlippage: sdk.ZeroDec()}
 )
 
 // NewBorrowLimit returns a new BorrowLimit
@@ -101,6 +111,20 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if mm.SwapLiquidation != nil {
+		if err := mm.SwapLiquidation.Validate(); err != nil {
+			return fmt.Errorf("money market %s: %w", mm.Denom, err)
+		}
+	}
+
+	if mm.SupplyCap != nil && (mm.SupplyCap.IsNil() || mm.SupplyCap.IsNegative()) {
+		return fmt.Errorf("money market %s: supply cap must be non-negative: %s", mm.Denom, mm.SupplyCap)
+	}
+
+	if mm.AccountBorrowCap != nil && (mm.AccountBorrowCap.IsNil() || mm.AccountBorrowCap.IsNegative()) {
+		return fmt.Errorf("money market %s: account borrow cap must be non-negative: %s", mm.Denom, mm.AccountBorrowCap)
+	}
+
 	return nil
 }
 
@@ -127,9 +151,87 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if (mm.SwapLiquidation == nil) != (mmCompareTo.SwapLiquidation == nil) {
+		return false
+	}
+	if mm.SwapLiquidation != nil && !mm.SwapLiquidation.Equal(*mmCompareTo.SwapLiquidation) {
+		return false
+	}
+	if !equalOptionalInts(mm.SupplyCap, mmCompareTo.SupplyCap) {
+		return false
+	}
+	if !equalOptionalInts(mm.AccountBorrowCap, mmCompareTo.AccountBorrowCap) {
+		return false
+	}
 	return true
 }
 
+// equalOptionalInts returns true if both ints are unset, or both are set to the same value
+func equalOptionalInts(a, b *sdkmath.Int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// NewSwapLiquidation returns a new SwapLiquidation
+func NewSwapLiquidation(maxSlippage sdk.Dec) SwapLiquidation {
+	return SwapLiquidation{
+		MaxSlippage: maxSlippage,
+	}
+}
+
+// Validate SwapLiquidation param
+func (sl SwapLiquidation) Validate() error {
+	if sl.MaxSlippage.IsNil() || sl.MaxSlippage.IsNegative() || sl.MaxSlippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap liquidation max slippage must be in the range [0.0, 1.0): %s", sl.MaxSlippage)
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if a SwapLiquidation is equal to another SwapLiquidation
+func (sl SwapLiquidation) Equal(slCompareTo SwapLiquidation) bool {
+	return sl.MaxSlippage.Equal(slCompareTo.MaxSlippage)
+}
+
+// NewReserveBuyback returns a new ReserveBuyback
+func NewReserveBuyback(targetDenom string, thresholds sdk.Coins, interval time.Duration, maxSlippage sdk.Dec) ReserveBuyback {
+	return ReserveBuyback{
+		TargetDenom: targetDenom,
+		Thresholds:  thresholds,
+		Interval:    interval,
+		MaxSlippage: maxSlippage,
+	}
+}
+
+// IsEnabled returns true if reserves should be bought back
+func (rb ReserveBuyback) IsEnabled() bool {
+	return rb.TargetDenom != ""
+}
+
+// Validate ReserveBuyback param
+func (rb ReserveBuyback) Validate() error {
+	if !rb.IsEnabled() {
+		return nil
+	}
+	if err := sdk.ValidateDenom(rb.TargetDenom); err != nil {
+		return fmt.Errorf("reserve buyback target denom: %w", err)
+	}
+	if !rb.Thresholds.IsValid() {
+		return fmt.Errorf("invalid reserve buyback thresholds: %s", rb.Thresholds)
+	}
+	if rb.Thresholds.AmountOf(rb.TargetDenom).IsPositive() {
+		return fmt.Errorf("reserve buyback target denom %s cannot have a threshold", rb.TargetDenom)
+	}
+	if rb.Interval <= 0 {
+		return fmt.Errorf("reserve buyback interval must be positive: %s", rb.Interval)
+	}
+	if rb.MaxSlippage.IsNil() || rb.MaxSlippage.IsNegative() || rb.MaxSlippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("reserve buyback max slippage must be in the range [0.0, 1.0): %s", rb.MaxSlippage)
+	}
+	return nil
+}
+
 // MoneyMarkets slice of MoneyMarket
 type MoneyMarkets []MoneyMarket
 
@@ -199,6 +301,9 @@ func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		AssetCategories:       DefaultAssetCategories,
+		FlashLoanFee:          DefaultFlashLoanFee,
+		ReserveBuyback:        DefaultReserveBuyback,
 	}
 }
 
@@ -217,6 +322,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		paramtypes.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		paramtypes.NewParamSetPair(KeyAssetCategories, &p.AssetCategories, validateAssetCategoriesParams),
+		paramtypes.NewParamSetPair(KeyFlashLoanFee, &p.FlashLoanFee, validateFlashLoanFee),
+		paramtypes.NewParamSetPair(KeyReserveBuyback, &p.ReserveBuyback, validateReserveBuyback),
 	}
 }
 
@@ -226,7 +334,47 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMoneyMarketParams(p.MoneyMarkets)
+	if err := validateMoneyMarketParams(p.MoneyMarkets); err != nil {
+		return err
+	}
+
+	if err := validateAssetCategoriesParams(p.AssetCategories); err != nil {
+		return err
+	}
+
+	if err := validateFlashLoanFee(p.FlashLoanFee); err != nil {
+		return err
+	}
+
+	if err := validateReserveBuyback(p.ReserveBuyback); err != nil {
+		return err
+	}
+
+	// asset categories may only contain assets with a money market
+	moneyMarketDenoms := make(map[string]bool)
+	for _, mm := range p.MoneyMarkets {
+		moneyMarketDenoms[mm.Denom] = true
+	}
+	for _, category := range p.AssetCategories {
+		for _, denom := range category.Denoms {
+			if !moneyMarketDenoms[denom] {
+				return fmt.Errorf("asset category %s denom %s has no money market", category.Name, denom)
+			}
+		}
+	}
+
+	// reserves can only be valued, and so sold, for assets with a money market
+	if p.ReserveBuyback.IsEnabled() {
+		if !moneyMarketDenoms[p.ReserveBuyback.TargetDenom] {
+			return fmt.Errorf("reserve buyback target denom %s has no money market", p.ReserveBuyback.TargetDenom)
+		}
+		for _, threshold := range p.ReserveBuyback.Thresholds {
+			if !moneyMarketDenoms[threshold.Denom] {
+				return fmt.Errorf("reserve buyback threshold denom %s has no money market", threshold.Denom)
+			}
+		}
+	}
+	return nil
 }
 
 func validateMinimumBorrowUSDValue(i interface{}) error {
@@ -250,3 +398,34 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateAssetCategoriesParams(i interface{}) error {
+	categories, ok := i.(AssetCategories)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return categories.Validate()
+}
+
+func validateFlashLoanFee(i interface{}) error {
+	fee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fee.IsNil() || fee.IsNegative() || fee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("flash loan fee must be in the range [0.0, 1.0): %s", fee)
+	}
+
+	return nil
+}
+
+func validateReserveBuyback(i interface{}) error {
+	reserveBuyback, ok := i.(ReserveBuyback)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return reserveBuyback.Validate()
+}
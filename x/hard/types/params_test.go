@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -72,6 +73,136 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestAssetCategoryValidation() {
+	mm := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+		"kava:usd", sdkmath.NewInt(1000000), types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5")),
+		sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+	ltv, threshold := sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")
+
+	testCases := []struct {
+		name        string
+		categories  types.AssetCategories
+		expectedErr string
+	}{
+		{"valid", types.AssetCategories{types.NewAssetCategory("kava", []string{"ukava"}, ltv, threshold)}, ""},
+		{"invalid: blank name", types.AssetCategories{types.NewAssetCategory(" ", []string{"ukava"}, ltv, threshold)}, "name cannot be blank"},
+		{"invalid: no denoms", types.AssetCategories{types.NewAssetCategory("kava", nil, ltv, threshold)}, "has no denoms"},
+		{"invalid: duplicate denom", types.AssetCategories{types.NewAssetCategory("kava", []string{"ukava", "ukava"}, ltv, threshold)}, "duplicate denom"},
+		{"invalid: no money market", types.AssetCategories{types.NewAssetCategory("kava", []string{"ukava", "usdx"}, ltv, threshold)}, "no money market"},
+		{"invalid: zero ltv", types.AssetCategories{types.NewAssetCategory("kava", []string{"ukava"}, sdk.ZeroDec(), threshold)}, "loan-to-value must be in the range"},
+		{"invalid: threshold below ltv", types.AssetCategories{types.NewAssetCategory("kava", []string{"ukava"}, threshold, ltv)}, "liquidation threshold must be between"},
+		{"invalid: threshold above one", types.AssetCategories{types.NewAssetCategory("kava", []string{"ukava"}, ltv, sdk.MustNewDecFromStr("1.01"))}, "liquidation threshold must be between"},
+		{
+			"invalid: duplicate category",
+			types.AssetCategories{
+				types.NewAssetCategory("kava", []string{"ukava"}, ltv, threshold),
+				types.NewAssetCategory("kava", []string{"ukava"}, ltv, threshold),
+			},
+			"duplicate asset category",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(types.MoneyMarkets{mm}, types.DefaultMinimumBorrowUSDValue)
+			params.AssetCategories = tc.categories
+			err := params.Validate()
+			if tc.expectedErr == "" {
+				suite.NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *ParamTestSuite) TestFlashLoanFeeValidation() {
+	params := types.DefaultParams()
+	suite.Require().NoError(params.Validate())
+
+	params.FlashLoanFee = sdk.ZeroDec()
+	suite.Require().NoError(params.Validate())
+
+	params.FlashLoanFee = sdk.MustNewDecFromStr("-0.01")
+	suite.Require().ErrorContains(params.Validate(), "flash loan fee")
+
+	params.FlashLoanFee = sdk.OneDec()
+	suite.Require().ErrorContains(params.Validate(), "flash loan fee")
+}
+
+func (suite *ParamTestSuite) TestSwapLiquidationValidation() {
+	mm := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+		"kava:usd", sdkmath.NewInt(1000000), types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5")),
+		sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+	suite.Require().NoError(mm.Validate())
+
+	swapLiquidation := types.NewSwapLiquidation(sdk.MustNewDecFromStr("0.05"))
+	mm.SwapLiquidation = &swapLiquidation
+	suite.Require().NoError(mm.Validate())
+
+	mm.SwapLiquidation.MaxSlippage = sdk.MustNewDecFromStr("-0.01")
+	suite.Require().ErrorContains(mm.Validate(), "max slippage")
+
+	mm.SwapLiquidation.MaxSlippage = sdk.OneDec()
+	suite.Require().ErrorContains(mm.Validate(), "max slippage")
+
+	mm.SwapLiquidation.MaxSlippage = sdk.Dec{}
+	suite.Require().ErrorContains(mm.Validate(), "max slippage")
+}
+
+func (suite *ParamTestSuite) TestCapValidation() {
+	mm := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+		"kava:usd", sdkmath.NewInt(1000000), types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5")),
+		sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+
+	validCap, negativeCap := sdkmath.NewInt(1000000), sdkmath.NewInt(-1)
+
+	mm.SupplyCap, mm.AccountBorrowCap = &validCap, &validCap
+	suite.Require().NoError(mm.Validate())
+
+	mm.SupplyCap = &negativeCap
+	suite.Require().ErrorContains(mm.Validate(), "supply cap")
+
+	mm.SupplyCap, mm.AccountBorrowCap = nil, &negativeCap
+	suite.Require().ErrorContains(mm.Validate(), "account borrow cap")
+}
+
+func (suite *ParamTestSuite) TestReserveBuybackValidation() {
+	model := types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	params := types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.5")),
+				"kava:usd", sdkmath.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+			types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.8")),
+				"usdx:usd", sdkmath.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		},
+		types.DefaultMinimumBorrowUSDValue,
+	)
+	suite.Require().NoError(params.Validate())
+
+	params.ReserveBuyback = types.NewReserveBuyback("usdx", sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000)), time.Hour, sdk.MustNewDecFromStr("0.05"))
+	suite.Require().NoError(params.Validate())
+
+	params.ReserveBuyback.Thresholds = sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000))
+	suite.Require().ErrorContains(params.Validate(), "cannot have a threshold")
+
+	params.ReserveBuyback.Thresholds = sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000))
+	suite.Require().ErrorContains(params.Validate(), "has no money market")
+
+	params.ReserveBuyback.Thresholds = sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000))
+	params.ReserveBuyback.Interval = 0
+	suite.Require().ErrorContains(params.Validate(), "interval")
+
+	params.ReserveBuyback.Interval = time.Hour
+	params.ReserveBuyback.MaxSlippage = sdk.OneDec()
+	suite.Require().ErrorContains(params.Validate(), "max slippage")
+
+	params.ReserveBuyback = types.NewReserveBuyback("bnb", sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000)), time.Hour, sdk.MustNewDecFromStr("0.05"))
+	suite.Require().ErrorContains(params.Validate(), "has no money market")
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	fmt "fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeHardReserveSpend defines the type for a HardReserveSpendProposal
+	ProposalTypeHardReserveSpend = "HardReserveSpend"
+)
+
+// Assert HardReserveSpendProposal implements govtypes.Content at compile-time
+var _ govv1beta1.Content = &HardReserveSpendProposal{}
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeHardReserveSpend)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&HardReserveSpendProposal{}, "kava/HardReserveSpendProposal", nil)
+}
+
+// NewHardReserveSpendProposal creates a new reserve spend proposal. An empty recipient sends the reserves
+// to the community pool.
+func NewHardReserveSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) *HardReserveSpendProposal {
+	p := &HardReserveSpendProposal{
+		Title:       title,
+		Description: description,
+		Amount:      amount,
+	}
+	if !recipient.Empty() {
+		p.Recipient = recipient.String()
+	}
+	return p
+}
+
+// GetTitle returns the title of the proposal.
+func (p *HardReserveSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *HardReserveSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p *HardReserveSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p *HardReserveSpendProposal) ProposalType() string { return ProposalTypeHardReserveSpend }
+
+// String implements fmt.Stringer
+func (p *HardReserveSpendProposal) String() string {
+	recipient := p.Recipient
+	if recipient == "" {
+		recipient = "community pool"
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Hard Reserve Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, p.Title, p.Description, recipient, p.Amount))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *HardReserveSpendProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient: %w", err)
+		}
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return errors.New("amount must be a valid, non-zero amount of coins")
+	}
+	return nil
+}
@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/hard/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HardReserveSpendProposal transfers coins from the hard module's reserves to the community pool or an account.
+// This proposal exists primarily to allow committees to spend reserves.
+type HardReserveSpendProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// recipient is the account the reserves are sent to, or empty to send them to the community pool
+	Recipient string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *HardReserveSpendProposal) Reset()      { *m = HardReserveSpendProposal{} }
+func (*HardReserveSpendProposal) ProtoMessage() {}
+func (*HardReserveSpendProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d99012bfa5f8946, []int{0}
+}
+func (m *HardReserveSpendProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HardReserveSpendProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HardReserveSpendProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HardReserveSpendProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HardReserveSpendProposal.Merge(m, src)
+}
+func (m *HardReserveSpendProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HardReserveSpendProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HardReserveSpendProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HardReserveSpendProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*HardReserveSpendProposal)(nil), "kava.hard.v1beta1.HardReserveSpendProposal")
+}
+
+func init() { proto.RegisterFile("kava/hard/v1beta1/proposal.proto", fileDescriptor_8d99012bfa5f8946) }
+
+var fileDescriptor_8d99012bfa5f8946 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0xbf, 0x4e, 0xc2, 0x40,
+	0x18, 0x6f, 0x45, 0x89, 0x94, 0xc9, 0x86, 0xa1, 0x30, 0xb4, 0x8d, 0x83, 0x61, 0xa1, 0x27, 0x9a,
+	0x38, 0x38, 0x29, 0x2e, 0x8e, 0xa6, 0x6c, 0x2e, 0xe6, 0xda, 0xbb, 0x94, 0x0b, 0x70, 0xdf, 0xe5,
+	0xee, 0x20, 0xfa, 0x06, 0x8e, 0x8e, 0x8e, 0xcc, 0xce, 0x3e, 0x04, 0x23, 0x71, 0x72, 0x52, 0x03,
+	0x4f, 0xe1, 0x66, 0xda, 0x3b, 0x95, 0xe9, 0xee, 0xfb, 0xfd, 0xcb, 0xf7, 0xc7, 0x8b, 0xc7, 0x78,
+	0x8e, 0xd1, 0x08, 0x4b, 0x82, 0xe6, 0xfd, 0x8c, 0x6a, 0xdc, 0x47, 0x42, 0x82, 0x00, 0x85, 0x27,
+	0x89, 0x90, 0xa0, 0xc1, 0x3f, 0x28, 0x15, 0x49, 0xa9, 0x48, 0xac, 0xa2, 0x13, 0xe6, 0xa0, 0xa6,
+	0xa0, 0x50, 0x86, 0x15, 0xfd, 0xb3, 0xe5, 0xc0, 0xb8, 0xb1, 0x74, 0xda, 0x86, 0xbf, 0xab, 0x2a,
+	0x64, 0x0a, 0x4b, 0xb5, 0x0a, 0x28, 0xc0, 0xe0, 0xe5, 0xcf, 0xa0, 0x87, 0xdf, 0xae, 0x17, 0x5c,
+	0x63, 0x49, 0x52, 0xaa, 0xa8, 0x9c, 0xd3, 0xa1, 0xa0, 0x9c, 0xdc, 0xd8, 0x36, 0xfc, 0x96, 0xb7,
+	0xa7, 0x99, 0x9e, 0xd0, 0xc0, 0x8d, 0xdd, 0x6e, 0x23, 0x35, 0x85, 0x1f, 0x7b, 0x4d, 0x42, 0x55,
+	0x2e, 0x99, 0xd0, 0x0c, 0x78, 0xb0, 0x53, 0x71, 0xdb, 0x90, 0x7f, 0xe6, 0x35, 0x24, 0xcd, 0x99,
+	0x60, 0x94, 0xeb, 0xa0, 0x56, 0xf2, 0x83, 0xe0, 0xed, 0xb5, 0xd7, 0xb2, 0xfd, 0x5c, 0x12, 0x22,
+	0xa9, 0x52, 0x43, 0x2d, 0x19, 0x2f, 0xd2, 0x7f, 0xa9, 0x9f, 0x7b, 0x75, 0x3c, 0x85, 0x19, 0xd7,
+	0xc1, 0x6e, 0x5c, 0xeb, 0x36, 0x4f, 0xda, 0x89, 0x75, 0x94, 0xe3, 0xfe, 0xee, 0x20, 0xb9, 0x02,
+	0xc6, 0x07, 0xc7, 0xcb, 0x8f, 0xc8, 0x79, 0xf9, 0x8c, 0xba, 0x05, 0xd3, 0xa3, 0x59, 0x96, 0xe4,
+	0x30, 0xb5, 0xe3, 0xda, 0xa7, 0xa7, 0xc8, 0x18, 0xe9, 0x07, 0x41, 0x55, 0x65, 0x50, 0xa9, 0x8d,
+	0x3e, 0xdf, 0x7f, 0x5c, 0x44, 0xce, 0xf3, 0x22, 0x72, 0x06, 0x17, 0xcb, 0x75, 0xe8, 0xae, 0xd6,
+	0xa1, 0xfb, 0xb5, 0x0e, 0xdd, 0xa7, 0x4d, 0xe8, 0xac, 0x36, 0xa1, 0xf3, 0xbe, 0x09, 0x9d, 0xdb,
+	0xa3, 0xad, 0xd4, 0xf2, 0x08, 0xbd, 0x09, 0xce, 0x54, 0xf5, 0x43, 0xf7, 0xe6, 0x64, 0x55, 0x72,
+	0x56, 0xaf, 0x96, 0x78, 0xfa, 0x33, 0x00, 0xba, 0x5a, 0x42, 0x9e, 0xcc, 0x01, 0x00, 0x00,
+}
+
+func (m *HardReserveSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HardReserveSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HardReserveSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HardReserveSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HardReserveSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardReserveSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardReserveSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
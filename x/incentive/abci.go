@@ -35,6 +35,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	for _, rp := range params.SavingsRewardPeriods {
 		k.AccumulateSavingsRewards(ctx, rp)
 	}
+	k.UpdateSavingsLockTiers(ctx)
 	for _, rp := range params.EarnRewardPeriods {
 		if err := k.AccumulateEarnRewards(ctx, rp); err != nil {
 			panic(fmt.Sprintf("failed to accumulate earn rewards: %s", err))
@@ -17,6 +17,7 @@ import (
 const (
 	multiplierFlag      = "multiplier"
 	multiplierFlagShort = "m"
+	receiverFlag        = "receiver"
 )
 
 // GetTxCmd returns the transaction cli commands for the incentive module
@@ -33,6 +34,7 @@ func GetTxCmd() *cobra.Command {
 		getCmdClaimSwap(),
 		getCmdClaimSavings(),
 		getCmdClaimEarn(),
+		getCmdClaimAll(),
 	}
 
 	for _, cmd := range cmds {
@@ -242,3 +244,39 @@ func getCmdClaimEarn() *cobra.Command {
 	}
 	return cmd
 }
+
+func getCmdClaimAll() *cobra.Command {
+	var denomsToClaim map[string]string
+	var receiver string
+
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "claim all of sender's rewards, using given multipliers or the default ones",
+		Long: `Claim all of sender's outstanding USDX minting, Hard, delegator, swap, savings and earn rewards. Denoms without a
+given multiplier are claimed with their default multiplier, which is the one with the shortest lockup.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s claim-all`, version.AppName, types.ModuleName),
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large,ukava=small`, version.AppName, types.ModuleName, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s kava1...`, version.AppName, types.ModuleName, receiverFlag),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			selections := types.NewSelectionsFromMap(denomsToClaim)
+
+			msg := types.NewMsgClaimAllRewards(sender.String(), receiver, selections)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "optionally specify the multiplier lockup to claim denoms with")
+	cmd.Flags().StringVar(&receiver, receiverFlag, "", "optional address to pay the rewards to, defaults to the sender")
+	return cmd
+}
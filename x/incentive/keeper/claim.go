@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -208,7 +210,69 @@ func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	return nil
 }
 
-// ClaimSavingsReward is a stub method for MsgServer interface compliance
+// ClaimSwapRewardForPool pays the swap rewards an owner has accrued in one pool since they were last synced to the
+// receiver, returning the rewards paid. It is used by modules that provide liquidity on behalf of their users, such
+// as x/earn. Each reward denom is paid with its default multiplier and vesting lockup. Module accounts can't hold
+// locked coins, so claims to them fail unless the default multipliers have no lockup.
+func (k Keeper) ClaimSwapRewardForPool(ctx sdk.Context, owner, receiver sdk.AccAddress, poolID string) (sdk.Coins, error) {
+	claimEnd := k.GetClaimEnd(ctx)
+
+	if ctx.BlockTime().After(claimEnd) {
+		return nil, errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+	}
+
+	claim, found := k.GetSwapClaim(ctx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+	}
+
+	shares, found := k.swapKeeper.GetDepositorSharesAmount(ctx, owner, poolID)
+	if !found {
+		shares = sdk.ZeroInt()
+	}
+
+	syncedClaim := k.synchronizeSwapReward(ctx, claim, poolID, owner, shares)
+	claimingCoins := syncedClaim.Reward.Sub(claim.Reward...)
+
+	rewardCoins := sdk.NewCoins()
+	for _, coin := range claimingCoins {
+		multiplier, found := k.GetDefaultMultiplierByDenom(ctx, coin.Denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multipliers", coin.Denom)
+		}
+
+		rewardCoin := sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(multiplier.Factor).RoundInt())
+		if rewardCoin.IsZero() {
+			continue
+		}
+		length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
+
+		if err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, sdk.NewCoins(rewardCoin), length); err != nil {
+			return nil, err
+		}
+		rewardCoins = rewardCoins.Add(rewardCoin)
+	}
+	if rewardCoins.IsZero() {
+		return nil, types.ErrZeroClaim
+	}
+
+	// only the indexes are updated, as the newly synced rewards have been claimed
+	syncedClaim.Reward = claim.Reward
+	k.SetSwapClaim(ctx, syncedClaim)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
+		),
+	)
+	return rewardCoins, nil
+}
+
+// ClaimSavingsReward pays out funds from a savings claim to a receiver account.
+// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
 func (k Keeper) ClaimSavingsReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
 	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
 	if !found {
@@ -304,3 +368,111 @@ func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	)
 	return nil
 }
+
+// ClaimAllRewards pays out all of an owner's rewards to a receiver account.
+// Each reward denom is paid out according to the multiplier selected for it, or the denom's default multiplier if none
+// is selected. Claims and denoms with nothing to pay are skipped.
+func (k Keeper) ClaimAllRewards(ctx sdk.Context, owner, receiver sdk.AccAddress, selections types.Selections) error {
+	claimEnd := k.GetClaimEnd(ctx)
+
+	if ctx.BlockTime().After(claimEnd) {
+		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+	}
+
+	multiplierNames := make(map[string]string, len(selections))
+	for _, selection := range selections {
+		multiplierNames[selection.Denom] = selection.MultiplierName
+	}
+
+	claimed := false
+	claimDenoms := func(rewards sdk.Coins, claimDenom func(denom, multiplierName string) error) error {
+		for _, reward := range rewards {
+			multiplierName, found := multiplierNames[reward.Denom]
+			if !found {
+				multiplier, found := k.GetDefaultMultiplierByDenom(ctx, reward.Denom)
+				if !found {
+					return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multipliers", reward.Denom)
+				}
+				multiplierName = multiplier.Name
+			}
+
+			err := claimDenom(reward.Denom, multiplierName)
+			if errors.Is(err, types.ErrZeroClaim) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			claimed = true
+		}
+		return nil
+	}
+
+	if claim, found := k.GetUSDXMintingClaim(ctx, owner); found {
+		syncedClaim, err := k.SynchronizeUSDXMintingClaim(ctx, claim)
+		if err != nil {
+			return err
+		}
+		err = claimDenoms(sdk.NewCoins(syncedClaim.Reward), func(_, multiplierName string) error {
+			return k.ClaimUSDXMintingReward(ctx, owner, receiver, multiplierName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SynchronizeHardLiquidityProviderClaim(ctx, owner)
+	if syncedClaim, found := k.GetHardLiquidityProviderClaim(ctx, owner); found {
+		err := claimDenoms(syncedClaim.Reward, func(denom, multiplierName string) error {
+			return k.ClaimHardReward(ctx, owner, receiver, denom, multiplierName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if claim, found := k.GetDelegatorClaim(ctx, owner); found {
+		syncedClaim, err := k.SynchronizeDelegatorClaim(ctx, claim)
+		if err != nil {
+			return err
+		}
+		err = claimDenoms(syncedClaim.Reward, func(denom, multiplierName string) error {
+			return k.ClaimDelegatorReward(ctx, owner, receiver, denom, multiplierName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if syncedClaim, found := k.GetSynchronizedSwapClaim(ctx, owner); found {
+		err := claimDenoms(syncedClaim.Reward, func(denom, multiplierName string) error {
+			return k.ClaimSwapReward(ctx, owner, receiver, denom, multiplierName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if syncedClaim, found := k.GetSynchronizedSavingsClaim(ctx, owner); found {
+		err := claimDenoms(syncedClaim.Reward, func(denom, multiplierName string) error {
+			return k.ClaimSavingsReward(ctx, owner, receiver, denom, multiplierName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if syncedClaim, found := k.GetSynchronizedEarnClaim(ctx, owner); found {
+		err := claimDenoms(syncedClaim.Reward, func(denom, multiplierName string) error {
+			return k.ClaimEarnReward(ctx, owner, receiver, denom, multiplierName)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !claimed {
+		return errorsmod.Wrapf(types.ErrNoClaimsFound, "address: %s", owner)
+	}
+	return nil
+}
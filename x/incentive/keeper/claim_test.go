@@ -84,3 +84,26 @@ func (suite *ClaimTests) TestCannotClaimAfterEndTime() {
 	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "small")
 	suite.ErrorIs(err, types.ErrClaimExpired)
 }
+
+func (suite *ClaimTests) TestCannotClaimSwapRewardForPoolAfterEndTime() {
+	endTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	subspace := &fakeParamSubspace{
+		params: types.Params{
+			ClaimEnd: endTime,
+		},
+	}
+	suite.keeper = suite.NewKeeper(subspace, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.ctx = suite.ctx.WithBlockTime(endTime.Add(time.Nanosecond))
+
+	claim := types.SwapClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner: arbitraryAddress(),
+		},
+	}
+	suite.keeper.SetSwapClaim(suite.ctx, claim)
+
+	_, err := suite.keeper.ClaimSwapRewardForPool(suite.ctx, claim.Owner, claim.Owner, "busd:usdx")
+	suite.ErrorIs(err, types.ErrClaimExpired)
+}
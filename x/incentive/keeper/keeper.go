@@ -3,10 +3,12 @@ package keeper
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"github.com/kava-labs/kava/x/incentive/types"
 )
@@ -810,6 +812,123 @@ func (k Keeper) IterateSavingsRewardAccrualTimes(ctx sdk.Context, cb func(string
 	}
 }
 
+// GetSavingsLockTierAmount returns the locked savings amount of a denom in a lock multiplier tier
+func (k Keeper) GetSavingsLockTierAmount(ctx sdk.Context, denom string, tier time.Duration) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsLockTierAmountKeyPrefix)
+	bz := store.Get(types.SavingsLockTierAmountKey(denom, tier))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetSavingsLockTierAmount stores the locked savings amount of a denom in a lock multiplier tier, deleting it if zero
+func (k Keeper) SetSavingsLockTierAmount(ctx sdk.Context, denom string, tier time.Duration, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsLockTierAmountKeyPrefix)
+	if amount.IsZero() {
+		store.Delete(types.SavingsLockTierAmountKey(denom, tier))
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.SavingsLockTierAmountKey(denom, tier), bz)
+}
+
+// IterateSavingsLockTierAmounts iterates over the locked savings amounts of a denom in each lock multiplier tier
+func (k Keeper) IterateSavingsLockTierAmounts(ctx sdk.Context, denom string, cb func(tier time.Duration, amount sdkmath.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsLockTierAmountKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.SavingsLockTierAmountsKey(denom))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keyPrefixLen := len(types.SavingsLockTierAmountsKey(denom))
+		tier := time.Duration(sdk.BigEndianToUint64(iterator.Key()[keyPrefixLen:]))
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(tier, amount) {
+			break
+		}
+	}
+}
+
+// DeleteAllSavingsLockTierAmounts deletes the locked savings amounts of all denoms and tiers
+func (k Keeper) DeleteAllSavingsLockTierAmounts(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsLockTierAmountKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetSavingsLockTierMultipliers returns the lock multipliers the locked savings amounts are bucketed by
+func (k Keeper) GetSavingsLockTierMultipliers(ctx sdk.Context) (types.SavingsLockMultipliers, bool) {
+	bz := ctx.KVStore(k.key).Get(types.SavingsLockTiersKey)
+	if bz == nil {
+		return nil, false
+	}
+	multipliers := types.SavingsLockMultipliers{}
+	for i := 1; i < len(bz); {
+		tier := time.Duration(sdk.BigEndianToUint64(bz[i : i+8]))
+		factorLen := int(bz[i+8])
+		var factor sdk.Dec
+		if err := factor.Unmarshal(bz[i+9 : i+9+factorLen]); err != nil {
+			panic(err)
+		}
+		multipliers = append(multipliers, types.NewSavingsLockMultiplier(tier, factor))
+		i += 9 + factorLen
+	}
+	return multipliers, true
+}
+
+// SetSavingsLockTierMultipliers stores the lock multipliers the locked savings amounts are bucketed by
+func (k Keeper) SetSavingsLockTierMultipliers(ctx sdk.Context, multipliers types.SavingsLockMultipliers) {
+	// The leading byte keeps the value non-empty when there are no multipliers
+	bz := []byte{0x00}
+	for _, m := range multipliers {
+		factorBz, err := m.Factor.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		bz = append(bz, sdk.Uint64ToBigEndian(uint64(m.MinRemainingDuration))...)
+		bz = append(bz, address.MustLengthPrefix(factorBz)...)
+	}
+	ctx.KVStore(k.key).Set(types.SavingsLockTiersKey, bz)
+}
+
+// GetSavingsLockTiersUpdateTime returns the last time locks were moved between lock multiplier tiers
+func (k Keeper) GetSavingsLockTiersUpdateTime(ctx sdk.Context) (blockTime time.Time, found bool) {
+	bz := ctx.KVStore(k.key).Get(types.SavingsLockTiersUpdateTimeKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	if err := blockTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return blockTime, true
+}
+
+// SetSavingsLockTiersUpdateTime stores the last time locks were moved between lock multiplier tiers
+func (k Keeper) SetSavingsLockTiersUpdateTime(ctx sdk.Context, blockTime time.Time) {
+	bz, err := blockTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.key).Set(types.SavingsLockTiersUpdateTimeKey, bz)
+}
+
 // SetEarnRewardIndexes stores the global reward indexes that track total rewards to a earn vault.
 func (k Keeper) SetEarnRewardIndexes(ctx sdk.Context, vaultDenom string, indexes types.RewardIndexes) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/x/incentive/testutil"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutAllClaims() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12), c("ukava", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleUSDXRewardPeriod("bnb-a", c(types.USDXMintingRewardDenom, 1e6)).
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6))).
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("swap", 1e6))).
+		WithSimpleSavingsRewardPeriod("busd", cs(c("ukava", 1e6)))
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("busd")
+
+	suite.SetupWithGenState(authBulder, incentBuilder, savingsBuilder)
+
+	// create a cdp, hard deposit, swap deposit and savings deposit
+	suite.NoError(suite.DeliverMsgCreateCDP(userAddr, c("bnb", 1e9), c("usdx", 1e7), "bnb-a"))
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")))
+	suite.NoError(suite.DeliverSavingsMsgDeposit(userAddr, cs(c("busd", 1e9))))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		"",
+		types.Selections{
+			types.NewSelection("hard", "large"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	// Check rewards were paid out, using the default multiplier for denoms without a selection
+	expectedRewards := cs(
+		// usdx minting and savings rewards are both paid in ukava
+		c(types.USDXMintingRewardDenom, 2*int64(0.2*float64(7*1e6))),
+		c("hard", 7*1e6),
+		c("swap", int64(0.5*float64(7*1e6))),
+	)
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewards...))
+
+	// Check that each claim has been emptied
+	suite.USDXRewardEquals(userAddr, c(types.USDXMintingRewardDenom, 0))
+	suite.HardRewardEquals(userAddr, nil)
+	suite.SwapRewardEquals(userAddr, nil)
+	suite.SavingsRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaims_Receiver() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12))).
+		WithSimpleAccount(receiverAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	// claim types without a claim are skipped
+	msg := types.NewMsgClaimAllRewards(userAddr.String(), receiverAddr.String(), nil)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	suite.BalanceEquals(userAddr, preClaimBal)
+	suite.BalanceEquals(receiverAddr, cs(c("hard", int64(0.2*float64(7*1e6)))))
+	suite.HardRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaims_NothingToClaim() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// a claim is created on deposit, but has no rewards until time passes
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+
+	msg := types.NewMsgClaimAllRewards(userAddr.String(), "", nil)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrNoClaimsFound)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaims_InvalidMultiplier() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		"",
+		types.Selections{
+			types.NewSelection("hard", "medium"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidMultiplier)
+}
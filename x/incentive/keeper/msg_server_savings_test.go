@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"time"
+
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/kava-labs/kava/x/incentive/testutil"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutSavingsClaim() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSavingsRewardPeriod("ukava", cs(c("hard", 1e6)))
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("ukava")
+
+	suite.SetupWithGenState(authBulder, incentBuilder, savingsBuilder)
+
+	// deposit into savings
+	suite.NoError(suite.DeliverSavingsMsgDeposit(userAddr, cs(c("ukava", 1e9))))
+
+	// accumulate some savings rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimSavingsReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "small"),
+		},
+	)
+
+	// Claim rewards
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	// Check rewards were paid out
+	expectedRewards := c("hard", int64(0.2*float64(7*1e6)))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewards))
+
+	suite.VestingPeriodsEqual(userAddr, []vestingtypes.Period{
+		{Length: (17+31)*secondsPerDay - 7, Amount: cs(expectedRewards)},
+	})
+
+	// Check that the claimed coins have been removed from the claim's reward
+	suite.SavingsRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutSavingsClaim_InvalidMultiplier() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSavingsRewardPeriod("ukava", cs(c("hard", 1e6)))
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("ukava")
+
+	suite.SetupWithGenState(authBulder, incentBuilder, savingsBuilder)
+
+	suite.NoError(suite.DeliverSavingsMsgDeposit(userAddr, cs(c("ukava", 1e9))))
+	suite.NextBlockAfter(7 * time.Second)
+
+	msg := types.NewMsgClaimSavingsReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "medium"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidMultiplier)
+}
+
+func (suite *HandlerTestSuite) TestPayoutSavingsClaim_LockedDeposit() {
+	lockedAddr, liquidAddr := suite.addrs[0], suite.addrs[1]
+	month := 30 * 24 * time.Hour
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(lockedAddr, cs(c("ukava", 1e12))).
+		WithSimpleAccount(liquidAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSavingsRewardPeriod("ukava", cs(c("hard", 1e6))).
+		WithSavingsLockMultipliers(types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(month, d("2.0")),
+		})
+
+	savingsBuilder := testutil.NewSavingsGenesisBuilder().
+		WithSupportedDenoms("ukava")
+
+	suite.SetupWithGenState(authBulder, incentBuilder, savingsBuilder)
+
+	suite.NoError(suite.DeliverSavingsMsgDepositLocked(lockedAddr, cs(c("ukava", 1e9)), 2*month))
+	suite.NoError(suite.DeliverSavingsMsgDeposit(liquidAddr, cs(c("ukava", 1e9))))
+
+	// The lock tiers are built at the end of the first block, after which the
+	// locked deposit earns twice as much as the liquid one
+	suite.NextBlockAfter(7 * time.Second)
+	suite.NextBlockAfter(7 * time.Second)
+
+	ik := suite.App.GetIncentiveKeeper()
+	lockedClaim, found := ik.GetSynchronizedSavingsClaim(suite.Ctx, lockedAddr)
+	suite.Require().True(found)
+	liquidClaim, found := ik.GetSynchronizedSavingsClaim(suite.Ctx, liquidAddr)
+	suite.Require().True(found)
+
+	suite.Equal(cs(c("hard", 3_500_000+4_666_667)), lockedClaim.Reward)
+	suite.Equal(cs(c("hard", 3_500_000+2_333_333)), liquidClaim.Reward)
+
+	preClaimBal := suite.GetBalance(lockedAddr)
+
+	msg := types.NewMsgClaimSavingsReward(
+		lockedAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+		},
+	)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+
+	suite.BalanceEquals(lockedAddr, preClaimBal.Add(c("hard", 3_500_000+4_666_667)))
+	suite.SavingsRewardEquals(lockedAddr, nil)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kava-labs/kava/x/incentive/testutil"
 	"github.com/kava-labs/kava/x/incentive/types"
 	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 const secondsPerDay = 24 * 60 * 60
@@ -146,6 +147,67 @@ func (suite *HandlerTestSuite) TestPayoutSwapClaimMultiDenom() {
 	suite.SwapRewardEquals(userAddr, nil)
 }
 
+func (suite *HandlerTestSuite) TestPayoutSwapClaimForPool() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// deposit into a swap pool
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+	// accumulate some swap rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	// Claim rewards
+	rewards, err := suite.App.GetIncentiveKeeper().ClaimSwapRewardForPool(suite.Ctx, userAddr, userAddr, "busd:ukava")
+	suite.Require().NoError(err)
+
+	// Check rewards were paid out with the default multipliers
+	expectedRewardsHard := c("hard", int64(0.2*float64(7*1e6)))
+	expectedRewardsSwap := c("swap", int64(0.5*float64(7*1e6)))
+	suite.Equal(cs(expectedRewardsHard, expectedRewardsSwap), rewards)
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewardsHard, expectedRewardsSwap))
+
+	suite.VestingPeriodsEqual(userAddr, []vestingtypes.Period{
+		{Length: (17+31)*secondsPerDay - 7, Amount: cs(expectedRewardsHard)},
+		{Length: (28 + 31 + 30 + 31 + 30) * secondsPerDay, Amount: cs(expectedRewardsSwap)}, // second length is stacked on top of the first
+	})
+
+	// Check that the claimed coins have been removed from the claim's reward
+	suite.SwapRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutSwapClaimForPool_ModuleAccountWithLockup() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+	suite.NextBlockAfter(7 * time.Second)
+
+	// Module accounts can't receive the locked rewards of the default multiplier
+	receiver := suite.GetModuleAccount(swaptypes.ModuleName).GetAddress()
+	_, err := suite.App.GetIncentiveKeeper().ClaimSwapRewardForPool(suite.Ctx, userAddr, receiver, "busd:ukava")
+	suite.ErrorIs(err, types.ErrInvalidAccountType)
+}
+
 func (suite *HandlerTestSuite) TestPayoutSwapClaimSingleDenom() {
 	userAddr := suite.addrs[0]
 
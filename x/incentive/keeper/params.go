@@ -88,6 +88,18 @@ func (k Keeper) GetMultiplierByDenom(ctx sdk.Context, denom string, name string)
 	return types.Multiplier{}, false
 }
 
+// GetDefaultMultiplierByDenom fetches the default multiplier from the params for the denom.
+func (k Keeper) GetDefaultMultiplierByDenom(ctx sdk.Context, denom string) (types.Multiplier, bool) {
+	params := k.GetParams(ctx)
+
+	for _, dm := range params.ClaimMultipliers {
+		if dm.Denom == denom {
+			return dm.Multipliers.Default()
+		}
+	}
+	return types.Multiplier{}, false
+}
+
 // GetClaimEnd returns the claim end time for the params
 func (k Keeper) GetClaimEnd(ctx sdk.Context) time.Time {
 	params := k.GetParams(ctx)
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/incentive/types"
@@ -26,8 +28,9 @@ func (k Keeper) AccumulateSavingsRewards(ctx sdk.Context, rewardPeriod types.Mul
 	savingsMacc := k.accountKeeper.GetModuleAccount(ctx, savingstypes.ModuleName)
 	maccCoins := k.bankKeeper.GetAllBalances(ctx, savingsMacc.GetAddress())
 	denomBalance := maccCoins.AmountOf(rewardPeriod.CollateralType)
+	totalSource := sdk.NewDecFromInt(denomBalance).Add(k.getSavingsLockBonusShares(ctx, rewardPeriod.CollateralType))
 
-	acc.Accumulate(rewardPeriod, sdk.NewDecFromInt(denomBalance), ctx.BlockTime())
+	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
 
 	k.SetSavingsRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 
@@ -63,7 +66,10 @@ func (k Keeper) InitializeSavingsReward(ctx sdk.Context, deposit savingstypes.De
 func (k Keeper) SynchronizeSavingsReward(ctx sdk.Context, deposit savingstypes.Deposit, incomingDenoms []string) {
 	claim, found := k.GetSavingsClaim(ctx, deposit.Depositor)
 	if !found {
-		return
+		// Deposits made while savings rewards were disabled have no claim, so
+		// one is started from the current indexes without any past rewards
+		claim = types.NewSavingsClaim(deposit.Depositor, sdk.Coins{}, nil)
+		incomingDenoms = append(getDenoms(deposit.Amount), incomingDenoms...)
 	}
 
 	// Set the reward factor on claim to the global reward factor for each incoming denom
@@ -78,7 +84,7 @@ func (k Keeper) SynchronizeSavingsReward(ctx sdk.Context, deposit savingstypes.D
 	// Existing denoms have their reward indexes + reward amount synced
 	existingDenoms := setDifference(getDenoms(deposit.Amount), incomingDenoms)
 	for _, denom := range existingDenoms {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, sdk.NewDecFromInt(deposit.Amount.AmountOf(denom)))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, k.getSavingsSourceShares(ctx, deposit, denom))
 	}
 
 	k.SetSavingsClaim(ctx, claim)
@@ -133,7 +139,7 @@ func (k Keeper) GetSynchronizedSavingsClaim(ctx sdk.Context, owner sdk.AccAddres
 	}
 
 	for _, coin := range deposit.Amount {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, sdk.NewDecFromInt(coin.Amount))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, k.getSavingsSourceShares(ctx, deposit, coin.Denom))
 	}
 
 	return claim, true
@@ -148,3 +154,165 @@ func (k Keeper) SynchronizeSavingsClaim(ctx sdk.Context, owner sdk.AccAddress) {
 
 	k.SynchronizeSavingsReward(ctx, deposit, []string{})
 }
+
+// getSavingsSourceShares returns the reward weight of a savings deposit for a
+// denom. Locked coins are weighted by the multiplier of the lock multiplier tier
+// they are bucketed in, so the weights of all deposits add up to the total source
+// shares that rewards are accumulated for.
+func (k Keeper) getSavingsSourceShares(ctx sdk.Context, deposit savingstypes.Deposit, denom string) sdk.Dec {
+	shares := sdk.NewDecFromInt(deposit.Amount.AmountOf(denom))
+
+	multipliers, found := k.GetSavingsLockTierMultipliers(ctx)
+	updateTime, timeFound := k.GetSavingsLockTiersUpdateTime(ctx)
+	if !found || !timeFound || len(multipliers) == 0 {
+		return shares
+	}
+
+	for _, lock := range k.savingsKeeper.GetDepositLocks(ctx, deposit.Depositor) {
+		if tier, found := multipliers.Tier(lock.EndTime.Sub(updateTime)); found {
+			shares = shares.Add(savingsLockBonusShares(multipliers.Get(tier), lock.Amount.AmountOf(denom)))
+		}
+	}
+	return shares
+}
+
+// getSavingsLockBonusShares returns the total reward weight that all deposit
+// locks add on top of the deposited amount of a denom, from the locked amounts
+// in each lock multiplier tier as of the last tier update.
+func (k Keeper) getSavingsLockBonusShares(ctx sdk.Context, denom string) sdk.Dec {
+	bonus := sdk.ZeroDec()
+
+	multipliers, found := k.GetSavingsLockTierMultipliers(ctx)
+	if !found {
+		return bonus
+	}
+	k.IterateSavingsLockTierAmounts(ctx, denom, func(tier time.Duration, amount sdkmath.Int) bool {
+		bonus = bonus.Add(savingsLockBonusShares(multipliers.Get(tier), amount))
+		return false
+	})
+	return bonus
+}
+
+// UpdateSavingsLockTiers moves the locked amounts of deposit locks whose
+// remaining duration fell below a lock multiplier tier since the last update
+// to their new tier. A lock crosses a tier once its end time is within the
+// tier's duration of the block time, so these are found from the savings lock
+// end time queue, and a lock leaves its last tier by the time it is unlocked.
+// All locks are re-bucketed if the multipliers have changed or were never built.
+//
+// It runs after the rewards of the past block were accumulated, and the claims
+// of the moved locks are synced first so they earn those rewards at the weight
+// they were accumulated for.
+func (k Keeper) UpdateSavingsLockTiers(ctx sdk.Context) {
+	multipliers := k.GetParams(ctx).SavingsLockMultipliers
+
+	storedMultipliers, found := k.GetSavingsLockTierMultipliers(ctx)
+	previousTime, timeFound := k.GetSavingsLockTiersUpdateTime(ctx)
+	if !found || !timeFound || !multipliers.Equal(storedMultipliers) {
+		var locks savingstypes.DepositLocks
+		k.savingsKeeper.IterateDepositLocks(ctx, func(lock savingstypes.DepositLock) bool {
+			locks = append(locks, lock)
+			return false
+		})
+		k.synchronizeSavingsLockClaims(ctx, locks)
+
+		k.DeleteAllSavingsLockTierAmounts(ctx)
+		for _, lock := range locks {
+			if tier, found := multipliers.Tier(lock.EndTime.Sub(ctx.BlockTime())); found {
+				k.addSavingsLockTierAmounts(ctx, tier, lock.Amount)
+			}
+		}
+		k.SetSavingsLockTierMultipliers(ctx, multipliers)
+		k.SetSavingsLockTiersUpdateTime(ctx, ctx.BlockTime())
+		return
+	}
+
+	if !ctx.BlockTime().After(previousTime) {
+		return
+	}
+
+	// A lock can cross several tiers between updates, but is only moved once
+	var crossed savingstypes.DepositLocks
+	seen := make(map[string]bool)
+	for _, tier := range multipliers.Tiers() {
+		k.savingsKeeper.IterateDepositLockQueueRange(
+			ctx,
+			previousTime.Add(tier),
+			ctx.BlockTime().Add(tier),
+			func(lock savingstypes.DepositLock) bool {
+				key := string(savingstypes.DepositLockKey(lock.Depositor, lock.ID))
+				if !seen[key] {
+					seen[key] = true
+					crossed = append(crossed, lock)
+				}
+				return false
+			},
+		)
+	}
+	k.synchronizeSavingsLockClaims(ctx, crossed)
+
+	for _, lock := range crossed {
+		if tier, found := multipliers.Tier(lock.EndTime.Sub(previousTime)); found {
+			k.subSavingsLockTierAmounts(ctx, tier, lock.Amount)
+		}
+		if tier, found := multipliers.Tier(lock.EndTime.Sub(ctx.BlockTime())); found {
+			k.addSavingsLockTierAmounts(ctx, tier, lock.Amount)
+		}
+	}
+
+	k.SetSavingsLockTiersUpdateTime(ctx, ctx.BlockTime())
+}
+
+// AddSavingsLockToTiers adds the locked amount of a new deposit lock to the
+// lock multiplier tier of its remaining duration at the last tier update, from
+// which the next update moves it. Locks created before the tiers are built are
+// included when they are.
+func (k Keeper) AddSavingsLockToTiers(ctx sdk.Context, lock savingstypes.DepositLock) {
+	multipliers, found := k.GetSavingsLockTierMultipliers(ctx)
+	updateTime, timeFound := k.GetSavingsLockTiersUpdateTime(ctx)
+	if !found || !timeFound {
+		return
+	}
+
+	if tier, found := multipliers.Tier(lock.EndTime.Sub(updateTime)); found {
+		k.addSavingsLockTierAmounts(ctx, tier, lock.Amount)
+	}
+}
+
+// synchronizeSavingsLockClaims syncs the savings claims of the depositors of
+// deposit locks once each
+func (k Keeper) synchronizeSavingsLockClaims(ctx sdk.Context, locks savingstypes.DepositLocks) {
+	synced := make(map[string]bool)
+	for _, lock := range locks {
+		if synced[lock.Depositor.String()] {
+			continue
+		}
+		synced[lock.Depositor.String()] = true
+		k.SynchronizeSavingsClaim(ctx, lock.Depositor)
+	}
+}
+
+// addSavingsLockTierAmounts adds locked coins to a lock multiplier tier
+func (k Keeper) addSavingsLockTierAmounts(ctx sdk.Context, tier time.Duration, coins sdk.Coins) {
+	for _, coin := range coins {
+		amount := k.GetSavingsLockTierAmount(ctx, coin.Denom, tier)
+		k.SetSavingsLockTierAmount(ctx, coin.Denom, tier, amount.Add(coin.Amount))
+	}
+}
+
+// subSavingsLockTierAmounts removes locked coins from a lock multiplier tier
+func (k Keeper) subSavingsLockTierAmounts(ctx sdk.Context, tier time.Duration, coins sdk.Coins) {
+	for _, coin := range coins {
+		amount := k.GetSavingsLockTierAmount(ctx, coin.Denom, tier).Sub(coin.Amount)
+		if amount.IsNegative() {
+			panic(fmt.Sprintf("negative savings lock tier amount for %s in tier %s", coin.Denom, tier))
+		}
+		k.SetSavingsLockTierAmount(ctx, coin.Denom, tier, amount)
+	}
+}
+
+// savingsLockBonusShares returns the weight that a locked amount adds on top of
+// itself with a lock multiplier factor
+func savingsLockBonusShares(factor sdk.Dec, amount sdkmath.Int) sdk.Dec {
+	return sdk.NewDecFromInt(amount).Mul(factor.Sub(sdk.OneDec()))
+}
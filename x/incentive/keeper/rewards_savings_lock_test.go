@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive"
+	"github.com/kava-labs/kava/x/incentive/testutil"
+	"github.com/kava-labs/kava/x/incentive/types"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
+)
+
+func (suite *SavingsRewardsTestSuite) TestSavingsLockMultipliers() {
+	lockedDepositor, liquidDepositor := suite.addrs[0], suite.addrs[1]
+
+	params := savingstypes.NewParams([]string{"ukava"})
+	deposits := savingstypes.Deposits{
+		savingstypes.NewDeposit(lockedDepositor, cs(c("ukava", 1_000_000))),
+		savingstypes.NewDeposit(liquidDepositor, cs(c("ukava", 500_000))),
+	}
+	savingsGenesis := savingstypes.NewGenesisState(params, deposits)
+	savingsGenesis.DepositLocks = savingstypes.DepositLocks{
+		savingstypes.NewDepositLock(1, lockedDepositor, cs(c("ukava", 500_000)), suite.genesisTime.Add(365*24*time.Hour)),
+	}
+	savingsGenesis.NextDepositLockID = 2
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(savingstypes.ModuleName, cs(c("ukava", 1_500_000)))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleSavingsRewardPeriod("ukava", cs(c("hard", 2_000_000))).
+		WithSavingsLockMultipliers(types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(30*24*time.Hour, d("1.5")),
+			types.NewSavingsLockMultiplier(180*24*time.Hour, d("2.0")),
+		})
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	for _, deposit := range deposits {
+		suite.keeper.InitializeSavingsReward(suite.ctx, deposit)
+	}
+
+	runCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Second))
+
+	rewardPeriods, found := suite.keeper.GetSavingsRewardPeriods(runCtx, "ukava")
+	suite.Require().True(found)
+	suite.keeper.AccumulateSavingsRewards(runCtx, rewardPeriods)
+
+	// The locked coins count twice, so the total weight is 2_000_000
+	rewardIndexes, _ := suite.keeper.GetSavingsRewardIndexes(runCtx, "ukava")
+	suite.Require().Equal(types.RewardIndexes{types.NewRewardIndex("hard", d("10"))}, rewardIndexes)
+
+	claim, found := suite.keeper.GetSynchronizedSavingsClaim(runCtx, lockedDepositor)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("hard", 15_000_000)), claim.Reward)
+
+	claim, found = suite.keeper.GetSynchronizedSavingsClaim(runCtx, liquidDepositor)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("hard", 5_000_000)), claim.Reward)
+}
+
+func (suite *SavingsRewardsTestSuite) TestSavingsLockMultipliers_ClaimsMatchSource() {
+	lockedDepositor, liquidDepositor := suite.addrs[0], suite.addrs[1]
+	month := 30 * 24 * time.Hour
+
+	params := savingstypes.NewParams([]string{"ukava"})
+	deposits := savingstypes.Deposits{
+		savingstypes.NewDeposit(lockedDepositor, cs(c("ukava", 1_000_000))),
+		savingstypes.NewDeposit(liquidDepositor, cs(c("ukava", 500_000))),
+	}
+	savingsGenesis := savingstypes.NewGenesisState(params, deposits)
+	savingsGenesis.DepositLocks = savingstypes.DepositLocks{
+		savingstypes.NewDepositLock(1, lockedDepositor, cs(c("ukava", 500_000)), suite.genesisTime.Add(7*month)),
+	}
+	savingsGenesis.NextDepositLockID = 2
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(savingstypes.ModuleName, cs(c("ukava", 1_500_000)))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleSavingsRewardPeriod("ukava", cs(c("hard", 1_000_000))).
+		WithSavingsLockMultipliers(types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(month, d("1.5")),
+			types.NewSavingsLockMultiplier(6*month, d("2.0")),
+		})
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	for _, deposit := range deposits {
+		suite.keeper.InitializeSavingsReward(suite.ctx, deposit)
+	}
+
+	// The lock crosses from the 6 month tier to the 1 month tier between the
+	// blocks, and neither claim is synced until the end
+	blockTimes := []time.Time{
+		suite.genesisTime.Add(month - time.Hour),
+		suite.genesisTime.Add(month + time.Hour),
+		suite.genesisTime.Add(2 * month),
+	}
+	for _, blockTime := range blockTimes {
+		suite.ctx = suite.ctx.WithBlockTime(blockTime)
+		incentive.BeginBlocker(suite.ctx, suite.keeper)
+	}
+
+	// The locked deposit earns at the 6 month tier weight until the lock crossed
+	lockedClaim, found := suite.keeper.GetSynchronizedSavingsClaim(suite.ctx, lockedDepositor)
+	suite.Require().True(found)
+	liquidClaim, found := suite.keeper.GetSynchronizedSavingsClaim(suite.ctx, liquidDepositor)
+	suite.Require().True(found)
+	// 3:1 for a month and an hour, then 2.5:1 for a month less an hour
+	suite.Equal(cs(c("hard", 1_946_700_000_000+1_848_857_142_857)), lockedClaim.Reward)
+	suite.Equal(cs(c("hard", 648_900_000_000+739_542_857_143)), liquidClaim.Reward)
+
+	// The claims add up to all the rewards distributed, except for rounding
+	distributed := int64(2*month/time.Second) * 1_000_000
+	claimed := lockedClaim.Reward.Add(liquidClaim.Reward...).AmountOf("hard")
+	suite.Require().True(claimed.LTE(i(distributed)))
+	suite.Require().True(claimed.GTE(i(distributed-2)), "claimed %s of %d", claimed, distributed)
+}
+
+func (suite *SavingsRewardsTestSuite) TestSavingsLockTiers() {
+	depositor := suite.addrs[0]
+	month := 30 * 24 * time.Hour
+
+	params := savingstypes.NewParams([]string{"ukava"})
+	savingsGenesis := savingstypes.NewGenesisState(params, savingstypes.Deposits{
+		savingstypes.NewDeposit(depositor, cs(c("ukava", 1_000_000))),
+	})
+	savingsGenesis.DepositLocks = savingstypes.DepositLocks{
+		savingstypes.NewDepositLock(1, depositor, cs(c("ukava", 500_000)), suite.genesisTime.Add(7*month)),
+	}
+	savingsGenesis.NextDepositLockID = 2
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(depositor, cs(c("ukava", 1_000_000))).
+		WithSimpleModuleAccount(savingstypes.ModuleName, cs(c("ukava", 1_000_000)))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSavingsLockMultipliers(types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(month, d("1.5")),
+			types.NewSavingsLockMultiplier(6*month, d("2.0")),
+		})
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	// Existing locks are bucketed when the tiers are first built
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(500_000), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", 6*month))
+
+	// New locks are added to the tier of their remaining duration
+	_, err := suite.savingsKeeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 200_000)), 3*month)
+	suite.Require().NoError(err)
+	suite.Equal(i(200_000), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", month))
+
+	// Locks move to a lower tier as their remaining duration passes it
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(month + time.Second))
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(0), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", 6*month))
+	suite.Equal(i(700_000), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", month))
+
+	// Locks leave the last tier before they end, and ended locks leave it
+	// even if they haven't been unlocked yet
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(6*month + time.Second))
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(0), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", month))
+
+	// Tiers are rebuilt from all locks when the multipliers change
+	incentiveParams := suite.keeper.GetParams(suite.ctx)
+	incentiveParams.SavingsLockMultipliers = types.SavingsLockMultipliers{
+		types.NewSavingsLockMultiplier(0, d("1.2")),
+	}
+	suite.keeper.SetParams(suite.ctx, incentiveParams)
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(0), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", month))
+	suite.Equal(i(500_000), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", 0))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(7 * month))
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(0), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", 0))
+}
+
+func (suite *SavingsRewardsTestSuite) TestSavingsLockTiers_CrossesSeveralTiers() {
+	depositor := suite.addrs[0]
+	month := 30 * 24 * time.Hour
+
+	params := savingstypes.NewParams([]string{"ukava"})
+	savingsGenesis := savingstypes.NewGenesisState(params, savingstypes.Deposits{
+		savingstypes.NewDeposit(depositor, cs(c("ukava", 1_000_000))),
+	})
+	savingsGenesis.DepositLocks = savingstypes.DepositLocks{
+		savingstypes.NewDepositLock(1, depositor, cs(c("ukava", 500_000)), suite.genesisTime.Add(7*month)),
+	}
+	savingsGenesis.NextDepositLockID = 2
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(savingstypes.ModuleName, cs(c("ukava", 1_000_000)))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSavingsLockMultipliers(types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(month, d("1.5")),
+			types.NewSavingsLockMultiplier(6*month, d("2.0")),
+		})
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(500_000), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", 6*month))
+
+	// The lock is only moved once, from its tier at the last update
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(6*month + time.Second))
+	suite.keeper.UpdateSavingsLockTiers(suite.ctx)
+	suite.Equal(i(0), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", 6*month))
+	suite.Equal(i(0), suite.keeper.GetSavingsLockTierAmount(suite.ctx, "ukava", month))
+}
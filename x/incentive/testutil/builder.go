@@ -198,6 +198,12 @@ func (builder IncentiveGenesisBuilder) WithMultipliers(multipliers types.Multipl
 	return builder
 }
 
+func (builder IncentiveGenesisBuilder) WithSavingsLockMultipliers(multipliers types.SavingsLockMultipliers) IncentiveGenesisBuilder {
+	builder.Params.SavingsLockMultipliers = multipliers
+
+	return builder
+}
+
 func (builder IncentiveGenesisBuilder) simpleRewardPeriod(ctype string, rewardsPerSecond sdk.Coins) types.MultiRewardPeriod {
 	return types.NewMultiRewardPeriod(
 		true,
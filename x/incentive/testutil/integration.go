@@ -34,6 +34,8 @@ import (
 	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
 	routerkeeper "github.com/kava-labs/kava/x/router/keeper"
 	routertypes "github.com/kava-labs/kava/x/router/types"
+	savingskeeper "github.com/kava-labs/kava/x/savings/keeper"
+	savingstypes "github.com/kava-labs/kava/x/savings/types"
 	swapkeeper "github.com/kava-labs/kava/x/swap/keeper"
 	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
@@ -150,8 +152,12 @@ func (suite *IntegrationTester) DeliverIncentiveMsg(msg sdk.Msg) error {
 		_, err = msgServer.ClaimUSDXMintingReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	case *types.MsgClaimDelegatorReward:
 		_, err = msgServer.ClaimDelegatorReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimSavingsReward:
+		_, err = msgServer.ClaimSavingsReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	case *types.MsgClaimEarnReward:
 		_, err = msgServer.ClaimEarnReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimAllRewards:
+		_, err = msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), msg)
 	default:
 		panic("unhandled incentive msg")
 	}
@@ -275,6 +281,22 @@ func (suite *IntegrationTester) DeliverHardMsgWithdraw(owner sdk.AccAddress, wit
 	return err
 }
 
+func (suite *IntegrationTester) DeliverSavingsMsgDeposit(owner sdk.AccAddress, deposit sdk.Coins) error {
+	msg := savingstypes.NewMsgDeposit(owner, deposit)
+	msgServer := savingskeeper.NewMsgServerImpl(suite.App.GetSavingsKeeper())
+
+	_, err := msgServer.Deposit(sdk.WrapSDKContext(suite.Ctx), &msg)
+	return err
+}
+
+func (suite *IntegrationTester) DeliverSavingsMsgDepositLocked(owner sdk.AccAddress, deposit sdk.Coins, lockDuration time.Duration) error {
+	msg := savingstypes.NewMsgDepositLocked(owner, deposit, lockDuration)
+	msgServer := savingskeeper.NewMsgServerImpl(suite.App.GetSavingsKeeper())
+
+	_, err := msgServer.DepositLocked(sdk.WrapSDKContext(suite.Ctx), &msg)
+	return err
+}
+
 func (suite *IntegrationTester) DeliverMsgCreateCDP(owner sdk.AccAddress, collateral, principal sdk.Coin, collateralType string) error {
 	msg := cdptypes.NewMsgCreateCDP(owner, collateral, principal, collateralType)
 	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
@@ -430,6 +452,12 @@ func (suite *IntegrationTester) USDXRewardEquals(owner sdk.AccAddress, expected
 	suite.Equalf(expected, claim.Reward, "expected delegator claim reward to be %s, but got %s", expected, claim.Reward)
 }
 
+func (suite *IntegrationTester) SavingsRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
+	claim, found := suite.App.GetIncentiveKeeper().GetSavingsClaim(suite.Ctx, owner)
+	suite.Require().Truef(found, "expected savings claim to be found for %s", owner)
+	suite.Equalf(expected, claim.Reward, "expected savings claim reward to be %s, but got %s", expected, claim.Reward)
+}
+
 func (suite *IntegrationTester) EarnRewardEquals(owner sdk.AccAddress, expected sdk.Coins) {
 	claim, found := suite.App.GetIncentiveKeeper().GetEarnClaim(suite.Ctx, owner)
 	suite.Require().Truef(found, "expected earn claim to be found for %s", owner)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -66,6 +68,9 @@ type SwapKeeper interface {
 type SavingsKeeper interface {
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 	GetSavingsModuleAccountBalances(ctx sdk.Context) sdk.Coins
+	GetDepositLocks(ctx sdk.Context, depositor sdk.AccAddress) savingstypes.DepositLocks
+	IterateDepositLocks(ctx sdk.Context, cb func(lock savingstypes.DepositLock) (stop bool))
+	IterateDepositLockQueueRange(ctx sdk.Context, start, end time.Time, cb func(lock savingstypes.DepositLock) (stop bool))
 }
 
 // EarnKeeper defines the required methods needed by this modules keeper
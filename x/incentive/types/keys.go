@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -36,4 +43,19 @@ var (
 	EarnClaimKeyPrefix                            = []byte{0x18} // prefix for keys that store earn claims
 	EarnRewardIndexesKeyPrefix                    = []byte{0x19} // prefix for key that stores earn reward indexes
 	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20} // prefix for key that stores the previous time earn rewards accrued
+	SavingsLockTierAmountKeyPrefix                = []byte{0x21} // prefix for keys that store the locked savings amounts in each lock multiplier tier
+	SavingsLockTiersKey                           = []byte{0x22} // key for the lock multipliers the locked savings amounts are bucketed by
+	SavingsLockTiersUpdateTimeKey                 = []byte{0x23} // key for the last time locks were moved between lock multiplier tiers
 )
+
+// SavingsLockTierAmountsKey returns the key prefix of the locked savings
+// amounts of a denom
+func SavingsLockTierAmountsKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// SavingsLockTierAmountKey returns the key of the locked savings amount of a
+// denom in the lock multiplier tier with the minimum remaining duration
+func SavingsLockTierAmountKey(denom string, tier time.Duration) []byte {
+	return append(SavingsLockTierAmountsKey(denom), sdk.Uint64ToBigEndian(uint64(tier))...)
+}
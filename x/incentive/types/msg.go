@@ -17,6 +17,7 @@ var (
 	_ sdk.Msg = &MsgClaimSwapReward{}
 	_ sdk.Msg = &MsgClaimSavingsReward{}
 	_ sdk.Msg = &MsgClaimEarnReward{}
+	_ sdk.Msg = &MsgClaimAllRewards{}
 
 	_ legacytx.LegacyMsg = &MsgClaimUSDXMintingReward{}
 	_ legacytx.LegacyMsg = &MsgClaimHardReward{}
@@ -24,6 +25,7 @@ var (
 	_ legacytx.LegacyMsg = &MsgClaimSwapReward{}
 	_ legacytx.LegacyMsg = &MsgClaimSavingsReward{}
 	_ legacytx.LegacyMsg = &MsgClaimEarnReward{}
+	_ legacytx.LegacyMsg = &MsgClaimAllRewards{}
 )
 
 const (
@@ -33,6 +35,7 @@ const (
 	TypeMsgClaimSwapReward        = "claim_swap_reward"
 	TypeMsgClaimSavingsReward     = "claim_savings_reward"
 	TypeMsgClaimEarnReward        = "claim_earn_reward"
+	TypeMsgClaimAllRewards        = "claim_all_rewards"
 )
 
 // NewMsgClaimUSDXMintingReward returns a new MsgClaimUSDXMintingReward.
@@ -290,3 +293,55 @@ func (msg MsgClaimEarnReward) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgClaimAllRewards returns a new MsgClaimAllRewards.
+func NewMsgClaimAllRewards(sender, receiver string, denomsToClaim Selections) MsgClaimAllRewards {
+	return MsgClaimAllRewards{
+		Sender:        sender,
+		Receiver:      receiver,
+		DenomsToClaim: denomsToClaim,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimAllRewards) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimAllRewards) Type() string {
+	return TypeMsgClaimAllRewards
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgClaimAllRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if msg.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver address is invalid")
+		}
+	}
+	// selections are optional, denoms without one are claimed with their default multiplier
+	if len(msg.DenomsToClaim) > 0 {
+		if err := msg.DenomsToClaim.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimAllRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
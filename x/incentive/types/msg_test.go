@@ -221,6 +221,101 @@ func TestMsgClaimUSDXMintingReward_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgClaimAllRewards_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+
+	type expectedErr struct {
+		wraps error
+		pass  bool
+	}
+	type msgArgs struct {
+		sender        string
+		receiver      string
+		denomsToClaim types.Selections
+	}
+	tests := []struct {
+		name    string
+		msgArgs msgArgs
+		expect  expectedErr
+	}{
+		{
+			name: "no selections is valid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "selections and receiver are valid",
+			msgArgs: msgArgs{
+				sender:        validAddress,
+				receiver:      validAddress,
+				denomsToClaim: types.Selections{types.NewSelection("hard", "large")},
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "invalid sender",
+			msgArgs: msgArgs{
+				sender: "",
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "invalid receiver",
+			msgArgs: msgArgs{
+				sender:   validAddress,
+				receiver: "kava1invalid",
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "empty multiplier name is invalid",
+			msgArgs: msgArgs{
+				sender:        validAddress,
+				denomsToClaim: types.Selections{types.NewSelection("hard", "")},
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidMultiplier,
+			},
+		},
+		{
+			name: "duplicate denoms are invalid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					types.NewSelection("hard", "large"),
+					types.NewSelection("hard", "small"),
+				},
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidClaimDenoms,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgClaimAllRewards(tc.msgArgs.sender, tc.msgArgs.receiver, tc.msgArgs.denomsToClaim)
+
+			err := msg.ValidateBasic()
+			if tc.expect.pass {
+				require.NoError(t, err)
+			} else {
+				require.Truef(t, errors.Is(err, tc.expect.wraps), "expected error '%s' was not actual '%s'", tc.expect.wraps, err)
+			}
+		})
+	}
+}
+
 func tooManySelections() types.Selections {
 	selections := make(types.Selections, types.MaxDenomsToClaim+1)
 	for i := range selections {
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +56,21 @@ func (ms Multipliers) Get(name string) (Multiplier, bool) {
 	return Multiplier{}, false
 }
 
+// Default returns the multiplier with the shortest lockup, which is used when a claim doesn't select one.
+// If several multipliers share the shortest lockup, the first is returned.
+func (ms Multipliers) Default() (Multiplier, bool) {
+	if len(ms) == 0 {
+		return Multiplier{}, false
+	}
+	multiplier := ms[0]
+	for _, m := range ms[1:] {
+		if m.MonthsLockup < multiplier.MonthsLockup {
+			multiplier = m
+		}
+	}
+	return multiplier, true
+}
+
 // MultipliersPerDenoms is a slice of MultipliersPerDenom
 type MultipliersPerDenoms []MultipliersPerDenom
 
@@ -78,6 +94,100 @@ func (mpd MultipliersPerDenoms) Validate() error {
 	return nil
 }
 
+// NewSavingsLockMultiplier returns a new SavingsLockMultiplier
+func NewSavingsLockMultiplier(minRemainingDuration time.Duration, factor sdk.Dec) SavingsLockMultiplier {
+	return SavingsLockMultiplier{
+		MinRemainingDuration: minRemainingDuration,
+		Factor:               factor,
+	}
+}
+
+// Validate savings lock multiplier param
+func (m SavingsLockMultiplier) Validate() error {
+	if m.MinRemainingDuration < 0 {
+		return fmt.Errorf("expected non-negative minimum remaining duration, got %s", m.MinRemainingDuration)
+	}
+	if m.Factor.IsNil() || m.Factor.LT(sdk.OneDec()) {
+		return fmt.Errorf("expected factor of at least 1, got %s", m.Factor)
+	}
+
+	return nil
+}
+
+// SavingsLockMultipliers is a slice of SavingsLockMultiplier
+type SavingsLockMultipliers []SavingsLockMultiplier
+
+// Validate validates each multiplier and checks for duplicate durations
+func (ms SavingsLockMultipliers) Validate() error {
+	foundDurations := map[time.Duration]bool{}
+
+	for _, m := range ms {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		if foundDurations[m.MinRemainingDuration] {
+			return fmt.Errorf("duplicate minimum remaining duration %s", m.MinRemainingDuration)
+		}
+		foundDurations[m.MinRemainingDuration] = true
+	}
+	return nil
+}
+
+// Get returns the highest factor of the multipliers that the remaining lock
+// duration qualifies for, or 1 if there are none.
+func (ms SavingsLockMultipliers) Get(remaining time.Duration) sdk.Dec {
+	factor := sdk.OneDec()
+	for _, m := range ms {
+		if remaining >= m.MinRemainingDuration && m.Factor.GT(factor) {
+			factor = m.Factor
+		}
+	}
+	return factor
+}
+
+// Equal returns true if both contain the same multipliers in the same order
+func (ms SavingsLockMultipliers) Equal(other SavingsLockMultipliers) bool {
+	if len(ms) != len(other) {
+		return false
+	}
+	for i := range ms {
+		if ms[i].MinRemainingDuration != other[i].MinRemainingDuration || !ms[i].Factor.Equal(other[i].Factor) {
+			return false
+		}
+	}
+	return true
+}
+
+// Tiers returns the minimum remaining durations of the multipliers in
+// ascending order. Locks are bucketed by the longest of these their remaining
+// duration reaches.
+func (ms SavingsLockMultipliers) Tiers() []time.Duration {
+	tiers := make([]time.Duration, 0, len(ms))
+	for _, m := range ms {
+		tiers = append(tiers, m.MinRemainingDuration)
+	}
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i] < tiers[j] })
+	return tiers
+}
+
+// Tier returns the longest of the tiers that the remaining lock duration
+// reaches, or false if the lock has ended or reaches none. The tier's
+// multiplier factor is the one of the remaining duration.
+func (ms SavingsLockMultipliers) Tier(remaining time.Duration) (time.Duration, bool) {
+	if remaining <= 0 {
+		return 0, false
+	}
+
+	tier, found := time.Duration(0), false
+	for _, m := range ms {
+		if remaining >= m.MinRemainingDuration && (!found || m.MinRemainingDuration > tier) {
+			tier, found = m.MinRemainingDuration, true
+		}
+	}
+	return tier, found
+}
+
 // NewSelection returns a new Selection
 func NewSelection(denom, multiplierName string) Selection {
 	return Selection{
@@ -24,6 +24,7 @@ var (
 	KeyEarnRewardPeriods        = []byte("EarnRewardPeriods")
 	KeyClaimEnd                 = []byte("ClaimEnd")
 	KeyMultipliers              = []byte("ClaimMultipliers")
+	KeySavingsLockMultipliers   = []byte("SavingsLockMultipliers")
 
 	DefaultActive             = false
 	DefaultRewardPeriods      = RewardPeriods{}
@@ -89,6 +90,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEarnRewardPeriods, &p.EarnRewardPeriods, validateMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersPerDenomParam),
 		paramtypes.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
+		paramtypes.NewParamSetPair(KeySavingsLockMultipliers, &p.SavingsLockMultipliers, validateSavingsLockMultipliersParam),
 	}
 }
 
@@ -126,6 +128,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSavingsLockMultipliersParam(p.SavingsLockMultipliers); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -155,6 +161,14 @@ func validateMultipliersPerDenomParam(i interface{}) error {
 	return multipliers.Validate()
 }
 
+func validateSavingsLockMultipliersParam(i interface{}) error {
+	multipliers, ok := i.(SavingsLockMultipliers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return multipliers.Validate()
+}
+
 func validateClaimEndParam(i interface{}) error {
 	endTime, ok := i.(time.Time)
 	if !ok {
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -192,24 +193,65 @@ func (m *MultipliersPerDenom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultipliersPerDenom proto.InternalMessageInfo
 
+// SavingsLockMultiplier increases the savings reward weight of locked deposits
+// that have at least the minimum remaining lock duration
+type SavingsLockMultiplier struct {
+	MinRemainingDuration time.Duration                          `protobuf:"bytes,1,opt,name=min_remaining_duration,json=minRemainingDuration,proto3,stdduration" json:"min_remaining_duration"`
+	Factor               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=factor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"factor"`
+}
+
+func (m *SavingsLockMultiplier) Reset()         { *m = SavingsLockMultiplier{} }
+func (m *SavingsLockMultiplier) String() string { return proto.CompactTextString(m) }
+func (*SavingsLockMultiplier) ProtoMessage()    {}
+func (*SavingsLockMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8833f5d745eac9, []int{4}
+}
+func (m *SavingsLockMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SavingsLockMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SavingsLockMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SavingsLockMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SavingsLockMultiplier.Merge(m, src)
+}
+func (m *SavingsLockMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *SavingsLockMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_SavingsLockMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SavingsLockMultiplier proto.InternalMessageInfo
+
 // Params
 type Params struct {
-	USDXMintingRewardPeriods RewardPeriods        `protobuf:"bytes,1,rep,name=usdx_minting_reward_periods,json=usdxMintingRewardPeriods,proto3,castrepeated=RewardPeriods" json:"usdx_minting_reward_periods"`
-	HardSupplyRewardPeriods  MultiRewardPeriods   `protobuf:"bytes,2,rep,name=hard_supply_reward_periods,json=hardSupplyRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_supply_reward_periods"`
-	HardBorrowRewardPeriods  MultiRewardPeriods   `protobuf:"bytes,3,rep,name=hard_borrow_reward_periods,json=hardBorrowRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_borrow_reward_periods"`
-	DelegatorRewardPeriods   MultiRewardPeriods   `protobuf:"bytes,4,rep,name=delegator_reward_periods,json=delegatorRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"delegator_reward_periods"`
-	SwapRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,5,rep,name=swap_reward_periods,json=swapRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"swap_reward_periods"`
-	ClaimMultipliers         MultipliersPerDenoms `protobuf:"bytes,6,rep,name=claim_multipliers,json=claimMultipliers,proto3,castrepeated=MultipliersPerDenoms" json:"claim_multipliers"`
-	ClaimEnd                 time.Time            `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
-	SavingsRewardPeriods     MultiRewardPeriods   `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
-	EarnRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	USDXMintingRewardPeriods RewardPeriods          `protobuf:"bytes,1,rep,name=usdx_minting_reward_periods,json=usdxMintingRewardPeriods,proto3,castrepeated=RewardPeriods" json:"usdx_minting_reward_periods"`
+	HardSupplyRewardPeriods  MultiRewardPeriods     `protobuf:"bytes,2,rep,name=hard_supply_reward_periods,json=hardSupplyRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_supply_reward_periods"`
+	HardBorrowRewardPeriods  MultiRewardPeriods     `protobuf:"bytes,3,rep,name=hard_borrow_reward_periods,json=hardBorrowRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_borrow_reward_periods"`
+	DelegatorRewardPeriods   MultiRewardPeriods     `protobuf:"bytes,4,rep,name=delegator_reward_periods,json=delegatorRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"delegator_reward_periods"`
+	SwapRewardPeriods        MultiRewardPeriods     `protobuf:"bytes,5,rep,name=swap_reward_periods,json=swapRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"swap_reward_periods"`
+	ClaimMultipliers         MultipliersPerDenoms   `protobuf:"bytes,6,rep,name=claim_multipliers,json=claimMultipliers,proto3,castrepeated=MultipliersPerDenoms" json:"claim_multipliers"`
+	ClaimEnd                 time.Time              `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
+	SavingsRewardPeriods     MultiRewardPeriods     `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
+	EarnRewardPeriods        MultiRewardPeriods     `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	SavingsLockMultipliers   SavingsLockMultipliers `protobuf:"bytes,10,rep,name=savings_lock_multipliers,json=savingsLockMultipliers,proto3,castrepeated=SavingsLockMultipliers" json:"savings_lock_multipliers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{4}
+	return fileDescriptor_bb8833f5d745eac9, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +285,7 @@ func init() {
 	proto.RegisterType((*MultiRewardPeriod)(nil), "kava.incentive.v1beta1.MultiRewardPeriod")
 	proto.RegisterType((*Multiplier)(nil), "kava.incentive.v1beta1.Multiplier")
 	proto.RegisterType((*MultipliersPerDenom)(nil), "kava.incentive.v1beta1.MultipliersPerDenom")
+	proto.RegisterType((*SavingsLockMultiplier)(nil), "kava.incentive.v1beta1.SavingsLockMultiplier")
 	proto.RegisterType((*Params)(nil), "kava.incentive.v1beta1.Params")
 }
 
@@ -251,56 +294,62 @@ func init() {
 }
 
 var fileDescriptor_bb8833f5d745eac9 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xfc, 0xbb, 0xc9, 0xb4, 0xbd, 0xb7, 0x9d, 0x46, 0xb9, 0xbe, 0xb9, 0xc8, 0xa9,
-	0x52, 0x04, 0x41, 0x55, 0x6d, 0x0a, 0x12, 0x0b, 0x76, 0x98, 0x82, 0x84, 0x44, 0xa5, 0xca, 0x2d,
-	0x12, 0xb0, 0x89, 0x26, 0xf6, 0xd4, 0xb5, 0x6a, 0x7b, 0xac, 0x99, 0x49, 0xda, 0x88, 0x05, 0x12,
-	0x0b, 0x76, 0x48, 0x15, 0x0b, 0x1e, 0xa2, 0xaf, 0xc1, 0xa6, 0xcb, 0x8a, 0x15, 0x62, 0xd1, 0x42,
-	0xfa, 0x22, 0x68, 0xc6, 0x6e, 0xe3, 0xa4, 0x69, 0xa1, 0x52, 0x36, 0xac, 0x32, 0x3e, 0x73, 0xce,
-	0xf9, 0x7d, 0xfe, 0xc6, 0x67, 0x14, 0xb0, 0xb8, 0x83, 0xba, 0xc8, 0xf0, 0x42, 0x1b, 0x87, 0xdc,
-	0xeb, 0x62, 0xa3, 0xbb, 0xd2, 0xc6, 0x1c, 0xad, 0x18, 0x11, 0xa2, 0x28, 0x60, 0x7a, 0x44, 0x09,
-	0x27, 0xb0, 0x2a, 0x92, 0xf4, 0xf3, 0x24, 0x3d, 0x49, 0xaa, 0x69, 0x36, 0x61, 0x01, 0x61, 0x46,
-	0x1b, 0xb1, 0x41, 0xa5, 0x4d, 0xbc, 0x30, 0xae, 0xab, 0x55, 0x5c, 0xe2, 0x12, 0xb9, 0x34, 0xc4,
-	0x2a, 0x89, 0xd6, 0x5d, 0x42, 0x5c, 0x1f, 0x1b, 0xf2, 0xa9, 0xdd, 0xd9, 0x32, 0xb8, 0x17, 0x60,
-	0xc6, 0x51, 0x10, 0xc5, 0x09, 0x8d, 0x8f, 0x59, 0x30, 0x6d, 0xe1, 0x5d, 0x44, 0x9d, 0x75, 0x4c,
-	0x3d, 0xe2, 0xc0, 0x2a, 0x28, 0x22, 0x5b, 0x90, 0x55, 0x65, 0x41, 0x69, 0x96, 0xac, 0xe4, 0x09,
-	0xde, 0x06, 0xff, 0xd8, 0xc4, 0xf7, 0x11, 0xc7, 0x14, 0xf9, 0x2d, 0xde, 0x8b, 0xb0, 0x9a, 0x5d,
-	0x50, 0x9a, 0x65, 0xeb, 0xef, 0x41, 0x78, 0xb3, 0x17, 0x61, 0xf8, 0x10, 0x14, 0x18, 0x47, 0x94,
-	0xab, 0xb9, 0x05, 0xa5, 0x39, 0x75, 0xaf, 0xa6, 0xc7, 0x12, 0xf4, 0x33, 0x09, 0xfa, 0xe6, 0x99,
-	0x04, 0xb3, 0x74, 0x78, 0x5c, 0xcf, 0xec, 0x9f, 0xd4, 0x15, 0x2b, 0x2e, 0x81, 0x0f, 0x40, 0x0e,
-	0x87, 0x8e, 0x9a, 0xbf, 0x46, 0xa5, 0x28, 0x80, 0x6b, 0x00, 0x52, 0xf9, 0x12, 0xac, 0x15, 0x61,
-	0xda, 0x62, 0xd8, 0x26, 0xa1, 0xa3, 0x16, 0x64, 0x9b, 0xff, 0xf4, 0xd8, 0x39, 0x5d, 0x38, 0x77,
-	0x66, 0xa7, 0xfe, 0x98, 0x78, 0xa1, 0x99, 0x17, 0x5d, 0xac, 0xd9, 0xa4, 0x74, 0x1d, 0xd3, 0x0d,
-	0x59, 0xd8, 0xf8, 0x9c, 0x05, 0x73, 0x6b, 0x1d, 0x9f, 0x7b, 0x7f, 0xbe, 0x33, 0xbd, 0x4b, 0x9c,
-	0xc9, 0x5d, 0xed, 0xcc, 0x5d, 0xd1, 0xe5, 0xe0, 0xa4, 0xde, 0x74, 0x3d, 0xbe, 0xdd, 0x69, 0xeb,
-	0x36, 0x09, 0x8c, 0xe4, 0x03, 0x8c, 0x7f, 0x96, 0x99, 0xb3, 0x63, 0x88, 0x77, 0x65, 0xb2, 0x80,
-	0x8d, 0x71, 0xf1, 0x83, 0x02, 0x80, 0x74, 0x31, 0xf2, 0x3d, 0x4c, 0x21, 0x04, 0xf9, 0x10, 0x05,
-	0xb1, 0x79, 0x65, 0x4b, 0xae, 0xe1, 0x22, 0x98, 0x09, 0x48, 0xc8, 0xb7, 0x59, 0xcb, 0x27, 0xf6,
-	0x4e, 0x27, 0x92, 0xc6, 0xe5, 0xac, 0xe9, 0x38, 0xf8, 0x5c, 0xc6, 0xe0, 0x53, 0x50, 0xdc, 0x42,
-	0x36, 0x27, 0x54, 0xfa, 0x36, 0x6d, 0xea, 0x42, 0xdb, 0xb7, 0xe3, 0xfa, 0xad, 0xdf, 0xd0, 0xb6,
-	0x8a, 0x6d, 0x2b, 0xa9, 0x6e, 0xbc, 0x57, 0xc0, 0xfc, 0x40, 0x8f, 0x10, 0xba, 0x8a, 0x43, 0x12,
-	0xc0, 0x0a, 0x28, 0x38, 0x62, 0x91, 0x28, 0x8b, 0x1f, 0xe0, 0x2b, 0x30, 0x15, 0x0c, 0x92, 0xd5,
-	0xac, 0x74, 0xac, 0xa1, 0x8f, 0x9f, 0x4e, 0x7d, 0xd0, 0xd7, 0x9c, 0x4f, 0xac, 0x9b, 0x4a, 0xb1,
-	0xac, 0x74, 0xaf, 0xc6, 0x97, 0x12, 0x28, 0xae, 0xcb, 0x99, 0x87, 0x9f, 0x14, 0xf0, 0x7f, 0x87,
-	0x39, 0x7b, 0xad, 0xc0, 0x0b, 0xb9, 0x17, 0xba, 0xad, 0xd8, 0x45, 0x71, 0x56, 0x1e, 0x71, 0x98,
-	0xaa, 0x48, 0xec, 0xcd, 0xcb, 0xb0, 0xe9, 0xef, 0xd3, 0x5c, 0x11, 0xe0, 0xfe, 0x71, 0x5d, 0x7d,
-	0xb1, 0xb1, 0xfa, 0x72, 0x2d, 0xee, 0x97, 0x4e, 0x60, 0x07, 0x27, 0xf5, 0x99, 0xa1, 0x80, 0xa5,
-	0x0a, 0xf6, 0xb8, 0x54, 0xf8, 0x4e, 0x01, 0xb5, 0x6d, 0xa1, 0x84, 0x75, 0xa2, 0xc8, 0xef, 0x8d,
-	0xea, 0x8a, 0xed, 0xb8, 0x73, 0xa5, 0x1d, 0x43, 0xe2, 0x6a, 0x89, 0x2b, 0xf0, 0xc2, 0x16, 0xb3,
-	0xfe, 0x15, 0xa0, 0x0d, 0xc9, 0xb9, 0x44, 0x44, 0x9b, 0x50, 0x4a, 0x76, 0x47, 0x45, 0xe4, 0x26,
-	0x2e, 0xc2, 0x94, 0x9c, 0x61, 0x11, 0x6f, 0x81, 0xea, 0x60, 0x1f, 0xbb, 0x88, 0x13, 0x3a, 0xaa,
-	0x20, 0x3f, 0x49, 0x05, 0xd5, 0x73, 0xcc, 0xb0, 0x80, 0x0e, 0x98, 0x67, 0xbb, 0x28, 0x1a, 0x65,
-	0x17, 0x26, 0xc9, 0x9e, 0x13, 0x84, 0x61, 0x6c, 0x17, 0xcc, 0xd9, 0x3e, 0xf2, 0x82, 0x56, 0x7a,
-	0x0c, 0x8a, 0x12, 0xba, 0xf4, 0xeb, 0x31, 0x38, 0x1f, 0x2f, 0xf3, 0x46, 0x82, 0xad, 0x8c, 0xd9,
-	0x64, 0xd6, 0xac, 0x64, 0xa4, 0xb6, 0xe0, 0x23, 0x50, 0x8e, 0xb9, 0xe2, 0xbe, 0xfb, 0xeb, 0x1a,
-	0xf7, 0x5d, 0x49, 0x96, 0x3d, 0x09, 0x1d, 0xf8, 0x06, 0x54, 0x19, 0xea, 0x7a, 0xa1, 0xcb, 0x46,
-	0x4d, 0x2b, 0x4d, 0xd2, 0xb4, 0x4a, 0x02, 0xb9, 0x70, 0x5c, 0x18, 0xd1, 0x70, 0x94, 0x5c, 0x9e,
-	0xe8, 0x71, 0x09, 0xc2, 0x50, 0xc8, 0x7c, 0x76, 0xf8, 0x43, 0xcb, 0x1c, 0xf6, 0x35, 0xe5, 0xa8,
-	0xaf, 0x29, 0xdf, 0xfb, 0x9a, 0xb2, 0x7f, 0xaa, 0x65, 0x8e, 0x4e, 0xb5, 0xcc, 0xd7, 0x53, 0x2d,
-	0xf3, 0x7a, 0x29, 0x75, 0x57, 0x0a, 0x05, 0xcb, 0x3e, 0x6a, 0x33, 0xb9, 0x32, 0xf6, 0x52, 0xff,
-	0x48, 0xe4, 0xa5, 0xd9, 0x2e, 0x4a, 0x9b, 0xef, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xca, 0x77,
-	0xf8, 0x09, 0xb0, 0x08, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x93, 0x36, 0x34, 0xd3, 0x2e, 0x6c, 0xa7, 0x21, 0x98, 0x80, 0x9c, 0x2a, 0x8b, 0xa0,
+	0x68, 0x55, 0x9b, 0x82, 0xc4, 0x81, 0x1b, 0xa6, 0x20, 0x21, 0x51, 0xa9, 0x72, 0x17, 0x89, 0xe5,
+	0x62, 0x4d, 0xec, 0x59, 0x77, 0x54, 0x7b, 0xc6, 0x9a, 0x19, 0xa7, 0x1b, 0x71, 0x40, 0xe2, 0x00,
+	0x27, 0xa4, 0x15, 0x07, 0xc4, 0x6f, 0xd8, 0x9f, 0xc0, 0x95, 0x4b, 0x8f, 0x7b, 0x44, 0x08, 0xb5,
+	0x90, 0xfe, 0x11, 0x34, 0x63, 0xbb, 0x71, 0xbc, 0xc9, 0xc2, 0x8a, 0x5c, 0xf6, 0x94, 0x99, 0xf7,
+	0xeb, 0x79, 0xe6, 0x79, 0x67, 0xde, 0x18, 0xdc, 0x39, 0x43, 0x63, 0xe4, 0x10, 0x1a, 0x60, 0x2a,
+	0xc9, 0x18, 0x3b, 0xe3, 0x83, 0x11, 0x96, 0xe8, 0xc0, 0x49, 0x11, 0x47, 0x89, 0xb0, 0x53, 0xce,
+	0x24, 0x83, 0x3d, 0x15, 0x64, 0xdf, 0x04, 0xd9, 0x45, 0x50, 0xdf, 0x0a, 0x98, 0x48, 0x98, 0x70,
+	0x46, 0x48, 0xcc, 0x32, 0x03, 0x46, 0x68, 0x9e, 0xd7, 0xef, 0x46, 0x2c, 0x62, 0x7a, 0xe9, 0xa8,
+	0x55, 0x61, 0xb5, 0x22, 0xc6, 0xa2, 0x18, 0x3b, 0x7a, 0x37, 0xca, 0x1e, 0x38, 0x61, 0xc6, 0x91,
+	0x24, 0xac, 0xcc, 0x1a, 0xd4, 0xfd, 0x92, 0x24, 0x58, 0x48, 0x94, 0xa4, 0x79, 0xc0, 0xf0, 0xa7,
+	0x26, 0xd8, 0xf2, 0xf0, 0x39, 0xe2, 0xe1, 0x31, 0xe6, 0x84, 0x85, 0xb0, 0x07, 0xda, 0x28, 0x50,
+	0xcc, 0x4c, 0x63, 0xd7, 0xd8, 0xdb, 0xf0, 0x8a, 0x1d, 0x7c, 0x07, 0xbc, 0x12, 0xb0, 0x38, 0x46,
+	0x12, 0x73, 0x14, 0xfb, 0x72, 0x92, 0x62, 0xb3, 0xb9, 0x6b, 0xec, 0x75, 0xbc, 0x97, 0x67, 0xe6,
+	0x7b, 0x93, 0x14, 0xc3, 0x8f, 0xc0, 0xba, 0x90, 0x88, 0x4b, 0xb3, 0xb5, 0x6b, 0xec, 0x6d, 0xbe,
+	0xdf, 0xb7, 0x73, 0x0a, 0x76, 0x49, 0xc1, 0xbe, 0x57, 0x52, 0x70, 0x37, 0x2e, 0x2e, 0x07, 0x8d,
+	0x47, 0x57, 0x03, 0xc3, 0xcb, 0x53, 0xe0, 0x87, 0xa0, 0x85, 0x69, 0x68, 0xae, 0x3d, 0x47, 0xa6,
+	0x4a, 0x80, 0x47, 0x00, 0x72, 0x7d, 0x08, 0xe1, 0xa7, 0x98, 0xfb, 0x02, 0x07, 0x8c, 0x86, 0xe6,
+	0xba, 0x2e, 0xf3, 0xba, 0x9d, 0x2b, 0x6b, 0x2b, 0x65, 0x4b, 0xb9, 0xed, 0x4f, 0x18, 0xa1, 0xee,
+	0x9a, 0xaa, 0xe2, 0xdd, 0x2e, 0x52, 0x8f, 0x31, 0x3f, 0xd1, 0x89, 0xc3, 0xdf, 0x9a, 0x60, 0xfb,
+	0x28, 0x8b, 0x25, 0x79, 0xf1, 0x95, 0x99, 0x2c, 0x51, 0xa6, 0xf5, 0x6c, 0x65, 0xde, 0x53, 0x55,
+	0x1e, 0x5f, 0x0d, 0xf6, 0x22, 0x22, 0x4f, 0xb3, 0x91, 0x1d, 0xb0, 0xc4, 0x29, 0x2e, 0x68, 0xfe,
+	0xb3, 0x2f, 0xc2, 0x33, 0x47, 0x9d, 0x55, 0xe8, 0x04, 0xb1, 0x40, 0xc5, 0x1f, 0x0d, 0x00, 0xb4,
+	0x8a, 0x69, 0x4c, 0x30, 0x87, 0x10, 0xac, 0x51, 0x94, 0xe4, 0xe2, 0x75, 0x3c, 0xbd, 0x86, 0x77,
+	0xc0, 0xad, 0x84, 0x51, 0x79, 0x2a, 0xfc, 0x98, 0x05, 0x67, 0x59, 0xaa, 0x85, 0x6b, 0x79, 0x5b,
+	0xb9, 0xf1, 0x0b, 0x6d, 0x83, 0x9f, 0x81, 0xf6, 0x03, 0x14, 0x48, 0xc6, 0xb5, 0x6e, 0x5b, 0xae,
+	0xad, 0xb8, 0xfd, 0x71, 0x39, 0x78, 0xfb, 0x3f, 0x70, 0x3b, 0xc4, 0x81, 0x57, 0x64, 0x0f, 0xbf,
+	0x37, 0xc0, 0xce, 0x8c, 0x8f, 0x22, 0x7a, 0x88, 0x29, 0x4b, 0x60, 0x17, 0xac, 0x87, 0x6a, 0x51,
+	0x30, 0xcb, 0x37, 0xf0, 0x3e, 0xd8, 0x4c, 0x66, 0xc1, 0x66, 0x53, 0x2b, 0x36, 0xb4, 0x17, 0xbf,
+	0x5e, 0x7b, 0x56, 0xd7, 0xdd, 0x29, 0xa4, 0xdb, 0xac, 0x60, 0x79, 0xd5, 0x5a, 0xc3, 0x5f, 0x0d,
+	0xf0, 0xea, 0x09, 0x1a, 0x13, 0x1a, 0xe9, 0x23, 0x56, 0x34, 0xba, 0x0f, 0x7a, 0x09, 0xa1, 0x3e,
+	0xc7, 0x09, 0x22, 0x94, 0xd0, 0xc8, 0x2f, 0x9f, 0xb3, 0xe6, 0xa6, 0x3a, 0x56, 0x6f, 0xfc, 0x61,
+	0x11, 0x90, 0xf7, 0xfd, 0x17, 0xd5, 0xf7, 0x6e, 0x42, 0xa8, 0x57, 0x56, 0x28, 0xfd, 0x15, 0x15,
+	0x9b, 0xff, 0x4b, 0xc5, 0x3f, 0x3b, 0xa0, 0x7d, 0xac, 0x07, 0x1a, 0xfc, 0xd9, 0x00, 0x6f, 0x64,
+	0x22, 0x7c, 0xe8, 0x27, 0x84, 0x4a, 0xc5, 0x36, 0xbf, 0x02, 0xea, 0xa2, 0x11, 0x16, 0x0a, 0xd3,
+	0xd0, 0x9a, 0xbd, 0xb5, 0x4c, 0xb3, 0xea, 0xe3, 0x72, 0x0f, 0x14, 0x9d, 0xe9, 0xe5, 0xc0, 0xfc,
+	0xf2, 0xe4, 0xf0, 0xab, 0xa3, 0xbc, 0x5e, 0x35, 0x40, 0x3c, 0xbe, 0x1a, 0xdc, 0x9a, 0x33, 0x78,
+	0xa6, 0xc2, 0x5e, 0x14, 0x0a, 0xbf, 0x33, 0x40, 0xff, 0x54, 0x31, 0x11, 0x59, 0x9a, 0xc6, 0x93,
+	0x3a, 0xaf, 0xbc, 0x97, 0xef, 0x3e, 0xb3, 0x97, 0x73, 0xe4, 0xfa, 0x45, 0x4b, 0xe1, 0x53, 0x2e,
+	0xe1, 0xbd, 0xa6, 0x80, 0x4e, 0x34, 0xce, 0x12, 0x12, 0x23, 0xc6, 0x39, 0x3b, 0xaf, 0x93, 0x68,
+	0xad, 0x9c, 0x84, 0xab, 0x71, 0xe6, 0x49, 0x7c, 0x0b, 0xcc, 0x10, 0xc7, 0x38, 0x42, 0x92, 0xf1,
+	0x3a, 0x83, 0xb5, 0x55, 0x32, 0xe8, 0xdd, 0xc0, 0xcc, 0x13, 0xc8, 0xc0, 0x8e, 0x38, 0x47, 0x69,
+	0x1d, 0x7b, 0x7d, 0x95, 0xd8, 0xdb, 0x0a, 0x61, 0x1e, 0x76, 0x0c, 0xb6, 0x83, 0x18, 0x91, 0xc4,
+	0xaf, 0xbe, 0xe1, 0xb6, 0x06, 0xbd, 0xfb, 0xef, 0x6f, 0xf8, 0x66, 0x36, 0xb8, 0x6f, 0x16, 0xb0,
+	0xdd, 0x05, 0x4e, 0xe1, 0xdd, 0xd6, 0x18, 0x15, 0x17, 0xfc, 0x18, 0x74, 0x72, 0x5c, 0x35, 0xac,
+	0x5f, 0x7a, 0x8e, 0x61, 0xbd, 0xa1, 0xd3, 0x3e, 0xa5, 0x21, 0xfc, 0x06, 0xf4, 0x44, 0x3e, 0x1c,
+	0xea, 0xa2, 0x6d, 0xac, 0x52, 0xb4, 0x6e, 0x01, 0xf2, 0x54, 0xbb, 0x30, 0xe2, 0xb4, 0x8e, 0xdc,
+	0x59, 0x69, 0xbb, 0x14, 0xc2, 0x3c, 0xec, 0x0f, 0x06, 0x30, 0xcb, 0x43, 0xab, 0x7f, 0x82, 0xb9,
+	0xb6, 0x01, 0x0d, 0xbe, 0xbf, 0x0c, 0x7c, 0xe1, 0x24, 0x75, 0xad, 0x82, 0x40, 0x6f, 0xa1, 0x5b,
+	0x78, 0x3d, 0xb1, 0xd0, 0xee, 0x7e, 0x7e, 0xf1, 0xb7, 0xd5, 0xb8, 0x98, 0x5a, 0xc6, 0x93, 0xa9,
+	0x65, 0xfc, 0x35, 0xb5, 0x8c, 0x47, 0xd7, 0x56, 0xe3, 0xc9, 0xb5, 0xd5, 0xf8, 0xfd, 0xda, 0x6a,
+	0x7c, 0x7d, 0xb7, 0x32, 0x2c, 0x15, 0x9d, 0xfd, 0x18, 0x8d, 0x84, 0x5e, 0x39, 0x0f, 0x2b, 0x1f,
+	0x7e, 0x7a, 0x6a, 0x8e, 0xda, 0xba, 0xe1, 0x1f, 0xfc, 0x33, 0x00, 0xd3, 0xc5, 0x07, 0x39, 0x17,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
@@ -528,6 +577,47 @@ func (m *MultipliersPerDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SavingsLockMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SavingsLockMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SavingsLockMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinRemainingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinRemainingDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -548,6 +638,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SavingsLockMultipliers) > 0 {
+		for iNdEx := len(m.SavingsLockMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavingsLockMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.EarnRewardPeriods) > 0 {
 		for iNdEx := len(m.EarnRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -576,12 +680,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x42
 		}
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimEnd):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimEnd):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.ClaimMultipliers) > 0 {
@@ -767,6 +871,19 @@ func (m *MultipliersPerDenom) Size() (n int) {
 	return n
 }
 
+func (m *SavingsLockMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinRemainingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Factor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -823,6 +940,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.SavingsLockMultipliers) > 0 {
+		for _, e := range m.SavingsLockMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1485,6 +1608,122 @@ func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SavingsLockMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SavingsLockMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SavingsLockMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRemainingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinRemainingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1819,6 +2058,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsLockMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavingsLockMultipliers = append(m.SavingsLockMultipliers, SavingsLockMultiplier{})
+			if err := m.SavingsLockMultipliers[len(m.SavingsLockMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -402,6 +402,107 @@ func (suite *ParamTestSuite) TestMultiRewardPeriods() {
 	})
 }
 
+func (suite *ParamTestSuite) TestSavingsLockMultipliers() {
+	month := 30 * 24 * time.Hour
+
+	suite.Run("Validate", func() {
+		testCases := []struct {
+			name        string
+			multipliers types.SavingsLockMultipliers
+			contains    string
+		}{
+			{
+				name: "valid multipliers",
+				multipliers: types.SavingsLockMultipliers{
+					types.NewSavingsLockMultiplier(month, sdk.MustNewDecFromStr("1.5")),
+					types.NewSavingsLockMultiplier(6*month, sdk.NewDec(2)),
+				},
+			},
+			{
+				name: "factor below one is invalid",
+				multipliers: types.SavingsLockMultipliers{
+					types.NewSavingsLockMultiplier(month, sdk.MustNewDecFromStr("0.5")),
+				},
+				contains: "expected factor of at least 1",
+			},
+			{
+				name: "negative duration is invalid",
+				multipliers: types.SavingsLockMultipliers{
+					types.NewSavingsLockMultiplier(-month, sdk.NewDec(2)),
+				},
+				contains: "expected non-negative minimum remaining duration",
+			},
+			{
+				name: "duplicated duration is invalid",
+				multipliers: types.SavingsLockMultipliers{
+					types.NewSavingsLockMultiplier(month, sdk.NewDec(2)),
+					types.NewSavingsLockMultiplier(month, sdk.NewDec(3)),
+				},
+				contains: "duplicate minimum remaining duration",
+			},
+		}
+		for _, tc := range testCases {
+			err := tc.multipliers.Validate()
+
+			if tc.contains == "" {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Contains(err.Error(), tc.contains)
+			}
+		}
+	})
+
+	suite.Run("Get", func() {
+		multipliers := types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(6*month, sdk.NewDec(2)),
+			types.NewSavingsLockMultiplier(month, sdk.MustNewDecFromStr("1.5")),
+		}
+
+		suite.Equal(sdk.OneDec(), multipliers.Get(month-time.Second))
+		suite.Equal(sdk.MustNewDecFromStr("1.5"), multipliers.Get(month))
+		suite.Equal(sdk.NewDec(2), multipliers.Get(12*month))
+		suite.Equal(sdk.OneDec(), types.SavingsLockMultipliers{}.Get(12*month))
+	})
+
+	suite.Run("Tier", func() {
+		multipliers := types.SavingsLockMultipliers{
+			types.NewSavingsLockMultiplier(6*month, sdk.NewDec(2)),
+			types.NewSavingsLockMultiplier(month, sdk.MustNewDecFromStr("1.5")),
+		}
+
+		suite.Equal([]time.Duration{month, 6 * month}, multipliers.Tiers())
+
+		_, found := multipliers.Tier(month - time.Second)
+		suite.False(found)
+		tier, found := multipliers.Tier(month)
+		suite.True(found)
+		suite.Equal(month, tier)
+		tier, found = multipliers.Tier(12 * month)
+		suite.True(found)
+		suite.Equal(6*month, tier)
+
+		// Ended locks are in no tier, even with a tier of zero duration
+		_, found = types.SavingsLockMultipliers{types.NewSavingsLockMultiplier(0, sdk.NewDec(2))}.Tier(0)
+		suite.False(found)
+	})
+}
+
+func (suite *ParamTestSuite) TestMultipliersDefault() {
+	multipliers := types.Multipliers{
+		types.NewMultiplier("large", 12, sdk.OneDec()),
+		types.NewMultiplier("small", 1, sdk.MustNewDecFromStr("0.2")),
+		types.NewMultiplier("other_small", 1, sdk.MustNewDecFromStr("0.25")),
+	}
+
+	multiplier, found := multipliers.Default()
+	suite.True(found)
+	suite.Equal("small", multiplier.Name)
+
+	_, found = types.Multipliers{}.Default()
+	suite.False(found)
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -524,6 +524,84 @@ func (m *MsgClaimEarnRewardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimEarnRewardResponse proto.InternalMessageInfo
 
+// MsgClaimAllRewards message type used to claim all of an account's rewards at once. Denoms without a selection are
+// claimed with their default multiplier.
+type MsgClaimAllRewards struct {
+	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver      string     `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	DenomsToClaim Selections `protobuf:"bytes,3,rep,name=denoms_to_claim,json=denomsToClaim,proto3,castrepeated=Selections" json:"denoms_to_claim"`
+}
+
+func (m *MsgClaimAllRewards) Reset()         { *m = MsgClaimAllRewards{} }
+func (m *MsgClaimAllRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllRewards) ProtoMessage()    {}
+func (*MsgClaimAllRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1cec058e3ff75d5, []int{13}
+}
+func (m *MsgClaimAllRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllRewards.Merge(m, src)
+}
+func (m *MsgClaimAllRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllRewards proto.InternalMessageInfo
+
+// MsgClaimAllRewardsResponse defines the Msg/ClaimAllRewards response type.
+type MsgClaimAllRewardsResponse struct {
+}
+
+func (m *MsgClaimAllRewardsResponse) Reset()         { *m = MsgClaimAllRewardsResponse{} }
+func (m *MsgClaimAllRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimAllRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1cec058e3ff75d5, []int{14}
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllRewardsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Selection)(nil), "kava.incentive.v1beta1.Selection")
 	proto.RegisterType((*MsgClaimUSDXMintingReward)(nil), "kava.incentive.v1beta1.MsgClaimUSDXMintingReward")
@@ -538,45 +616,50 @@ func init() {
 	proto.RegisterType((*MsgClaimSavingsRewardResponse)(nil), "kava.incentive.v1beta1.MsgClaimSavingsRewardResponse")
 	proto.RegisterType((*MsgClaimEarnReward)(nil), "kava.incentive.v1beta1.MsgClaimEarnReward")
 	proto.RegisterType((*MsgClaimEarnRewardResponse)(nil), "kava.incentive.v1beta1.MsgClaimEarnRewardResponse")
+	proto.RegisterType((*MsgClaimAllRewards)(nil), "kava.incentive.v1beta1.MsgClaimAllRewards")
+	proto.RegisterType((*MsgClaimAllRewardsResponse)(nil), "kava.incentive.v1beta1.MsgClaimAllRewardsResponse")
 }
 
 func init() { proto.RegisterFile("kava/incentive/v1beta1/tx.proto", fileDescriptor_b1cec058e3ff75d5) }
 
 var fileDescriptor_b1cec058e3ff75d5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0xad, 0x5a, 0xd1, 0x87, 0x20, 0xd2, 0x29, 0x84, 0x60, 0x81, 0xdd, 0x84, 0x81,
-	0x0a, 0x54, 0x5b, 0x09, 0x42, 0x08, 0xc6, 0xd2, 0x4a, 0x2c, 0x61, 0x48, 0x8a, 0x84, 0x90, 0x50,
-	0x74, 0x49, 0x0e, 0x73, 0xc2, 0xbe, 0x33, 0xbe, 0x6b, 0x5a, 0x98, 0x98, 0x10, 0x23, 0x0b, 0x12,
-	0x62, 0xea, 0xcc, 0x27, 0xe9, 0xd8, 0x91, 0x09, 0x50, 0x22, 0x21, 0x3e, 0x06, 0x8a, 0x93, 0xd8,
-	0x56, 0x6d, 0x63, 0xd2, 0x29, 0x9b, 0xed, 0xf7, 0x7f, 0xef, 0xfd, 0xfe, 0x4f, 0x7e, 0x77, 0x60,
-	0xbe, 0x26, 0x43, 0x62, 0x33, 0xde, 0xa7, 0x5c, 0xb1, 0x21, 0xb5, 0x87, 0x8d, 0x1e, 0x55, 0xa4,
-	0x61, 0xab, 0x23, 0xcb, 0x0f, 0x84, 0x12, 0xb8, 0x32, 0x11, 0x58, 0x91, 0xc0, 0x9a, 0x09, 0xf4,
-	0xb2, 0x23, 0x1c, 0x11, 0x4a, 0xec, 0xc9, 0xd3, 0x54, 0x5d, 0xdf, 0x87, 0x8d, 0x0e, 0x75, 0x69,
-	0x5f, 0x31, 0xc1, 0x71, 0x19, 0xd6, 0x06, 0x94, 0x0b, 0xaf, 0x8a, 0x36, 0xd1, 0xd6, 0x46, 0x7b,
-	0xfa, 0x82, 0x6f, 0x41, 0xc9, 0x3b, 0x70, 0x15, 0xf3, 0x5d, 0x46, 0x83, 0x2e, 0x27, 0x1e, 0xad,
-	0xae, 0x84, 0xf1, 0xcb, 0xf1, 0xe7, 0x27, 0xc4, 0xa3, 0x0f, 0x2f, 0x7c, 0x3c, 0x36, 0xb5, 0x3f,
-	0xc7, 0xa6, 0x56, 0x7f, 0x09, 0xd7, 0x5a, 0xd2, 0x79, 0xe4, 0x12, 0xe6, 0x3d, 0xed, 0xec, 0x3e,
-	0x6b, 0x31, 0xae, 0x18, 0x77, 0xda, 0xf4, 0x90, 0x04, 0x03, 0x5c, 0x81, 0x75, 0x49, 0xf9, 0x80,
-	0x06, 0xb3, 0x36, 0xb3, 0xb7, 0xf3, 0xf4, 0xb9, 0x09, 0xb5, 0xdc, 0x3e, 0x6d, 0x2a, 0x7d, 0xc1,
-	0x25, 0xad, 0x7f, 0x46, 0x80, 0xe7, 0xaa, 0xc7, 0x61, 0xe0, 0x9f, 0x18, 0x2f, 0xa0, 0x14, 0xfa,
-	0x96, 0x5d, 0x25, 0xba, 0xfd, 0x49, 0x52, 0x75, 0x65, 0x73, 0x75, 0xeb, 0x62, 0xb3, 0x66, 0x65,
-	0x4f, 0xd6, 0x8a, 0x06, 0xb8, 0x83, 0x4f, 0x7e, 0x98, 0xda, 0xb7, 0x9f, 0x26, 0x44, 0x9f, 0x64,
-	0xfb, 0xd2, 0xb4, 0xda, 0xbe, 0x08, 0x01, 0x12, 0xf0, 0xd7, 0x41, 0x4f, 0x63, 0x45, 0xd4, 0x5f,
-	0x11, 0x5c, 0x9d, 0x87, 0x77, 0xa9, 0x4b, 0x1d, 0xa2, 0x44, 0xb0, 0x2c, 0xe8, 0x35, 0x30, 0x73,
-	0xd8, 0x32, 0xa7, 0xde, 0x39, 0x24, 0xfe, 0x12, 0x4e, 0x3d, 0xc6, 0x8a, 0xa8, 0xbf, 0x20, 0xb8,
-	0x12, 0x85, 0xc9, 0x90, 0x71, 0x47, 0x2e, 0x0b, 0xb8, 0x09, 0x37, 0x32, 0xc9, 0x32, 0x27, 0xbe,
-	0x47, 0x02, 0xbe, 0x84, 0x13, 0x8f, 0xb1, 0xe6, 0xd4, 0xcd, 0xdf, 0x6b, 0xb0, 0xda, 0x92, 0x0e,
-	0xfe, 0x80, 0xa0, 0x92, 0x73, 0x60, 0x34, 0xf2, 0x80, 0x72, 0x77, 0x5f, 0x7f, 0xb0, 0x70, 0xca,
-	0x1c, 0x08, 0xbf, 0x81, 0xd2, 0xd9, 0xa3, 0xe2, 0x76, 0x51, 0xb5, 0x58, 0xab, 0x37, 0xff, 0x5f,
-	0x1b, 0xb5, 0x7c, 0x8f, 0xa0, 0x9c, 0xb9, 0xe8, 0x76, 0x51, 0xb1, 0x33, 0x09, 0xfa, 0xfd, 0x05,
-	0x13, 0x52, 0xae, 0x13, 0xab, 0x5a, 0xe8, 0x3a, 0xd6, 0x16, 0xbb, 0x4e, 0xef, 0x1a, 0x7e, 0x07,
-	0x38, 0x63, 0xcf, 0xb6, 0x0b, 0x2b, 0x25, 0xe5, 0xfa, 0xbd, 0x85, 0xe4, 0x29, 0xbb, 0x89, 0x3d,
-	0x29, 0xb4, 0x1b, 0x6b, 0x8b, 0xed, 0xa6, 0x7f, 0xf4, 0x9d, 0xbd, 0x93, 0x91, 0x81, 0x4e, 0x47,
-	0x06, 0xfa, 0x35, 0x32, 0xd0, 0xa7, 0xb1, 0xa1, 0x9d, 0x8e, 0x0d, 0xed, 0xfb, 0xd8, 0xd0, 0x9e,
-	0xdf, 0x71, 0x98, 0x7a, 0x75, 0xd0, 0xb3, 0xfa, 0xc2, 0xb3, 0x27, 0x75, 0xb7, 0x5d, 0xd2, 0x93,
-	0xe1, 0x93, 0x7d, 0x94, 0xb8, 0xe9, 0xd5, 0x5b, 0x9f, 0xca, 0xde, 0x7a, 0x78, 0x6f, 0xdf, 0xfd,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x28, 0x8b, 0x70, 0xee, 0x08, 0x08, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x41, 0x8b, 0xd3, 0x4e,
+	0x14, 0xc0, 0x93, 0x2d, 0xff, 0xb2, 0xfb, 0xfe, 0x68, 0x21, 0xd4, 0x5a, 0x83, 0x26, 0xdb, 0x7a,
+	0x70, 0x51, 0x36, 0xa1, 0x11, 0x11, 0xbd, 0xb9, 0xee, 0x82, 0x97, 0x7a, 0x68, 0x57, 0x10, 0x41,
+	0xca, 0xb4, 0x1d, 0xe3, 0x60, 0x32, 0x53, 0x33, 0xb3, 0xdd, 0xd5, 0x93, 0x27, 0xf1, 0xe8, 0x45,
+	0x10, 0x4f, 0x7b, 0xf6, 0xe6, 0xb7, 0xd8, 0xe3, 0x1e, 0xf5, 0xa2, 0xd2, 0x5e, 0xfc, 0x18, 0xd2,
+	0xb4, 0x99, 0x84, 0x6d, 0x62, 0x5a, 0x41, 0xe8, 0x2d, 0x93, 0xf7, 0x9b, 0xf7, 0x7e, 0xef, 0x41,
+	0x1e, 0x01, 0xf3, 0x05, 0x1a, 0x22, 0x9b, 0xd0, 0x1e, 0xa6, 0x82, 0x0c, 0xb1, 0x3d, 0x6c, 0x74,
+	0xb1, 0x40, 0x0d, 0x5b, 0x1c, 0x59, 0x83, 0x80, 0x09, 0xa6, 0x55, 0x26, 0x80, 0x25, 0x01, 0x6b,
+	0x06, 0xe8, 0x65, 0x97, 0xb9, 0x2c, 0x44, 0xec, 0xc9, 0xd3, 0x94, 0xae, 0xef, 0xc3, 0x46, 0x1b,
+	0x7b, 0xb8, 0x27, 0x08, 0xa3, 0x5a, 0x19, 0xfe, 0xeb, 0x63, 0xca, 0xfc, 0xaa, 0xba, 0xa9, 0x6e,
+	0x6d, 0xb4, 0xa6, 0x07, 0xed, 0x1a, 0x94, 0xfc, 0x03, 0x4f, 0x90, 0x81, 0x47, 0x70, 0xd0, 0xa1,
+	0xc8, 0xc7, 0xd5, 0xb5, 0x30, 0x7e, 0x3e, 0x7e, 0xfd, 0x10, 0xf9, 0xf8, 0xee, 0xfa, 0xbb, 0x63,
+	0x53, 0xf9, 0x75, 0x6c, 0x2a, 0xf5, 0x67, 0x70, 0xa9, 0xc9, 0xdd, 0xfb, 0x1e, 0x22, 0xfe, 0xa3,
+	0xf6, 0xee, 0xe3, 0x26, 0xa1, 0x82, 0x50, 0xb7, 0x85, 0x0f, 0x51, 0xd0, 0xd7, 0x2a, 0x50, 0xe4,
+	0x98, 0xf6, 0x71, 0x30, 0x2b, 0x33, 0x3b, 0xfd, 0x4d, 0x9d, 0xab, 0x50, 0xcb, 0xac, 0xd3, 0xc2,
+	0x7c, 0xc0, 0x28, 0xc7, 0xf5, 0x0f, 0x2a, 0x68, 0x11, 0xf5, 0x20, 0x0c, 0xfc, 0x51, 0xe3, 0x29,
+	0x94, 0xc2, 0xbe, 0x79, 0x47, 0xb0, 0x4e, 0x6f, 0x72, 0xa9, 0xba, 0xb6, 0x59, 0xd8, 0xfa, 0xdf,
+	0xa9, 0x59, 0xe9, 0x93, 0xb5, 0xe4, 0x00, 0x77, 0xb4, 0x93, 0xef, 0xa6, 0xf2, 0xf9, 0x87, 0x09,
+	0xf2, 0x15, 0x6f, 0x9d, 0x9b, 0x66, 0xdb, 0x67, 0xa1, 0x40, 0x42, 0xfe, 0x32, 0xe8, 0xf3, 0x5a,
+	0xd2, 0xfa, 0x93, 0x0a, 0x17, 0xa3, 0xf0, 0x2e, 0xf6, 0xb0, 0x8b, 0x04, 0x0b, 0x56, 0x45, 0xbd,
+	0x06, 0x66, 0x86, 0x5b, 0xea, 0xd4, 0xdb, 0x87, 0x68, 0xb0, 0x82, 0x53, 0x8f, 0xb5, 0xa4, 0xf5,
+	0x47, 0x15, 0x2e, 0xc8, 0x30, 0x1a, 0x12, 0xea, 0xf2, 0x55, 0x11, 0x37, 0xe1, 0x4a, 0xaa, 0x59,
+	0xea, 0xc4, 0xf7, 0x50, 0x40, 0x57, 0x70, 0xe2, 0xb1, 0x96, 0xb4, 0xfe, 0x92, 0xb0, 0xbe, 0xe7,
+	0x79, 0xd3, 0x28, 0xcf, 0xb4, 0xd6, 0x61, 0x3d, 0xc0, 0x3d, 0x4c, 0x86, 0x38, 0x98, 0x6d, 0x07,
+	0x79, 0x4e, 0xeb, 0xa8, 0xf0, 0xaf, 0x3b, 0x8a, 0x95, 0xa3, 0x8e, 0x9c, 0x6f, 0x45, 0x28, 0x34,
+	0xb9, 0xab, 0xbd, 0x55, 0xa1, 0x92, 0xb1, 0x02, 0x1b, 0x59, 0x42, 0x99, 0xdb, 0x4c, 0xbf, 0xb3,
+	0xf4, 0x95, 0x48, 0x48, 0x7b, 0x09, 0xa5, 0xb3, 0xcb, 0xef, 0x7a, 0x5e, 0xb6, 0x98, 0xd5, 0x9d,
+	0xc5, 0x59, 0x59, 0xf2, 0x8d, 0x0a, 0xe5, 0xd4, 0xd5, 0x65, 0xe7, 0x25, 0x3b, 0x73, 0x41, 0xbf,
+	0xbd, 0xe4, 0x85, 0xb9, 0xae, 0x13, 0xcb, 0x27, 0xb7, 0xeb, 0x98, 0xd5, 0x9d, 0xc5, 0x59, 0x59,
+	0xf2, 0x35, 0x68, 0x29, 0x9b, 0x63, 0x3b, 0x37, 0x53, 0x12, 0xd7, 0x6f, 0x2d, 0x85, 0xcf, 0xb5,
+	0x9b, 0xf8, 0xf2, 0x73, 0xdb, 0x8d, 0x59, 0xdd, 0x59, 0x9c, 0x9d, 0x2b, 0x99, 0xf8, 0x6c, 0x73,
+	0x4b, 0xc6, 0xac, 0xee, 0x2c, 0xce, 0x46, 0x25, 0x77, 0xf6, 0x4e, 0x46, 0x86, 0x7a, 0x3a, 0x32,
+	0xd4, 0x9f, 0x23, 0x43, 0x7d, 0x3f, 0x36, 0x94, 0xd3, 0xb1, 0xa1, 0x7c, 0x1d, 0x1b, 0xca, 0x93,
+	0x1b, 0x2e, 0x11, 0xcf, 0x0f, 0xba, 0x56, 0x8f, 0xf9, 0xf6, 0x24, 0xef, 0xb6, 0x87, 0xba, 0x3c,
+	0x7c, 0xb2, 0x8f, 0x12, 0xbf, 0x4b, 0xe2, 0xd5, 0x00, 0xf3, 0x6e, 0x31, 0xfc, 0xf9, 0xb9, 0xf9,
+	0x7b, 0x00, 0xcc, 0xd2, 0xf1, 0xdf, 0x4d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -603,6 +686,8 @@ type MsgClient interface {
 	ClaimSavingsReward(ctx context.Context, in *MsgClaimSavingsReward, opts ...grpc.CallOption) (*MsgClaimSavingsRewardResponse, error)
 	// ClaimEarnReward is a message type used to claim earn rewards
 	ClaimEarnReward(ctx context.Context, in *MsgClaimEarnReward, opts ...grpc.CallOption) (*MsgClaimEarnRewardResponse, error)
+	// ClaimAllRewards is a message type used to claim all of an account's rewards at once
+	ClaimAllRewards(ctx context.Context, in *MsgClaimAllRewards, opts ...grpc.CallOption) (*MsgClaimAllRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -667,6 +752,15 @@ func (c *msgClient) ClaimEarnReward(ctx context.Context, in *MsgClaimEarnReward,
 	return out, nil
 }
 
+func (c *msgClient) ClaimAllRewards(ctx context.Context, in *MsgClaimAllRewards, opts ...grpc.CallOption) (*MsgClaimAllRewardsResponse, error) {
+	out := new(MsgClaimAllRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kava.incentive.v1beta1.Msg/ClaimAllRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ClaimUSDXMintingReward is a message type used to claim USDX minting rewards
@@ -681,6 +775,8 @@ type MsgServer interface {
 	ClaimSavingsReward(context.Context, *MsgClaimSavingsReward) (*MsgClaimSavingsRewardResponse, error)
 	// ClaimEarnReward is a message type used to claim earn rewards
 	ClaimEarnReward(context.Context, *MsgClaimEarnReward) (*MsgClaimEarnRewardResponse, error)
+	// ClaimAllRewards is a message type used to claim all of an account's rewards at once
+	ClaimAllRewards(context.Context, *MsgClaimAllRewards) (*MsgClaimAllRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -705,6 +801,9 @@ func (*UnimplementedMsgServer) ClaimSavingsReward(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) ClaimEarnReward(ctx context.Context, req *MsgClaimEarnReward) (*MsgClaimEarnRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimEarnReward not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAllRewards(ctx context.Context, req *MsgClaimAllRewards) (*MsgClaimAllRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -818,6 +917,24 @@ func _Msg_ClaimEarnReward_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAllRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.incentive.v1beta1.Msg/ClaimAllRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllRewards(ctx, req.(*MsgClaimAllRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.incentive.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -846,6 +963,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimEarnReward",
 			Handler:    _Msg_ClaimEarnReward_Handler,
 		},
+		{
+			MethodName: "ClaimAllRewards",
+			Handler:    _Msg_ClaimAllRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/incentive/v1beta1/tx.proto",
@@ -1283,6 +1404,80 @@ func (m *MsgClaimEarnRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimAllRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomsToClaim) > 0 {
+		for iNdEx := len(m.DenomsToClaim) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsToClaim[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1477,6 +1672,38 @@ func (m *MsgClaimEarnRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimAllRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimAllRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2591,6 +2818,204 @@ func (m *MsgClaimEarnRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimAllRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
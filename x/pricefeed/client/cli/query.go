@@ -2,11 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -29,6 +32,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdOracles(),
 		GetCmdMarkets(),
 		GetCmdQueryParams(),
+		GetCmdCircuitBreakers(),
+		GetCmdOracleStats(),
+		GetCmdPriceAt(),
+		GetCmdTwap(),
 	}
 
 	for _, cmd := range cmds {
@@ -202,3 +209,126 @@ func GetCmdQueryParams() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdCircuitBreakers queries the circuit breaker states of all markets
+func GetCmdCircuitBreakers() *cobra.Command {
+	return &cobra.Command{
+		Use:   "circuit-breakers",
+		Short: "get the circuit breaker state of all markets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreakers(context.Background(), &types.QueryCircuitBreakersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdOracleStats queries the performance counters of the oracles of a market
+func GetCmdOracleStats() *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-stats [marketID]",
+		Short: "get the performance stats of the oracles of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OracleStats(context.Background(), &types.QueryOracleStatsRequest{
+				MarketId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdPriceAt queries the price snapshot of a market at a point in time
+func GetCmdPriceAt() *cobra.Command {
+	return &cobra.Command{
+		Use:     "price-at [marketID] [time]",
+		Short:   "get the price of a market at a point in time",
+		Long:    "Get the latest price snapshot of a market recorded at or before an RFC3339 time.",
+		Example: fmt.Sprintf("%s q %s price-at bnb:usd 2022-07-20T00:00:00Z", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			t, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid time: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PriceAt(context.Background(), &types.QueryPriceAtRequest{
+				MarketId: args[0],
+				Time:     t,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdTwap queries the time-weighted average price of a market over a period
+func GetCmdTwap() *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [start-time] [end-time]",
+		Short:   "get the time-weighted average price of a market",
+		Long:    "Get the time-weighted average price of a market between two RFC3339 times.",
+		Example: fmt.Sprintf("%s q %s twap bnb:usd 2022-07-20T00:00:00Z 2022-07-20T01:00:00Z", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			start, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+			end, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid end time: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Twap(context.Background(), &types.QueryTwapRequest{
+				MarketId:  args[0],
+				StartTime: start,
+				EndTime:   end,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
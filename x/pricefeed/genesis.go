@@ -1,6 +1,9 @@
 package pricefeed
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
@@ -34,10 +37,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			continue
 		}
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil {
+		// markets may not have enough valid prices to satisfy their aggregation params
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
 	}
+
+	// Restore the circuit breaker states, overwriting any state created while setting the current prices
+	for _, state := range gs.CircuitBreakerStates {
+		k.SetCircuitBreakerState(ctx, state)
+	}
+
+	for _, stats := range gs.OracleStats {
+		k.SetOracleStats(ctx, stats)
+	}
+
+	for _, snapshot := range gs.PriceSnapshots {
+		market, found := k.GetMarket(ctx, snapshot.MarketID)
+		if !found || market.Snapshots == nil {
+			panic(fmt.Sprintf("price snapshot for market %s without snapshot params", snapshot.MarketID))
+		}
+		k.SetPriceSnapshot(ctx, *market.Snapshots, snapshot)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,5 +72,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	gs := types.NewGenesisState(params, postedPrices)
+	gs.CircuitBreakerStates = k.GetCircuitBreakerStates(ctx)
+	gs.OracleStats = k.GetAllOracleStats(ctx)
+	gs.PriceSnapshots = k.GetAllPriceSnapshots(ctx)
+	return gs
 }
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetCircuitBreakerState returns the circuit breaker state of a market
+func (k Keeper) GetCircuitBreakerState(ctx sdk.Context, marketID string) (types.CircuitBreakerState, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.CircuitBreakerStateKey(marketID))
+	if bz == nil {
+		return types.CircuitBreakerState{}, false
+	}
+	var state types.CircuitBreakerState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// SetCircuitBreakerState stores the circuit breaker state of a market
+func (k Keeper) SetCircuitBreakerState(ctx sdk.Context, state types.CircuitBreakerState) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.CircuitBreakerStateKey(state.MarketID), k.cdc.MustMarshal(&state))
+}
+
+// DeleteCircuitBreakerState removes the circuit breaker state of a market
+func (k Keeper) DeleteCircuitBreakerState(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.CircuitBreakerStateKey(marketID))
+}
+
+// IterateCircuitBreakerStates iterates over all circuit breaker states in the store and performs a callback function
+func (k Keeper) IterateCircuitBreakerStates(ctx sdk.Context, cb func(state types.CircuitBreakerState) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.CircuitBreakerStatePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.CircuitBreakerState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetCircuitBreakerStates returns all circuit breaker states from the store
+func (k Keeper) GetCircuitBreakerStates(ctx sdk.Context) types.CircuitBreakerStates {
+	var states types.CircuitBreakerStates
+	k.IterateCircuitBreakerStates(ctx, func(state types.CircuitBreakerState) (stop bool) {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// IsMarketHalted returns true if the market has been halted by its circuit breaker
+func (k Keeper) IsMarketHalted(ctx sdk.Context, marketID string) bool {
+	state, found := k.GetCircuitBreakerState(ctx, marketID)
+	return found && state.Halted
+}
+
+// ResetMarketHalt resumes a market that was halted by its circuit breaker. The
+// hourly window restarts with the next price update.
+func (k Keeper) ResetMarketHalt(ctx sdk.Context, marketID string) error {
+	if _, found := k.GetMarket(ctx, marketID); !found {
+		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !k.IsMarketHalted(ctx, marketID) {
+		return errorsmod.Wrap(types.ErrMarketNotHalted, marketID)
+	}
+
+	k.DeleteCircuitBreakerState(ctx, marketID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketResumed,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+		),
+	)
+	return nil
+}
+
+// applyCircuitBreaker checks a new current price of a market against its circuit breaker
+// params, halting the market if the price moved too far and resuming a halted market once
+// the price has been stable for the configured number of updates.
+func (k Keeper) applyCircuitBreaker(
+	ctx sdk.Context,
+	market types.Market,
+	lastPrice sdk.Dec,
+	newPrice sdk.Dec,
+) {
+	params := market.CircuitBreaker
+	if params == nil {
+		// circuit breaker disabled, clear any state left from when it was enabled
+		k.DeleteCircuitBreakerState(ctx, market.MarketID)
+		return
+	}
+
+	state, found := k.GetCircuitBreakerState(ctx, market.MarketID)
+	if !found {
+		state = types.NewCircuitBreakerState(market.MarketID, newPrice, ctx.BlockTime(), false, 0)
+	}
+
+	blockChangeExceeded := types.ExceedsChange(lastPrice, newPrice, params.MaxBlockChange)
+
+	if !state.Halted {
+		if blockChangeExceeded || types.ExceedsChange(state.ReferencePrice, newPrice, params.MaxHourlyChange) {
+			state.Halted = true
+			state.StableBlocks = 0
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMarketHalted,
+					sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+					sdk.NewAttribute(types.AttributeMarketPrice, newPrice.String()),
+				),
+			)
+		} else if !ctx.BlockTime().Before(state.ReferenceTime.Add(types.CircuitBreakerWindow)) {
+			// start a new hourly window
+			state.ReferencePrice = newPrice
+			state.ReferenceTime = ctx.BlockTime()
+		}
+
+		k.SetCircuitBreakerState(ctx, state)
+		return
+	}
+
+	// a zero last price means the market had no valid price, which does not count as stable
+	if lastPrice.IsZero() || blockChangeExceeded {
+		state.StableBlocks = 0
+	} else {
+		state.StableBlocks++
+	}
+
+	if params.RecoveryBlocks > 0 && state.StableBlocks >= params.RecoveryBlocks {
+		state = types.NewCircuitBreakerState(market.MarketID, newPrice, ctx.BlockTime(), false, 0)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketResumed,
+				sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+			),
+		)
+	}
+
+	k.SetCircuitBreakerState(ctx, state)
+}
@@ -57,7 +57,7 @@ func (s queryServer) Prices(c context.Context, req *types.QueryPricesRequest) (*
 
 	var currentPrices types.CurrentPriceResponses
 	for _, cp := range s.keeper.GetCurrentPrices(ctx) {
-		if cp.MarketID != "" {
+		if cp.MarketID != "" && !s.keeper.IsMarketHalted(ctx, cp.MarketID) {
 			currentPrices = append(currentPrices, types.CurrentPriceResponse(cp))
 		}
 	}
@@ -120,3 +120,69 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+func (s queryServer) CircuitBreakers(c context.Context, req *types.QueryCircuitBreakersRequest) (*types.QueryCircuitBreakersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCircuitBreakersResponse{
+		CircuitBreakers: s.keeper.GetCircuitBreakerStates(ctx),
+	}, nil
+}
+
+func (s queryServer) OracleStats(c context.Context, req *types.QueryOracleStatsRequest) (*types.QueryOracleStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	market, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	activeOracles := make(map[string]bool)
+	for _, oracle := range market.Oracles {
+		activeOracles[oracle.String()] = true
+	}
+
+	var stats types.OracleStatsResponses
+	s.keeper.IterateOracleStatsByMarket(ctx, market.MarketID, func(os types.OracleStats) (stop bool) {
+		stats = append(stats, os.ToOracleStatsResponse(activeOracles[os.OracleAddress.String()]))
+		return false
+	})
+
+	return &types.QueryOracleStatsResponse{
+		OracleStats: stats,
+	}, nil
+}
+
+func (s queryServer) PriceAt(c context.Context, req *types.QueryPriceAtRequest) (*types.QueryPriceAtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	snapshot, err := s.keeper.PriceAt(ctx, req.MarketId, req.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceAtResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+func (s queryServer) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	twap, err := s.keeper.Twap(ctx, req.MarketId, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{
+		Price: types.NewCurrentPriceResponse(req.MarketId, twap),
+	}, nil
+}
@@ -79,67 +79,40 @@ func (k Keeper) SetPrice(
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset by aggregating all valid oracle inputs
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
-	// store current price
-	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-	if err != nil {
-		validPrevPrice = false
+
+	if market.IsDerived() {
+		return k.updateDerivedPrice(ctx, market)
 	}
 
 	prices := k.GetRawPrices(ctx, marketID)
 
-	var notExpiredPrices []types.CurrentPrice
+	var notExpiredPrices types.PostedPrices
 	// filter out expired prices
 	for _, v := range prices {
 		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+			notExpiredPrices = append(notExpiredPrices, v)
 		}
 	}
 
-	if len(notExpiredPrices) == 0 {
-		// NOTE: The current price stored will continue storing the most recent (expired)
-		// price if this is not set.
-		// This zero's out the current price stored value for that market and ensures
-		// that CDP methods that GetCurrentPrice will return error.
-		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-		return types.ErrNoValidPrice
-	}
-
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
-
-	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-		// only emit event if price has changed
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeMarketPriceUpdated,
-				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
-			),
-		)
-	}
-
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
-	k.setCurrentPrice(ctx, marketID, currentPrice)
-
-	return nil
+	return k.updateCurrentPrice(ctx, market, notExpiredPrices)
 }
 
-// SetCurrentPricesForAllMarkets updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPricesForAllMarkets updates the price of an asset by aggregating all valid oracle inputs
 func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
-	orderedMarkets := []string{}
-	marketPricesByID := make(map[string]types.CurrentPrices)
-
-	for _, market := range k.GetMarkets(ctx) {
-		if market.Active {
-			orderedMarkets = append(orderedMarkets, market.MarketID)
-			marketPricesByID[market.MarketID] = types.CurrentPrices{}
+	markets := k.GetMarkets(ctx)
+	orderedMarkets := []types.Market{}
+	marketPricesByID := make(map[string]types.PostedPrices)
+
+	for _, market := range markets {
+		if market.Active && !market.IsDerived() {
+			orderedMarkets = append(orderedMarkets, market)
+			marketPricesByID[market.MarketID] = types.PostedPrices{}
 		}
 	}
 
@@ -155,47 +128,183 @@ func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
 
 		// filter out expired prices
 		if postedPrice.Expiry.After(ctx.BlockTime()) {
-			marketPricesByID[postedPrice.MarketID] = append(prices, types.NewCurrentPrice(postedPrice.MarketID, postedPrice.Price))
+			marketPricesByID[postedPrice.MarketID] = append(prices, postedPrice)
 		}
 	}
 	iterator.Close()
 
-	for _, marketID := range orderedMarkets {
-		// store current price
-		validPrevPrice := true
-		prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-		if err != nil {
-			validPrevPrice = false
+	for _, market := range orderedMarkets {
+		// markets without enough valid prices have their current price zeroed out,
+		// so the error can be ignored
+		_ = k.updateCurrentPrice(ctx, market, marketPricesByID[market.MarketID])
+	}
+
+	// derived markets are evaluated after the base markets, in dependency order
+	derivedMarkets, err := markets.DerivedMarkets()
+	if err != nil {
+		// params are validated when set, so this can only happen with corrupted params
+		k.Logger(ctx).Error("failed to order derived markets", "error", err)
+		return
+	}
+	for _, market := range derivedMarkets {
+		if market.Active {
+			// derived markets with a down input have their current price zeroed out
+			_ = k.updateDerivedPrice(ctx, market)
 		}
+	}
+}
 
-		notExpiredPrices, _ := marketPricesByID[marketID]
+// updateCurrentPrice aggregates the unexpired posted prices of a market according to
+// the market's aggregation params and stores the result as its current price.
+func (k Keeper) updateCurrentPrice(ctx sdk.Context, market types.Market, notExpiredPrices types.PostedPrices) error {
+	marketID := market.MarketID
 
-		if len(notExpiredPrices) == 0 {
-			// NOTE: The current price stored will continue storing the most recent (expired)
-			// price if this is not set.
-			// This zero's out the current price stored value for that market and ensures
-			// that CDP methods that GetCurrentPrice will return error.
-			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-			continue
+	// store current price
+	validPrevPrice := true
+	prevPrice, err := k.getCurrentPrice(ctx, marketID)
+	if err != nil {
+		validPrevPrice = false
+	}
+
+	aggregation := market.AggregationOrDefault()
+
+	// posts are checked against the last valid price, so a round without enough posts
+	// doesn't let any price through the next round
+	validPrices := notExpiredPrices
+	if lastValidPrice, found := k.getLastValidPrice(ctx, marketID); found {
+		validPrices = k.filterDeviatingPrices(ctx, aggregation.MaxDeviation, lastValidPrice.Price, notExpiredPrices)
+	}
+
+	if len(validPrices) == 0 || len(validPrices) < int(aggregation.MinValidPosts) {
+		// NOTE: The current price stored will continue storing the most recent (expired)
+		// price if this is not set.
+		// This zero's out the current price stored value for that market and ensures
+		// that CDP methods that GetCurrentPrice will return error.
+		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		return types.ErrNoValidPrice
+	}
+
+	aggregatedPrice := k.AggregatePrices(aggregation, validPrices)
+
+	k.storeCurrentPrice(ctx, market, prevPrice, validPrevPrice, aggregatedPrice)
+	return nil
+}
+
+// updateDerivedPrice evaluates the price expression of a derived market from the current
+// prices of its input markets and stores the result as its current price. The market has
+// no valid price while any of its inputs is inactive, has no valid price or is halted.
+func (k Keeper) updateDerivedPrice(ctx sdk.Context, market types.Market) error {
+	expr, err := types.ParsePriceExpression(market.PriceExpression)
+	if err != nil {
+		return err
+	}
+
+	prices := make(map[string]sdk.Dec, len(expr))
+	for _, id := range expr.MarketIDs() {
+		input, found := k.GetMarket(ctx, id)
+		if !found || !input.Active {
+			k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+			return errorsmod.Wrapf(types.ErrNoValidPrice, "input market %s is not active", id)
+		}
+		price, err := k.GetCurrentPrice(ctx, id)
+		if err != nil {
+			k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+			return errorsmod.Wrapf(types.ErrNoValidPrice, "input market %s is down: %s", id, err)
 		}
+		prices[id] = price.Price
+	}
+
+	prevPrice, err := k.getCurrentPrice(ctx, market.MarketID)
+	k.storeCurrentPrice(ctx, market, prevPrice, err == nil, expr.Evaluate(prices))
+	return nil
+}
+
+// storeCurrentPrice sets a new current price of a market after checking it against the
+// market's circuit breaker, and records it in the market's price history.
+func (k Keeper) storeCurrentPrice(
+	ctx sdk.Context,
+	market types.Market,
+	prevPrice types.CurrentPrice,
+	validPrevPrice bool,
+	price sdk.Dec,
+) {
+	marketID := market.MarketID
+
+	// check case that market price was not set in genesis
+	if validPrevPrice && !price.Equal(prevPrice.Price) {
+		// only emit event if price has changed
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketPriceUpdated,
+				sdk.NewAttribute(types.AttributeMarketID, marketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+			),
+		)
+	}
+
+	lastPrice := sdk.ZeroDec()
+	if validPrevPrice {
+		lastPrice = prevPrice.Price
+	}
+	k.applyCircuitBreaker(ctx, market, lastPrice, price)
+
+	currentPrice := types.NewCurrentPrice(marketID, price)
+	k.setCurrentPrice(ctx, marketID, currentPrice)
+	if price.IsPositive() {
+		k.setLastValidPrice(ctx, currentPrice)
+	}
+
+	// prices of halted markets are not trusted, so they are left out of the history
+	if !k.IsMarketHalted(ctx, marketID) {
+		k.recordPriceSnapshot(ctx, market, price)
+	}
+}
 
-		medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+// filterDeviatingPrices removes the posted prices that differ from the last current price
+// by more than the max deviation, emitting an event for each rejected post.
+func (k Keeper) filterDeviatingPrices(
+	ctx sdk.Context,
+	maxDeviation sdk.Dec,
+	lastPrice sdk.Dec,
+	prices types.PostedPrices,
+) types.PostedPrices {
+	if maxDeviation.IsZero() {
+		return prices
+	}
 
-		// check case that market price was not set in genesis
-		//if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-		if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-			// only emit event if price has changed
+	var accepted types.PostedPrices
+	for _, pp := range prices {
+		deviation := pp.Price.Sub(lastPrice).Abs().Quo(lastPrice)
+		if deviation.GT(maxDeviation) {
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
-					types.EventTypeMarketPriceUpdated,
-					sdk.NewAttribute(types.AttributeMarketID, marketID),
-					sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+					types.EventTypeOraclePriceRejected,
+					sdk.NewAttribute(types.AttributeMarketID, pp.MarketID),
+					sdk.NewAttribute(types.AttributeOracle, pp.OracleAddress.String()),
+					sdk.NewAttribute(types.AttributeMarketPrice, pp.Price.String()),
 				),
 			)
+			continue
 		}
+		accepted = append(accepted, pp)
+	}
+	return accepted
+}
 
-		currentPrice := types.NewCurrentPrice(marketID, medianPrice)
-		k.setCurrentPrice(ctx, marketID, currentPrice)
+// AggregatePrices combines the input prices into a single price using the method
+// selected by the aggregation params. The input must not be empty.
+func (k Keeper) AggregatePrices(params types.AggregationParams, prices types.PostedPrices) sdk.Dec {
+	switch params.Mode {
+	case types.AGGREGATION_MODE_WEIGHTED_MEDIAN:
+		return k.CalculateWeightedMedianPrice(prices, params.OracleWeights)
+	case types.AGGREGATION_MODE_TRIMMED_MEAN:
+		return k.CalculateTrimmedMeanPrice(prices, params.TrimFraction)
+	default:
+		currentPrices := make([]types.CurrentPrice, len(prices))
+		for i, pp := range prices {
+			currentPrices[i] = types.NewCurrentPrice(pp.MarketID, pp.Price)
+		}
+		return k.CalculateMedianPrice(currentPrices)
 	}
 }
 
@@ -225,14 +334,78 @@ func (k Keeper) CalculateMedianPrice(prices []types.CurrentPrice) sdk.Dec {
 	return prices[l/2].Price
 }
 
+// CalculateWeightedMedianPrice calculates the median of the input prices where each
+// price counts with the weight of the oracle that posted it.
+func (k Keeper) CalculateWeightedMedianPrice(prices types.PostedPrices, weights types.OracleWeights) sdk.Dec {
+	sorted := make(types.PostedPrices, len(prices))
+	copy(sorted, prices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.LT(sorted[j].Price)
+	})
+
+	totalWeight := sdk.ZeroDec()
+	for _, pp := range sorted {
+		totalWeight = totalWeight.Add(weights.WeightOf(pp.OracleAddress))
+	}
+	halfWeight := totalWeight.QuoInt64(2)
+
+	cumulativeWeight := sdk.ZeroDec()
+	for i, pp := range sorted {
+		cumulativeWeight = cumulativeWeight.Add(weights.WeightOf(pp.OracleAddress))
+		// when the weight is split evenly between two prices, the median is their mean
+		if cumulativeWeight.Equal(halfWeight) && i+1 < len(sorted) {
+			return k.calculateMeanPrice(
+				types.NewCurrentPrice(pp.MarketID, pp.Price),
+				types.NewCurrentPrice(sorted[i+1].MarketID, sorted[i+1].Price),
+			)
+		}
+		if cumulativeWeight.GTE(halfWeight) {
+			return pp.Price
+		}
+	}
+	return sorted[len(sorted)-1].Price
+}
+
+// CalculateTrimmedMeanPrice calculates the mean of the input prices after discarding
+// the given fraction of prices from each end of the sorted prices.
+func (k Keeper) CalculateTrimmedMeanPrice(prices types.PostedPrices, trimFraction sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	for i, pp := range prices {
+		sorted[i] = pp.Price
+	}
+	sort.Sort(types.SortDecs(sorted))
+
+	trimmed := trimFraction.MulInt64(int64(len(sorted))).TruncateInt64()
+	kept := sorted[trimmed : int64(len(sorted))-trimmed]
+
+	sum := sdk.ZeroDec()
+	for _, price := range kept {
+		sum = sum.Add(price)
+	}
+	return sum.QuoInt64(int64(len(kept)))
+}
+
 func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	sum := priceA.Price.Add(priceB.Price)
 	mean := sum.Quo(sdk.NewDec(2))
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current aggregated price of all oracles for a specific market.
+// It returns an error if the market has no valid price or is halted by its circuit breaker.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	price, err := k.getCurrentPrice(ctx, marketID)
+	if err != nil {
+		return types.CurrentPrice{}, err
+	}
+	if k.IsMarketHalted(ctx, marketID) {
+		return types.CurrentPrice{}, errorsmod.Wrap(types.ErrMarketHalted, marketID)
+	}
+	return price, nil
+}
+
+// getCurrentPrice fetches the stored current price of a market, ignoring its circuit breaker.
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
@@ -250,6 +423,24 @@ func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.Current
 	return price, nil
 }
 
+// getLastValidPrice fetches the last non-zero current price of a market, which is kept
+// while the current price is cleared.
+func (k Keeper) getLastValidPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LastValidPriceKey(marketID))
+	if bz == nil {
+		return types.CurrentPrice{}, false
+	}
+	var price types.CurrentPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+func (k Keeper) setLastValidPrice(ctx sdk.Context, price types.CurrentPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.LastValidPriceKey(price.MarketID), k.cdc.MustMarshal(&price))
+}
+
 // IterateCurrentPrices iterates over all current price objects in the store and performs a callback function
 func (k Keeper) IterateCurrentPrices(ctx sdk.Context, cb func(cp types.CurrentPrice) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.CurrentPricePrefix)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -269,3 +270,512 @@ func TestKeeper_SetCurrentPrices_MatchesAllMarketsBehavior(t *testing.T) {
 	testutil.SetCurrentPrices_PriceCalculations(t, testFunc)
 	testutil.SetCurrentPrices_EventEmission(t, testFunc)
 }
+
+func TestKeeper_AggregationModes(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	prices := []string{"1.0", "2.0", "3.0", "4.0", "100.0"}
+
+	testCases := []struct {
+		name        string
+		aggregation *types.AggregationParams
+		expPrice    sdk.Dec
+	}{
+		{
+			name:        "no aggregation params uses median",
+			aggregation: nil,
+			expPrice:    sdk.MustNewDecFromStr("3.0"),
+		},
+		{
+			name: "weighted median",
+			aggregation: &types.AggregationParams{
+				Mode: types.AGGREGATION_MODE_WEIGHTED_MEDIAN,
+				OracleWeights: types.OracleWeights{
+					types.NewOracleWeight(addrs[0], sdk.NewDec(4)),
+				},
+				TrimFraction: sdk.ZeroDec(),
+				MaxDeviation: sdk.ZeroDec(),
+			},
+			// weights are 4,1,1,1,1 so the first price holds half the weight
+			expPrice: sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name: "trimmed mean",
+			aggregation: &types.AggregationParams{
+				Mode:         types.AGGREGATION_MODE_TRIMMED_MEAN,
+				TrimFraction: sdk.MustNewDecFromStr("0.2"),
+				MaxDeviation: sdk.ZeroDec(),
+			},
+			expPrice: sdk.MustNewDecFromStr("3.0"),
+		},
+		{
+			name: "trimmed mean without trimming",
+			aggregation: &types.AggregationParams{
+				Mode:         types.AGGREGATION_MODE_TRIMMED_MEAN,
+				TrimFraction: sdk.ZeroDec(),
+				MaxDeviation: sdk.ZeroDec(),
+			},
+			expPrice: sdk.MustNewDecFromStr("22.0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Now().UTC())
+			keeper := tApp.GetPriceFeedKeeper()
+
+			keeper.SetParams(ctx, types.Params{
+				Markets: []types.Market{
+					{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, Aggregation: tc.aggregation},
+				},
+			})
+
+			for i, price := range prices {
+				_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+			price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+			require.NoError(t, err)
+			require.Equal(t, tc.expPrice, price.Price)
+		})
+	}
+}
+
+func TestKeeper_DeviationAndMinValidPosts(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	aggregation := types.NewAggregationParams(
+		types.AGGREGATION_MODE_MEDIAN,
+		types.OracleWeights{},
+		sdk.ZeroDec(),
+		sdk.MustNewDecFromStr("0.1"),
+		2,
+	)
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, Aggregation: &aggregation},
+		},
+	})
+	expiry := ctx.BlockTime().Add(time.Hour)
+	// a single post is not enough to set a price
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("10.0"), expiry)
+	require.NoError(t, err)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("10.4"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), price.Price)
+
+	// an outlier is rejected and does not move the price
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("50.0"), expiry)
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.2"), price.Price)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeOraclePriceRejected, ctx.EventManager().Events()[0].Type)
+
+	// when too few posts remain after rejection the price is cleared
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("50.0"), expiry)
+	require.NoError(t, err)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	// posts are still checked against the last valid price once the price is cleared
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("50.0"), expiry)
+	require.NoError(t, err)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("10.6"), expiry)
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("10.8"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.7"), price.Price)
+}
+
+func TestKeeper_CircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	circuitBreaker := types.NewCircuitBreakerParams(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.2"), 2)
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, CircuitBreaker: &circuitBreaker},
+		},
+	})
+	postPrice := func(price string) {
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+
+	postPrice("10.0")
+	postPrice("10.9")
+	postPrice("11.8")
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+
+	// the hourly change is measured from the start of the window
+	postPrice("12.5")
+	require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+
+	// the market resumes once the price is stable for the recovery blocks
+	postPrice("12.6")
+	require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	postPrice("12.6")
+	require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	require.Equal(t, types.EventTypeMarketResumed, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12.6"), price.Price)
+
+	// a large single block move halts the market, which can then be reset
+	postPrice("20.0")
+	require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	require.NoError(t, keeper.ResetMarketHalt(ctx, "tstusd"))
+	require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	require.ErrorIs(t, keeper.ResetMarketHalt(ctx, "tstusd"), types.ErrMarketNotHalted)
+	require.ErrorIs(t, keeper.ResetMarketHalt(ctx, "invalid"), types.ErrInvalidMarket)
+
+	// the hourly window restarts after it expires
+	postPrice("21.0")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.CircuitBreakerWindow))
+	postPrice("22.0")
+	postPrice("24.0")
+	postPrice("26.0")
+	require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	state, found := keeper.GetCircuitBreakerState(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("22.0"), state.ReferencePrice)
+}
+
+// descriptorGasMeter records the descriptors of the gas it consumes
+type descriptorGasMeter struct {
+	storetypes.GasMeter
+	descriptors []string
+}
+
+func (m *descriptorGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.descriptors = append(m.descriptors, descriptor)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func TestKeeper_OracleStats(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	now := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(now)
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+	querySrv := keeper.NewQueryServerImpl(k)
+
+	performance := types.NewOraclePerformanceParams(time.Hour, 2)
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, OraclePerformance: &performance},
+		},
+	})
+	postPrice := func(oracle sdk.AccAddress, price string) {
+		msg := types.NewMsgPostPrice(oracle.String(), "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		_, err := msgSrv.PostPrice(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+
+	k.UpdateOracleStats(ctx)
+	postPrice(addrs[0], "10.0")
+	postPrice(addrs[1], "11.0")
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+
+	// deviation is measured against the current price at the time of posting
+	postPrice(addrs[0], "11.55")
+	stats, found := k.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(2), stats.TotalPosts)
+	require.Equal(t, uint64(1), stats.DeviationSamples)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), stats.AverageDeviation)
+
+	// stats are not rewritten within a miss window
+	gasMeter := &descriptorGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+	k.UpdateOracleStats(ctx.WithGasMeter(gasMeter).WithBlockTime(now.Add(30 * time.Minute)))
+	require.NotContains(t, gasMeter.descriptors, storetypes.GasWriteCostFlatDesc)
+
+	// only the oracle that did not post misses the first window
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.UpdateOracleStats(ctx)
+	stats, _ = k.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(0), stats.MissedWindows)
+	stats, _ = k.GetOracleStats(ctx, "tstusd", addrs[2])
+	require.Equal(t, uint64(1), stats.ConsecutiveMisses)
+
+	// the oracle is removed after missing the max consecutive windows
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	k.UpdateOracleStats(ctx)
+	oracles, err := k.GetOracles(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addrs[0], addrs[1]}, oracles)
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeOracleRemoved, events[len(events)-1].Type)
+
+	// the stats of the removed oracle are dropped with it
+	_, found = k.GetOracleStats(ctx, "tstusd", addrs[2])
+	require.False(t, found)
+	res, err := querySrv.OracleStats(sdk.WrapSDKContext(ctx), &types.QueryOracleStatsRequest{MarketId: "tstusd"})
+	require.NoError(t, err)
+	require.Len(t, res.OracleStats, 2)
+	for _, s := range res.OracleStats {
+		require.True(t, s.Active)
+		require.Equal(t, uint64(1), s.ConsecutiveMisses)
+	}
+
+	// the last oracle of a market is never removed
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:1], Active: true, OraclePerformance: &performance},
+		},
+	})
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	k.UpdateOracleStats(ctx)
+	oracles, err = k.GetOracles(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, oracles)
+
+}
+
+func TestKeeper_OracleStats_MinValidPosts(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	now := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(now)
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	performance := types.NewOraclePerformanceParams(time.Hour, 1)
+	aggregation := types.NewAggregationParams(types.AGGREGATION_MODE_MEDIAN, types.OracleWeights{}, sdk.ZeroDec(), sdk.ZeroDec(), 2)
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:2], Active: true, Aggregation: &aggregation, OraclePerformance: &performance},
+			{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addrs[0], addrs[2]}, Active: true, OraclePerformance: &performance},
+		},
+	})
+
+	k.UpdateOracleStats(ctx)
+	for _, marketID := range []string{"tstusd", "tst2usd"} {
+		msg := types.NewMsgPostPrice(addrs[0].String(), marketID, sdk.MustNewDecFromStr("10.0"), now.Add(2*time.Hour))
+		_, err := msgSrv.PostPrice(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+
+	// the oracles a market needs for its min valid posts are kept, without stopping the
+	// removal of oracles from other markets
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.UpdateOracleStats(ctx)
+	oracles, err := k.GetOracles(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, addrs[:2], oracles)
+	stats, found := k.GetOracleStats(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.ConsecutiveMisses)
+
+	oracles, err = k.GetOracles(ctx, "tst2usd")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, oracles)
+	_, found = k.GetOracleStats(ctx, "tst2usd", addrs[2])
+	require.False(t, found)
+}
+
+func TestKeeper_OracleStats_RemoveWeightedOracle(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	now := time.Now().UTC()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(now)
+	k := tApp.GetPriceFeedKeeper()
+
+	performance := types.NewOraclePerformanceParams(time.Hour, 1)
+	aggregation := types.NewAggregationParams(
+		types.AGGREGATION_MODE_WEIGHTED_MEDIAN,
+		types.OracleWeights{types.NewOracleWeight(addrs[0], sdk.NewDec(2)), types.NewOracleWeight(addrs[1], sdk.NewDec(3))},
+		sdk.ZeroDec(), sdk.ZeroDec(), 1,
+	)
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, Aggregation: &aggregation, OraclePerformance: &performance},
+		},
+	})
+
+	k.UpdateOracleStats(ctx)
+	msg := types.NewMsgPostPrice(addrs[0].String(), "tstusd", sdk.MustNewDecFromStr("10.0"), now.Add(2*time.Hour))
+	_, err := keeper.NewMsgServerImpl(k).PostPrice(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the weight of the removed oracle is dropped with it
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	require.NotPanics(t, func() { k.UpdateOracleStats(ctx) })
+	market, found := k.GetMarket(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, market.Oracles)
+	require.Equal(t, types.OracleWeights{types.NewOracleWeight(addrs[0], sdk.NewDec(2))}, market.Aggregation.OracleWeights)
+	require.NoError(t, k.GetParams(ctx).Validate())
+}
+
+func TestKeeper_PriceSnapshots(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Date(2022, 7, 20, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	snapshots := types.NewSnapshotParams(time.Minute, 5*time.Minute, 0)
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, Snapshots: &snapshots},
+		},
+	})
+	postPrice := func(blockTime time.Time, price string) {
+		ctx = ctx.WithBlockTime(blockTime)
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), blockTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+
+	// only the first price of each period is recorded
+	postPrice(start, "10.0")
+	postPrice(start.Add(30*time.Second), "11.0")
+	postPrice(start.Add(time.Minute), "12.0")
+	require.Len(t, keeper.GetPriceSnapshots(ctx, "tstusd"), 2)
+
+	snapshot, err := keeper.PriceAt(ctx, "tstusd", start.Add(59*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.0"), snapshot.Price)
+	_, err = keeper.PriceAt(ctx, "tstusd", start.Add(-time.Second))
+	require.ErrorIs(t, err, types.ErrNoPriceSnapshot)
+
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+	price, err := keeper.GetTwapPrice(ctx, "tstusd", 2*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("11.0"), price.Price)
+	_, err = keeper.Twap(ctx, "tstusd", start, start.Add(3*time.Minute))
+	require.ErrorIs(t, err, types.ErrInvalidTimeRange)
+
+	// the ring buffer overwrites snapshots older than the retention period
+	for i := 2; i <= 6; i++ {
+		postPrice(start.Add(time.Duration(i)*time.Minute), "13.0")
+	}
+	history := keeper.GetPriceSnapshots(ctx, "tstusd")
+	require.Len(t, history, 5)
+	require.Equal(t, start.Add(2*time.Minute), history[0].Time)
+	require.Len(t, keeper.GetAllPriceSnapshots(ctx), 5)
+	_, err = keeper.PriceAt(ctx, "tstusd", start.Add(time.Minute))
+	require.ErrorIs(t, err, types.ErrNoPriceSnapshot)
+}
+
+func TestKeeper_LiquidationPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Date(2022, 7, 20, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	snapshots := types.NewSnapshotParams(time.Minute, 5*time.Minute, 2*time.Minute)
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, Snapshots: &snapshots},
+			{MarketID: "spotusd", BaseAsset: "spot", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+	})
+	postPrice := func(marketID string, blockTime time.Time, price string) {
+		ctx = ctx.WithBlockTime(blockTime)
+		_, err := keeper.SetPrice(ctx, addrs[0], marketID, sdk.MustNewDecFromStr(price), blockTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, marketID))
+	}
+
+	// markets without a liquidation twap window are liquidated at their current price
+	postPrice("spotusd", start, "5.0")
+	price, err := keeper.GetLiquidationPrice(ctx, "spotusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("5.0"), price.Price)
+
+	// there is no liquidation price until the snapshots cover the window
+	postPrice("tstusd", start, "10.0")
+	_, err = keeper.GetLiquidationPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	// a single manipulated price moves the liquidation price by its share of the window
+	postPrice("tstusd", start.Add(2*time.Minute), "2.0")
+	price, err = keeper.GetLiquidationPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.0"), price.Price)
+
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Minute))
+	price, err = keeper.GetLiquidationPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("6.0"), price.Price)
+}
+
+func TestKeeper_DerivedMarkets(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			// derived markets can be listed before the markets they depend on
+			{MarketID: "atom:kava", BaseAsset: "atom", QuoteAsset: "kava", Active: true, PriceExpression: "atom:usd * usd:kava"},
+			{MarketID: "atom:btc", BaseAsset: "atom", QuoteAsset: "btc", Oracles: addrs, Active: true},
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
+			{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: addrs, Active: true},
+			{MarketID: "atom:usd", BaseAsset: "atom", QuoteAsset: "usd", Active: true, PriceExpression: "atom:btc * btc:usd"},
+			{MarketID: "usd:kava", BaseAsset: "usd", QuoteAsset: "kava", Active: true, PriceExpression: "1 / kava:usd"},
+		},
+	})
+	postPrice := func(marketID, price string, expiry time.Time) {
+		_, err := keeper.SetPrice(ctx, addrs[0], marketID, sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+	}
+	expiry := ctx.BlockTime().Add(time.Hour)
+	postPrice("atom:btc", "0.0005", expiry)
+	postPrice("btc:usd", "20000.0", ctx.BlockTime().Add(time.Minute))
+	postPrice("kava:usd", "2.0", expiry)
+
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	for marketID, expPrice := range map[string]string{"atom:usd": "10.0", "usd:kava": "0.5", "atom:kava": "5.0"} {
+		price, err := keeper.GetCurrentPrice(ctx, marketID)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(expPrice), price.Price, marketID)
+	}
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "atom:usd"))
+
+	// derived markets go down with any of their inputs
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	_, err := keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "atom:kava")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "usd:kava")
+	require.NoError(t, err)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "atom:usd"), types.ErrNoValidPrice)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -38,6 +39,8 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 		return nil, err
 	}
 
+	k.keeper.recordOraclePost(ctx, msg.MarketID, from, msg.Price)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -48,3 +51,63 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 
 	return &types.MsgPostPriceResponse{}, nil
 }
+
+func (k msgServer) PostPrices(goCtx context.Context, msg *types.MsgPostPrices) (*types.MsgPostPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	// the markets are loaded once for the whole batch, which is what makes a batch cheaper
+	// than posting each price in a separate message
+	markets := make(map[string]types.Market)
+	for _, market := range k.keeper.GetMarkets(ctx) {
+		markets[market.MarketID] = market
+	}
+
+	// validate every entry before setting any price so the batch is applied atomically
+	for _, entry := range msg.Prices {
+		market, found := markets[entry.MarketID]
+		if !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidMarket, entry.MarketID)
+		}
+		if !market.Active {
+			return nil, errorsmod.Wrap(types.ErrInactiveMarket, entry.MarketID)
+		}
+		if !isOracle(market, from) {
+			return nil, errorsmod.Wrap(types.ErrInvalidOracle, from.String())
+		}
+		if !entry.Expiry.After(ctx.BlockTime()) {
+			return nil, errorsmod.Wrap(types.ErrExpired, entry.MarketID)
+		}
+	}
+
+	for _, entry := range msg.Prices {
+		if _, err := k.keeper.SetPrice(ctx, from, entry.MarketID, entry.Price, entry.Expiry); err != nil {
+			return nil, err
+		}
+		k.keeper.recordOraclePost(ctx, entry.MarketID, from, entry.Price)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgPostPricesResponse{}, nil
+}
+
+// isOracle returns true if the address is an oracle of the market
+func isOracle(market types.Market, address sdk.AccAddress) bool {
+	for _, oracle := range market.Oracles {
+		if oracle.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
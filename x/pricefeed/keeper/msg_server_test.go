@@ -61,3 +61,72 @@ func TestKeeper_PostPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_PostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	oracle := addrs[0]
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+			{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+			{MarketID: "tst3usd", BaseAsset: "tst3", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: false},
+			{MarketID: "tst4usd", BaseAsset: "tst4", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addrs[1]}, Active: true},
+		},
+	}
+	k.SetParams(ctx, mp)
+
+	price := sdk.MustNewDecFromStr("0.5")
+	expiry := ctx.BlockTime().Add(time.Hour)
+
+	tests := []struct {
+		giveMsg    string
+		giveMarket string
+		giveExpiry time.Time
+		errorKind  error
+	}{
+		{"invalid market", "invalid", expiry, types.ErrInvalidMarket},
+		{"inactive market", "tst3usd", expiry, types.ErrInactiveMarket},
+		{"unauthorized", "tst4usd", expiry, types.ErrInvalidOracle},
+		{"expired", "tst2usd", ctx.BlockTime(), types.ErrExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.giveMsg, func(t *testing.T) {
+			// a single invalid entry rejects the whole batch
+			msg := types.NewMsgPostPrices(oracle.String(), types.PriceEntries{
+				types.NewPriceEntry("tstusd", price, expiry),
+				types.NewPriceEntry(tt.giveMarket, price, tt.giveExpiry),
+			})
+			_, err := msgSrv.PostPrices(sdk.WrapSDKContext(ctx), msg)
+			require.ErrorIs(t, err, tt.errorKind)
+			require.Empty(t, k.GetRawPrices(ctx, "tstusd"))
+		})
+	}
+
+	// posting a batch costs less gas than posting each price separately
+	separateCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, marketID := range []string{"tstusd", "tst2usd"} {
+		_, err := msgSrv.PostPrice(sdk.WrapSDKContext(separateCtx), types.NewMsgPostPrice(oracle.String(), marketID, price, expiry))
+		require.NoError(t, err)
+	}
+
+	batchCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	msg := types.NewMsgPostPrices(oracle.String(), types.PriceEntries{
+		types.NewPriceEntry("tstusd", price, expiry),
+		types.NewPriceEntry("tst2usd", price, expiry),
+	})
+	_, err := msgSrv.PostPrices(sdk.WrapSDKContext(batchCtx), msg)
+	require.NoError(t, err)
+	require.Less(t, batchCtx.GasMeter().GasConsumed(), separateCtx.GasMeter().GasConsumed())
+
+	for _, marketID := range []string{"tstusd", "tst2usd"} {
+		rawPrices := k.GetRawPrices(ctx, marketID)
+		require.Len(t, rawPrices, 1)
+		require.Equal(t, price, rawPrices[0].Price)
+	}
+}
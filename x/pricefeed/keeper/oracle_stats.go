@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetOracleStats returns the performance counters of an oracle for a market
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStats, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleStats{}, false
+	}
+	var stats types.OracleStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStats stores the performance counters of an oracle for a market
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// DeleteOracleStats removes the performance counters of an oracle for a market
+func (k Keeper) DeleteOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.OracleStatsKey(marketID, oracle))
+}
+
+// IterateOracleStatsByMarket iterates over the oracle stats of a market and performs a callback function
+func (k Keeper) IterateOracleStatsByMarket(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatsIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// IterateOracleStats iterates over all oracle stats in the store and performs a callback function
+func (k Keeper) IterateOracleStats(ctx sdk.Context, cb func(stats types.OracleStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllOracleStats returns all oracle stats from the store
+func (k Keeper) GetAllOracleStats(ctx sdk.Context) types.OracleStatsList {
+	var stats types.OracleStatsList
+	k.IterateOracleStats(ctx, func(s types.OracleStats) (stop bool) {
+		stats = append(stats, s)
+		return false
+	})
+	return stats
+}
+
+// recordOraclePost updates the stats of an oracle with a newly posted price
+func (k Keeper) recordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress, price sdk.Dec) {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	if !found {
+		stats = types.NewOracleStats(marketID, oracle, ctx.BlockTime())
+	}
+
+	currentPrice := sdk.ZeroDec()
+	if cp, err := k.getCurrentPrice(ctx, marketID); err == nil {
+		currentPrice = cp.Price
+	}
+	stats.RecordPost(price, currentPrice, ctx.BlockTime())
+
+	k.SetOracleStats(ctx, stats)
+}
+
+// UpdateOracleStats counts the missed windows of the oracles of all active markets and
+// removes oracles that exceeded their market's max consecutive misses, along with their
+// posted prices and stats. Oracles are kept if their removal would leave a market without
+// enough oracles for its min valid posts.
+func (k Keeper) UpdateOracleStats(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	removedOracles := make(map[string][]sdk.AccAddress)
+
+	for i, market := range params.Markets {
+		if !market.Active {
+			continue
+		}
+		performance := market.OraclePerformanceOrDefault()
+
+		var remaining, removed []sdk.AccAddress
+		for _, oracle := range market.Oracles {
+			stats, found := k.GetOracleStats(ctx, market.MarketID, oracle)
+			if !found {
+				stats = types.NewOracleStats(market.MarketID, oracle, ctx.BlockTime())
+			}
+
+			// stats only change when created or when a miss window ends
+			changed := !found
+			if !ctx.BlockTime().Before(stats.WindowStart.Add(performance.MissWindow)) {
+				if stats.LastPostTime.Before(stats.WindowStart) {
+					stats.MissedWindows++
+					stats.ConsecutiveMisses++
+
+					ctx.EventManager().EmitEvent(
+						sdk.NewEvent(
+							types.EventTypeOracleMissedWindow,
+							sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+							sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+							sdk.NewAttribute(types.AttributeConsecutiveMisses, strconv.FormatUint(stats.ConsecutiveMisses, 10)),
+						),
+					)
+				} else {
+					stats.ConsecutiveMisses = 0
+				}
+				stats.WindowStart = ctx.BlockTime()
+				changed = true
+			}
+			if changed {
+				k.SetOracleStats(ctx, stats)
+			}
+
+			if performance.MaxConsecutiveMisses > 0 && stats.ConsecutiveMisses >= performance.MaxConsecutiveMisses {
+				removed = append(removed, oracle)
+			} else {
+				remaining = append(remaining, oracle)
+			}
+		}
+
+		// never remove every oracle of a market, or leave fewer oracles than the posts the
+		// market needs for a price, as it could then not be priced again without governance
+		if len(removed) == 0 || len(remaining) == 0 || len(remaining) < int(market.AggregationOrDefault().MinValidPosts) {
+			continue
+		}
+
+		params.Markets[i].Oracles = remaining
+		if market.Aggregation != nil {
+			aggregation := *market.Aggregation
+			aggregation.OracleWeights = withoutOracleWeights(aggregation.OracleWeights, removed)
+			params.Markets[i].Aggregation = &aggregation
+		}
+		removedOracles[market.MarketID] = removed
+	}
+
+	if len(removedOracles) == 0 {
+		return
+	}
+	// an invalid param set would panic in the end blocker, so oracles are kept instead
+	if err := params.Validate(); err != nil {
+		k.Logger(ctx).Error("failed to remove oracles exceeding max consecutive misses", "error", err)
+		return
+	}
+	k.SetParams(ctx, params)
+
+	for _, market := range params.Markets {
+		for _, oracle := range removedOracles[market.MarketID] {
+			ctx.KVStore(k.key).Delete(types.RawPriceKey(market.MarketID, oracle))
+			k.DeleteOracleStats(ctx, market.MarketID, oracle)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeOracleRemoved,
+					sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+					sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+				),
+			)
+		}
+	}
+}
+
+// withoutOracleWeights returns the weights that do not belong to any of the given oracles
+func withoutOracleWeights(weights types.OracleWeights, oracles []sdk.AccAddress) types.OracleWeights {
+	filtered := types.OracleWeights{}
+	for _, w := range weights {
+		keep := true
+		for _, oracle := range oracles {
+			if w.OracleAddress.Equals(oracle) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = append(filtered, w)
+		}
+	}
+	return filtered
+}
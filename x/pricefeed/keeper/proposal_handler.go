@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// HandlePricefeedResetMarketHaltProposal is a handler for executing a passed market halt reset proposal.
+func HandlePricefeedResetMarketHaltProposal(ctx sdk.Context, k Keeper, p *types.PricefeedResetMarketHaltProposal) error {
+	return k.ResetMarketHalt(ctx, p.MarketID)
+}
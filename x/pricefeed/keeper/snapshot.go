@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SetPriceSnapshot stores a price snapshot in its slot of the market's ring buffer,
+// replacing the snapshot that previously occupied the slot.
+func (k Keeper) SetPriceSnapshot(ctx sdk.Context, params types.SnapshotParams, snapshot types.PriceSnapshot) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceSnapshotKey(snapshot.MarketID, params.Slot(snapshot.Time)), k.cdc.MustMarshal(&snapshot))
+}
+
+// IteratePriceSnapshotsByMarket iterates over the stored price snapshots of a market and performs a callback function
+func (k Keeper) IteratePriceSnapshotsByMarket(ctx sdk.Context, marketID string, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllPriceSnapshots returns all price snapshots from the store
+func (k Keeper) GetAllPriceSnapshots(ctx sdk.Context) types.PriceSnapshots {
+	var snapshots types.PriceSnapshots
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// GetPriceSnapshots returns the price snapshots of a market within its retention period,
+// sorted from oldest to newest.
+func (k Keeper) GetPriceSnapshots(ctx sdk.Context, marketID string) types.PriceSnapshots {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || market.Snapshots == nil {
+		return types.PriceSnapshots{}
+	}
+
+	cutoff := ctx.BlockTime().Add(-market.Snapshots.Retention)
+	snapshots := types.PriceSnapshots{}
+	k.IteratePriceSnapshotsByMarket(ctx, marketID, func(snapshot types.PriceSnapshot) (stop bool) {
+		// slots are only overwritten when a new snapshot is recorded, so they can hold expired snapshots
+		if !snapshot.Time.Before(cutoff) {
+			snapshots = append(snapshots, snapshot)
+		}
+		return false
+	})
+	snapshots.SortByTime()
+	return snapshots
+}
+
+// PriceAt returns the latest price snapshot of a market recorded at or before a point in time
+func (k Keeper) PriceAt(ctx sdk.Context, marketID string, t time.Time) (types.PriceSnapshot, error) {
+	if _, found := k.GetMarket(ctx, marketID); !found {
+		return types.PriceSnapshot{}, errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	snapshot, found := k.GetPriceSnapshots(ctx, marketID).PriceAt(t)
+	if !found {
+		return types.PriceSnapshot{}, errorsmod.Wrapf(types.ErrNoPriceSnapshot, "%s at %s", marketID, t)
+	}
+	return snapshot, nil
+}
+
+// Twap returns the time-weighted average price of a market between start and end
+func (k Keeper) Twap(ctx sdk.Context, marketID string, start, end time.Time) (sdk.Dec, error) {
+	if _, found := k.GetMarket(ctx, marketID); !found {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if end.After(ctx.BlockTime()) {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidTimeRange, "end %s is after block time %s", end, ctx.BlockTime())
+	}
+	return k.GetPriceSnapshots(ctx, marketID).Twap(start, end)
+}
+
+// GetTwapPrice returns the time-weighted average price of a market over the window ending
+// at the current block time. It fails like GetCurrentPrice when the market has no valid
+// current price or is halted.
+func (k Keeper) GetTwapPrice(ctx sdk.Context, marketID string, window time.Duration) (types.CurrentPrice, error) {
+	if _, err := k.GetCurrentPrice(ctx, marketID); err != nil {
+		return types.CurrentPrice{}, err
+	}
+	twap, err := k.Twap(ctx, marketID, ctx.BlockTime().Add(-window), ctx.BlockTime())
+	if err != nil {
+		return types.CurrentPrice{}, err
+	}
+	return types.NewCurrentPrice(marketID, twap), nil
+}
+
+// GetLiquidationPrice returns the price x/cdp and x/hard liquidate positions at. It is
+// the TWAP over the market's liquidation TWAP window, or the current price if the window
+// isn't set. Until snapshots cover the window there is no valid liquidation price.
+func (k Keeper) GetLiquidationPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || market.Snapshots == nil || market.Snapshots.LiquidationTwapWindow == 0 {
+		return k.GetCurrentPrice(ctx, marketID)
+	}
+
+	price, err := k.GetTwapPrice(ctx, marketID, market.Snapshots.LiquidationTwapWindow)
+	if errors.Is(err, types.ErrNoPriceSnapshot) {
+		return types.CurrentPrice{}, errorsmod.Wrap(types.ErrNoValidPrice, err.Error())
+	}
+	return price, err
+}
+
+// recordPriceSnapshot stores the current price of a market as a snapshot if none has
+// been recorded yet in the current granularity period.
+func (k Keeper) recordPriceSnapshot(ctx sdk.Context, market types.Market, price sdk.Dec) {
+	params := market.Snapshots
+	if params == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceSnapshotKey(market.MarketID, params.Slot(ctx.BlockTime())))
+	if bz != nil {
+		var existing types.PriceSnapshot
+		k.cdc.MustUnmarshal(bz, &existing)
+		if params.Period(existing.Time) == params.Period(ctx.BlockTime()) {
+			return
+		}
+	}
+
+	k.SetPriceSnapshot(ctx, *params, types.NewPriceSnapshot(market.MarketID, price, ctx.BlockTime()))
+}
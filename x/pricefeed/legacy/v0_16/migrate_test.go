@@ -87,7 +87,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "bnb:usd:30",
@@ -96,7 +101,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "atom:usd",
@@ -105,7 +115,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "atom:usd:30",
@@ -114,7 +129,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "akt:usd",
@@ -123,7 +143,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "akt:usd:30",
@@ -132,7 +157,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "luna:usd",
@@ -141,7 +171,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "luna:usd:30",
@@ -150,7 +185,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "osmo:usd",
@@ -159,7 +199,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "osmo:usd:30",
@@ -168,7 +213,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "ust:usd",
@@ -177,7 +227,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				},
 				{
 					"market_id": "ust:usd:30",
@@ -186,7 +241,12 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"aggregation": null,
+					"circuit_breaker": null,
+					"oracle_performance": null,
+					"snapshots": null,
+					"price_expression": ""
 				}
 			]
 		},
@@ -203,7 +263,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 				"price": "217.962650000000001782",
 				"expiry": "2022-07-20T00:00:00Z"
 			}
-		]
+		],
+		"circuit_breaker_states": [],
+		"oracle_stats": [],
+		"price_snapshots": []
 	}`
 
 	err := s.legacyCdc.UnmarshalJSON([]byte(v15Params), &s.v15genstate)
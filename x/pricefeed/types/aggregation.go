@@ -0,0 +1,101 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTrimFraction is the exclusive upper bound of the trim fraction, above which
+// a trimmed mean would discard every post.
+var MaxTrimFraction = sdk.MustNewDecFromStr("0.5")
+
+// NewAggregationParams returns a new AggregationParams
+func NewAggregationParams(
+	mode AggregationMode,
+	weights OracleWeights,
+	trimFraction sdk.Dec,
+	maxDeviation sdk.Dec,
+	minValidPosts uint32,
+) AggregationParams {
+	return AggregationParams{
+		Mode:          mode,
+		OracleWeights: weights,
+		TrimFraction:  trimFraction,
+		MaxDeviation:  maxDeviation,
+		MinValidPosts: minValidPosts,
+	}
+}
+
+// DefaultAggregationParams returns the aggregation params used by markets that
+// do not define their own: the plain median of all valid posts.
+func DefaultAggregationParams() AggregationParams {
+	return NewAggregationParams(AGGREGATION_MODE_MEDIAN, OracleWeights{}, sdk.ZeroDec(), sdk.ZeroDec(), 1)
+}
+
+// Validate performs a basic validation of the aggregation params
+func (a AggregationParams) Validate() error {
+	if _, ok := AggregationMode_name[int32(a.Mode)]; !ok {
+		return fmt.Errorf("invalid aggregation mode %d", a.Mode)
+	}
+	if err := a.OracleWeights.Validate(); err != nil {
+		return err
+	}
+	if a.TrimFraction.IsNil() || a.TrimFraction.IsNegative() || a.TrimFraction.GTE(MaxTrimFraction) {
+		return fmt.Errorf("trim fraction must be within [0, %s), got %s", MaxTrimFraction, a.TrimFraction)
+	}
+	if a.MaxDeviation.IsNil() || a.MaxDeviation.IsNegative() {
+		return fmt.Errorf("max deviation cannot be nil or negative %s", a.MaxDeviation)
+	}
+	return nil
+}
+
+// NewOracleWeight returns a new OracleWeight
+func NewOracleWeight(oracle sdk.AccAddress, weight sdk.Dec) OracleWeight {
+	return OracleWeight{
+		OracleAddress: oracle,
+		Weight:        weight,
+	}
+}
+
+// Validate performs a basic validation of the oracle weight
+func (w OracleWeight) Validate() error {
+	if len(w.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if w.Weight.IsNil() || !w.Weight.IsPositive() {
+		return fmt.Errorf("oracle weight must be positive for %s", w.OracleAddress)
+	}
+	return nil
+}
+
+// OracleWeights is a slice of OracleWeight
+type OracleWeights []OracleWeight
+
+// Validate checks if all the weights are valid and there are no duplicated
+// oracles.
+func (ws OracleWeights) Validate() error {
+	seenOracles := make(map[string]bool)
+	for _, w := range ws {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+		if seenOracles[w.OracleAddress.String()] {
+			return fmt.Errorf("duplicated oracle weight %s", w.OracleAddress)
+		}
+		seenOracles[w.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// WeightOf returns the weight of an oracle, defaulting to one when the oracle
+// has no explicit weight.
+func (ws OracleWeights) WeightOf(oracle sdk.AccAddress) sdk.Dec {
+	for _, w := range ws {
+		if w.OracleAddress.Equals(oracle) {
+			return w.Weight
+		}
+	}
+	return sdk.OneDec()
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CircuitBreakerWindow is the duration of the window the hourly price change is measured over.
+const CircuitBreakerWindow = time.Hour
+
+// NewCircuitBreakerParams returns a new CircuitBreakerParams
+func NewCircuitBreakerParams(maxBlockChange, maxHourlyChange sdk.Dec, recoveryBlocks uint64) CircuitBreakerParams {
+	return CircuitBreakerParams{
+		MaxBlockChange:  maxBlockChange,
+		MaxHourlyChange: maxHourlyChange,
+		RecoveryBlocks:  recoveryBlocks,
+	}
+}
+
+// Validate performs a basic validation of the circuit breaker params
+func (p CircuitBreakerParams) Validate() error {
+	if p.MaxBlockChange.IsNil() || p.MaxBlockChange.IsNegative() {
+		return fmt.Errorf("max block change cannot be nil or negative %s", p.MaxBlockChange)
+	}
+	if p.MaxHourlyChange.IsNil() || p.MaxHourlyChange.IsNegative() {
+		return fmt.Errorf("max hourly change cannot be nil or negative %s", p.MaxHourlyChange)
+	}
+	return nil
+}
+
+// ExceedsChange returns true if the relative change from one price to another is
+// greater than the max change. A zero max change or reference price disables the check.
+func ExceedsChange(from, to, maxChange sdk.Dec) bool {
+	if maxChange.IsZero() || from.IsZero() {
+		return false
+	}
+	return to.Sub(from).Abs().Quo(from).GT(maxChange)
+}
+
+// NewCircuitBreakerState returns a new CircuitBreakerState
+func NewCircuitBreakerState(
+	marketID string,
+	referencePrice sdk.Dec,
+	referenceTime time.Time,
+	halted bool,
+	stableBlocks uint64,
+) CircuitBreakerState {
+	return CircuitBreakerState{
+		MarketID:       marketID,
+		ReferencePrice: referencePrice,
+		ReferenceTime:  referenceTime,
+		Halted:         halted,
+		StableBlocks:   stableBlocks,
+	}
+}
+
+// Validate performs a basic validation of the circuit breaker state
+func (s CircuitBreakerState) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if s.ReferencePrice.IsNil() || s.ReferencePrice.IsNegative() {
+		return fmt.Errorf("reference price cannot be nil or negative %s", s.ReferencePrice)
+	}
+	return nil
+}
+
+// CircuitBreakerStates is a slice of CircuitBreakerState
+type CircuitBreakerStates []CircuitBreakerState
+
+// Validate checks if all the states are valid and there are no duplicated
+// markets.
+func (ss CircuitBreakerStates) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, s := range ss {
+		if seenMarkets[s.MarketID] {
+			return fmt.Errorf("duplicated circuit breaker state for market %s", s.MarketID)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seenMarkets[s.MarketID] = true
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceTerm is a factor of a price expression: the price of a market or its inverse.
+type PriceTerm struct {
+	MarketID string
+	Inverse  bool
+}
+
+// PriceExpression is the product of the terms of a derived market's price expression.
+type PriceExpression []PriceTerm
+
+// ParsePriceExpression parses a price expression made of market IDs joined by '*' or
+// '/'. The expression may start with "1 /" to invert the first market.
+func ParsePriceExpression(expr string) (PriceExpression, error) {
+	expr = strings.NewReplacer("*", " * ", "/", " / ").Replace(expr)
+	tokens := strings.Fields(expr)
+	if len(tokens) == 0 {
+		return nil, errors.New("price expression cannot be blank")
+	}
+	if len(tokens)%2 == 0 {
+		return nil, fmt.Errorf("price expression must alternate market ids and operators: %s", expr)
+	}
+
+	var terms PriceExpression
+	inverse := false
+	for i, token := range tokens {
+		if i%2 == 1 {
+			switch token {
+			case "*":
+				inverse = false
+			case "/":
+				inverse = true
+			default:
+				return nil, fmt.Errorf("invalid operator %s in price expression, expected '*' or '/'", token)
+			}
+			continue
+		}
+		if token == "*" || token == "/" {
+			return nil, fmt.Errorf("missing market id before operator %s in price expression", token)
+		}
+		if token == "1" {
+			if i != 0 {
+				return nil, errors.New("the constant 1 can only start a price expression")
+			}
+			continue
+		}
+		terms = append(terms, PriceTerm{MarketID: token, Inverse: inverse})
+	}
+	if len(terms) == 0 {
+		return nil, errors.New("price expression must reference at least one market")
+	}
+	return terms, nil
+}
+
+// MarketIDs returns the IDs of the markets referenced by the expression.
+func (e PriceExpression) MarketIDs() []string {
+	ids := make([]string, len(e))
+	for i, term := range e {
+		ids[i] = term.MarketID
+	}
+	return ids
+}
+
+// Evaluate computes the expression from the prices of its markets, which must all be positive.
+func (e PriceExpression) Evaluate(prices map[string]sdk.Dec) sdk.Dec {
+	result := sdk.OneDec()
+	for _, term := range e {
+		if term.Inverse {
+			result = result.Quo(prices[term.MarketID])
+		} else {
+			result = result.Mul(prices[term.MarketID])
+		}
+	}
+	return result
+}
+
+// IsDerived returns true if the market's price is derived from other markets.
+func (m Market) IsDerived() bool {
+	return m.PriceExpression != ""
+}
+
+// DerivedMarkets returns the derived markets ordered so that each market comes after
+// the derived markets it depends on. It fails if a market references an unknown market
+// or if the markets depend on each other in a cycle.
+func (ms Markets) DerivedMarkets() (Markets, error) {
+	marketsByID := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		marketsByID[m.MarketID] = m
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var ordered Markets
+
+	var visit func(m Market) error
+	visit = func(m Market) error {
+		switch state[m.MarketID] {
+		case visiting:
+			return fmt.Errorf("price expression of market %s depends on itself", m.MarketID)
+		case visited:
+			return nil
+		}
+		state[m.MarketID] = visiting
+
+		expr, err := ParsePriceExpression(m.PriceExpression)
+		if err != nil {
+			return fmt.Errorf("invalid price expression for market %s: %w", m.MarketID, err)
+		}
+		for _, id := range expr.MarketIDs() {
+			input, found := marketsByID[id]
+			if !found {
+				return fmt.Errorf("price expression of market %s references unknown market %s", m.MarketID, id)
+			}
+			if input.IsDerived() {
+				if err := visit(input); err != nil {
+					return err
+				}
+			}
+		}
+
+		state[m.MarketID] = visited
+		ordered = append(ordered, m)
+		return nil
+	}
+
+	for _, m := range ms {
+		if !m.IsDerived() {
+			continue
+		}
+		if err := visit(m); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
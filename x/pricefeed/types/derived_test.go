@@ -0,0 +1,119 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParsePriceExpression(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		expr    string
+		expExpr PriceExpression
+		expPass bool
+	}{
+		{"single market", "atom:usd", PriceExpression{{MarketID: "atom:usd"}}, true},
+		{"product", "atom:btc * btc:usd", PriceExpression{{MarketID: "atom:btc"}, {MarketID: "btc:usd"}}, true},
+		{"inverse", "1 / kava:usd", PriceExpression{{MarketID: "kava:usd", Inverse: true}}, true},
+		{"without spaces", "atom:usd/kava:usd", PriceExpression{{MarketID: "atom:usd"}, {MarketID: "kava:usd", Inverse: true}}, true},
+		{"blank", " ", nil, false},
+		{"only constant", "1", nil, false},
+		{"constant not first", "atom:usd * 1", nil, false},
+		{"trailing operator", "atom:usd *", nil, false},
+		{"missing operator", "atom:usd btc:usd", nil, false},
+		{"double operator", "atom:usd * / btc:usd", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			expr, err := ParsePriceExpression(tc.expr)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expExpr, expr)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceExpressionEvaluate(t *testing.T) {
+	expr, err := ParsePriceExpression("atom:btc * btc:usd / kava:usd")
+	require.NoError(t, err)
+
+	price := expr.Evaluate(map[string]sdk.Dec{
+		"atom:btc": sdk.MustNewDecFromStr("0.0005"),
+		"btc:usd":  sdk.MustNewDecFromStr("20000"),
+		"kava:usd": sdk.MustNewDecFromStr("2"),
+	})
+	require.Equal(t, sdk.MustNewDecFromStr("5"), price)
+}
+
+func TestMarketsDerivedMarkets(t *testing.T) {
+	base := func(id string) Market {
+		return Market{MarketID: id, BaseAsset: "xrp", QuoteAsset: "usd", Active: true}
+	}
+	derived := func(id, expr string) Market {
+		return Market{MarketID: id, BaseAsset: "xrp", QuoteAsset: "usd", Active: true, PriceExpression: expr}
+	}
+
+	testCases := []struct {
+		msg      string
+		markets  Markets
+		expOrder []string
+		expPass  bool
+	}{
+		{
+			"dependencies come first",
+			Markets{
+				derived("atom:kava", "atom:usd * usd:kava"),
+				base("atom:btc"),
+				base("btc:usd"),
+				base("kava:usd"),
+				derived("atom:usd", "atom:btc * btc:usd"),
+				derived("usd:kava", "1 / kava:usd"),
+			},
+			[]string{"atom:usd", "usd:kava", "atom:kava"},
+			true,
+		},
+		{
+			"unknown market",
+			Markets{derived("atom:usd", "atom:btc * btc:usd"), base("atom:btc")},
+			nil,
+			false,
+		},
+		{
+			"self reference",
+			Markets{derived("atom:usd", "atom:usd * btc:usd"), base("btc:usd")},
+			nil,
+			false,
+		},
+		{
+			"cycle",
+			Markets{derived("a:usd", "b:usd"), derived("b:usd", "c:usd"), derived("c:usd", "1 / a:usd")},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			ordered, err := tc.markets.DerivedMarkets()
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Error(t, tc.markets.Validate())
+				return
+			}
+			require.NoError(t, err)
+			var ids []string
+			for _, m := range ordered {
+				ids = append(ids, m.MarketID)
+			}
+			require.Equal(t, tc.expOrder, ids)
+			require.NoError(t, tc.markets.Validate())
+		})
+	}
+}
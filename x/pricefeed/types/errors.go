@@ -17,4 +17,14 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrMarketHalted error for markets halted by their circuit breaker
+	ErrMarketHalted = errorsmod.Register(ModuleName, 8, "market is halted")
+	// ErrMarketNotHalted error for resetting a market that is not halted
+	ErrMarketNotHalted = errorsmod.Register(ModuleName, 9, "market is not halted")
+	// ErrNoPriceSnapshot error for price history queries without a snapshot
+	ErrNoPriceSnapshot = errorsmod.Register(ModuleName, 10, "no price snapshot found")
+	// ErrInvalidTimeRange error for price history queries with an invalid time range
+	ErrInvalidTimeRange = errorsmod.Register(ModuleName, 11, "invalid time range")
+	// ErrInactiveMarket error for posted prices for inactive markets
+	ErrInactiveMarket = errorsmod.Register(ModuleName, 12, "market is not active")
 )
@@ -2,13 +2,19 @@ package types
 
 // Pricefeed module event types
 const (
-	EventTypeMarketPriceUpdated = "market_price_updated"
-	EventTypeOracleUpdatedPrice = "oracle_updated_price"
-	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketPriceUpdated  = "market_price_updated"
+	EventTypeOracleUpdatedPrice  = "oracle_updated_price"
+	EventTypeNoValidPrices       = "no_valid_prices"
+	EventTypeOraclePriceRejected = "oracle_price_rejected"
+	EventTypeMarketHalted        = "market_halted"
+	EventTypeMarketResumed       = "market_resumed"
+	EventTypeOracleMissedWindow  = "oracle_missed_window"
+	EventTypeOracleRemoved       = "oracle_removed"
 
-	AttributeValueCategory = ModuleName
-	AttributeMarketID      = "market_id"
-	AttributeMarketPrice   = "market_price"
-	AttributeOracle        = "oracle"
-	AttributeExpiry        = "expiry"
+	AttributeValueCategory     = ModuleName
+	AttributeMarketID          = "market_id"
+	AttributeMarketPrice       = "market_price"
+	AttributeOracle            = "oracle"
+	AttributeExpiry            = "expiry"
+	AttributeConsecutiveMisses = "consecutive_misses"
 )
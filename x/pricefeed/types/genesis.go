@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state for the pricefeed module
 func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
 	return GenesisState{
@@ -23,5 +25,30 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.CircuitBreakerStates.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.OracleStats.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PriceSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	snapshotMarkets := make(map[string]bool)
+	for _, m := range gs.Params.Markets {
+		snapshotMarkets[m.MarketID] = m.Snapshots != nil
+	}
+	for _, s := range gs.PriceSnapshots {
+		if !snapshotMarkets[s.MarketID] {
+			return fmt.Errorf("price snapshot for market %s without snapshot params", s.MarketID)
+		}
+	}
+	return nil
 }
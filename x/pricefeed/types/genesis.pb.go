@@ -26,8 +26,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pricefeed module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	Params               Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PostedPrices         PostedPrices         `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	CircuitBreakerStates CircuitBreakerStates `protobuf:"bytes,3,rep,name=circuit_breaker_states,json=circuitBreakerStates,proto3,castrepeated=CircuitBreakerStates" json:"circuit_breaker_states"`
+	OracleStats          OracleStatsList      `protobuf:"bytes,4,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsList" json:"oracle_stats"`
+	PriceSnapshots       PriceSnapshots       `protobuf:"bytes,5,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +80,27 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetCircuitBreakerStates() CircuitBreakerStates {
+	if m != nil {
+		return m.CircuitBreakerStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleStats() OracleStatsList {
+	if m != nil {
+		return m.OracleStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.pricefeed.v1beta1.GenesisState")
 }
@@ -86,24 +110,32 @@ func init() {
 }
 
 var fileDescriptor_fffec798191784d2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x70, 0x98, 0x59, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa3, 0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x9c, 0x1e, 0x76, 0x3b, 0xf5, 0x02, 0xc0, 0xaa, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x11, 0x8a, 0xe3, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x69, 0x08, 0x58, 0x71, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd2, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xdf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x90, 0xa5, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0x52, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf3,
-	0x1e, 0xef, 0x7e, 0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbf, 0x8e, 0xda, 0x30,
+	0x1c, 0xc7, 0x93, 0x42, 0x19, 0x42, 0x0a, 0x52, 0x14, 0xd1, 0x08, 0x55, 0x06, 0xd1, 0x56, 0x42,
+	0x42, 0x4d, 0x04, 0x5d, 0x3b, 0xa5, 0x43, 0x97, 0x56, 0x45, 0x61, 0xeb, 0xd0, 0xc8, 0x09, 0x26,
+	0x44, 0xfc, 0xb1, 0xe5, 0x9f, 0x41, 0xed, 0xd4, 0x57, 0xe8, 0x63, 0x54, 0x7d, 0x12, 0x46, 0xc6,
+	0x4e, 0x3d, 0x2e, 0xbc, 0xc3, 0xcd, 0x27, 0x3b, 0xd1, 0x5d, 0x90, 0xc8, 0x6d, 0xf6, 0x37, 0x9f,
+	0xef, 0xef, 0x13, 0x5b, 0x36, 0xde, 0xac, 0xf0, 0x1e, 0x7b, 0x8c, 0xa7, 0x31, 0x59, 0x10, 0x32,
+	0xf7, 0xf6, 0xe3, 0x88, 0x08, 0x3c, 0xf6, 0x12, 0xb2, 0x25, 0x90, 0x82, 0xcb, 0x38, 0x15, 0xd4,
+	0xea, 0x48, 0xca, 0x7d, 0xa0, 0xdc, 0x82, 0xea, 0xda, 0x09, 0x4d, 0xa8, 0x42, 0x3c, 0xb9, 0xca,
+	0xe9, 0xee, 0xa0, 0x62, 0x26, 0x08, 0xca, 0x49, 0xce, 0x0c, 0xee, 0x6a, 0x86, 0xf9, 0x29, 0x77,
+	0xcc, 0x04, 0x16, 0xc4, 0xfa, 0x60, 0x34, 0x18, 0xe6, 0x78, 0x03, 0x8e, 0xde, 0xd7, 0x87, 0xcd,
+	0x09, 0x72, 0xaf, 0x3b, 0xdd, 0xa9, 0xa2, 0xfc, 0xfa, 0xe1, 0x7f, 0x4f, 0x0b, 0x8a, 0x8e, 0xf5,
+	0xdd, 0x78, 0xc1, 0x28, 0x08, 0x32, 0x0f, 0x55, 0x01, 0x9c, 0x67, 0xfd, 0xda, 0xb0, 0x39, 0x79,
+	0x5d, 0x39, 0x44, 0xc1, 0x53, 0x99, 0xfb, 0xb6, 0x9c, 0xf4, 0xf7, 0xa6, 0x67, 0x96, 0x42, 0x08,
+	0x4c, 0x56, 0xda, 0x59, 0xbf, 0x8c, 0x4e, 0x9c, 0xf2, 0x78, 0x97, 0x8a, 0x30, 0xe2, 0x04, 0xaf,
+	0x08, 0x0f, 0x41, 0xfe, 0x36, 0x38, 0x35, 0x25, 0x1a, 0x55, 0x89, 0x3e, 0xe6, 0x2d, 0x3f, 0x2f,
+	0xa9, 0xa3, 0xfa, 0xaf, 0x0a, 0xa1, 0x7d, 0xe5, 0x23, 0x04, 0x76, 0x7c, 0x25, 0xb5, 0x42, 0xc3,
+	0xa4, 0x1c, 0xc7, 0x6b, 0xa2, 0xbc, 0xe0, 0xd4, 0x9f, 0x3e, 0xdf, 0x57, 0xc5, 0xca, 0x2e, 0xf8,
+	0x2f, 0x0b, 0x5d, 0xbb, 0x14, 0x7e, 0x4e, 0x41, 0x04, 0x4d, 0xfa, 0x18, 0x58, 0x0b, 0xa3, 0xad,
+	0xc6, 0x84, 0xb0, 0xc5, 0x0c, 0x96, 0x54, 0x80, 0xf3, 0x5c, 0x39, 0xde, 0x56, 0xde, 0xa1, 0x4c,
+	0x66, 0x05, 0xed, 0x77, 0x0a, 0x4b, 0xeb, 0x22, 0x86, 0xa0, 0xc5, 0x2e, 0xf6, 0xfe, 0x97, 0xd3,
+	0x2d, 0xd2, 0xff, 0x64, 0x48, 0x3f, 0x64, 0x48, 0x3f, 0x66, 0x48, 0x3f, 0x65, 0x48, 0xff, 0x7d,
+	0x46, 0xda, 0xf1, 0x8c, 0xb4, 0x7f, 0x67, 0xa4, 0x7d, 0x1b, 0x25, 0xa9, 0x58, 0xee, 0x22, 0x37,
+	0xa6, 0x1b, 0x4f, 0xaa, 0xdf, 0xad, 0x71, 0x04, 0x6a, 0xe5, 0xfd, 0x28, 0xbd, 0x2a, 0xf1, 0x93,
+	0x11, 0x88, 0x1a, 0xea, 0x39, 0xbd, 0xbf, 0x1f, 0x00, 0xeb, 0x28, 0x5a, 0x83, 0xc8, 0x02, 0x00,
+	0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +174,30 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.CircuitBreakerStates) != len(that1.CircuitBreakerStates) {
+		return fmt.Errorf("CircuitBreakerStates this(%v) Not Equal that(%v)", len(this.CircuitBreakerStates), len(that1.CircuitBreakerStates))
+	}
+	for i := range this.CircuitBreakerStates {
+		if !this.CircuitBreakerStates[i].Equal(&that1.CircuitBreakerStates[i]) {
+			return fmt.Errorf("CircuitBreakerStates this[%v](%v) Not Equal that[%v](%v)", i, this.CircuitBreakerStates[i], i, that1.CircuitBreakerStates[i])
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
+		}
+	}
+	if len(this.PriceSnapshots) != len(that1.PriceSnapshots) {
+		return fmt.Errorf("PriceSnapshots this(%v) Not Equal that(%v)", len(this.PriceSnapshots), len(that1.PriceSnapshots))
+	}
+	for i := range this.PriceSnapshots {
+		if !this.PriceSnapshots[i].Equal(&that1.PriceSnapshots[i]) {
+			return fmt.Errorf("PriceSnapshots this[%v](%v) Not Equal that[%v](%v)", i, this.PriceSnapshots[i], i, that1.PriceSnapshots[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +230,30 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CircuitBreakerStates) != len(that1.CircuitBreakerStates) {
+		return false
+	}
+	for i := range this.CircuitBreakerStates {
+		if !this.CircuitBreakerStates[i].Equal(&that1.CircuitBreakerStates[i]) {
+			return false
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return false
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return false
+		}
+	}
+	if len(this.PriceSnapshots) != len(that1.PriceSnapshots) {
+		return false
+	}
+	for i := range this.PriceSnapshots {
+		if !this.PriceSnapshots[i].Equal(&that1.PriceSnapshots[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +276,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CircuitBreakerStates) > 0 {
+		for iNdEx := len(m.CircuitBreakerStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +370,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CircuitBreakerStates) > 0 {
+		for _, e := range m.CircuitBreakerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +493,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerStates = append(m.CircuitBreakerStates, CircuitBreakerState{})
+			if err := m.CircuitBreakerStates[len(m.CircuitBreakerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStats = append(m.OracleStats, OracleStats{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,18 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// CircuitBreakerStatePrefix prefix for the circuit breaker state of a market
+	CircuitBreakerStatePrefix = []byte{0x02}
+
+	// OracleStatsPrefix prefix for the performance counters of an oracle
+	OracleStatsPrefix = []byte{0x03}
+
+	// PriceSnapshotPrefix prefix for the price snapshots of a market
+	PriceSnapshotPrefix = []byte{0x04}
+
+	// LastValidPricePrefix prefix for the last non-zero current price of a market
+	LastValidPricePrefix = []byte{0x05}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -29,6 +41,16 @@ func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
+// LastValidPriceKey returns the key for the last valid price of a market
+func LastValidPriceKey(marketID string) []byte {
+	return append(LastValidPricePrefix, []byte(marketID)...)
+}
+
+// CircuitBreakerStateKey returns the key for the circuit breaker state of a market
+func CircuitBreakerStateKey(marketID string) []byte {
+	return append(CircuitBreakerStatePrefix, []byte(marketID)...)
+}
+
 // RawPriceIteratorKey returns the prefix for the raw price for a single market
 func RawPriceIteratorKey(marketID string) []byte {
 	return append(
@@ -45,6 +67,38 @@ func RawPriceKey(marketID string, oracleAddr sdk.AccAddress) []byte {
 	)
 }
 
+// OracleStatsIteratorKey returns the prefix for the oracle stats of a single market
+func OracleStatsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatsKey returns the key for the stats of an oracle of a market
+func OracleStatsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
+// PriceSnapshotIteratorKey returns the prefix for the price snapshots of a single market
+func PriceSnapshotIteratorKey(marketID string) []byte {
+	return append(
+		PriceSnapshotPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceSnapshotKey returns the key for a slot of the price snapshot ring buffer of a market
+func PriceSnapshotKey(marketID string, slot uint64) []byte {
+	return append(
+		PriceSnapshotIteratorKey(marketID),
+		sdk.Uint64ToBigEndian(slot)...,
+	)
+}
+
 // lengthPrefixWithByte returns the input bytes prefixes with one byte containing its length.
 // It panics if the input is greater than 255 in length.
 func lengthPrefixWithByte(bz []byte) []byte {
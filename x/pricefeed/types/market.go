@@ -41,9 +41,63 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if m.Aggregation != nil {
+		if err := m.Aggregation.Validate(); err != nil {
+			return fmt.Errorf("invalid aggregation params for market %s: %w", m.MarketID, err)
+		}
+		if int(m.Aggregation.MinValidPosts) > len(m.Oracles) {
+			return fmt.Errorf("min valid posts %d is more than the %d oracles of market %s", m.Aggregation.MinValidPosts, len(m.Oracles), m.MarketID)
+		}
+		for _, w := range m.Aggregation.OracleWeights {
+			if !seenOracles[w.OracleAddress.String()] {
+				return fmt.Errorf("oracle weight set for %s, which is not an oracle of market %s", w.OracleAddress, m.MarketID)
+			}
+		}
+	}
+	if m.CircuitBreaker != nil {
+		if err := m.CircuitBreaker.Validate(); err != nil {
+			return fmt.Errorf("invalid circuit breaker params for market %s: %w", m.MarketID, err)
+		}
+	}
+	if m.OraclePerformance != nil {
+		if err := m.OraclePerformance.Validate(); err != nil {
+			return fmt.Errorf("invalid oracle performance params for market %s: %w", m.MarketID, err)
+		}
+	}
+	if m.Snapshots != nil {
+		if err := m.Snapshots.Validate(); err != nil {
+			return fmt.Errorf("invalid snapshot params for market %s: %w", m.MarketID, err)
+		}
+	}
+	if m.IsDerived() {
+		if _, err := ParsePriceExpression(m.PriceExpression); err != nil {
+			return fmt.Errorf("invalid price expression for market %s: %w", m.MarketID, err)
+		}
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+		}
+	}
 	return nil
 }
 
+// AggregationOrDefault returns the market's aggregation params, or the default
+// params when the market does not define any.
+func (m Market) AggregationOrDefault() AggregationParams {
+	if m.Aggregation == nil {
+		return DefaultAggregationParams()
+	}
+	return *m.Aggregation
+}
+
+// OraclePerformanceOrDefault returns the market's oracle performance params, or
+// the default params when the market does not define any.
+func (m Market) OraclePerformanceOrDefault() OraclePerformanceParams {
+	if m.OraclePerformance == nil {
+		return DefaultOraclePerformanceParams()
+	}
+	return *m.OraclePerformance
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
 	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
@@ -65,7 +119,8 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
-	return nil
+	_, err := ms.DerivedMarkets()
+	return err
 }
 
 // NewMarketResponse returns a new MarketResponse
@@ -78,6 +78,168 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid aggregation params",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Aggregation: &AggregationParams{
+					Mode:          AGGREGATION_MODE_WEIGHTED_MEDIAN,
+					OracleWeights: OracleWeights{NewOracleWeight(addr, sdk.NewDec(2))},
+					TrimFraction:  sdk.MustNewDecFromStr("0.25"),
+					MaxDeviation:  sdk.MustNewDecFromStr("0.1"),
+					MinValidPosts: 1,
+				},
+			},
+			true,
+		},
+		{
+			"more min valid posts than oracles",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Aggregation: &AggregationParams{
+					TrimFraction:  sdk.ZeroDec(),
+					MaxDeviation:  sdk.ZeroDec(),
+					MinValidPosts: 2,
+				},
+			},
+			false,
+		},
+		{
+			"invalid aggregation mode",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Aggregation: &AggregationParams{Mode: 10, TrimFraction: sdk.ZeroDec(), MaxDeviation: sdk.ZeroDec()},
+			},
+			false,
+		},
+		{
+			"weight for unknown oracle",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Aggregation: &AggregationParams{
+					OracleWeights: OracleWeights{NewOracleWeight(addr, sdk.OneDec())},
+					TrimFraction:  sdk.ZeroDec(),
+					MaxDeviation:  sdk.ZeroDec(),
+				},
+			},
+			false,
+		},
+		{
+			"non positive oracle weight",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Aggregation: &AggregationParams{
+					OracleWeights: OracleWeights{NewOracleWeight(addr, sdk.ZeroDec())},
+					TrimFraction:  sdk.ZeroDec(),
+					MaxDeviation:  sdk.ZeroDec(),
+				},
+			},
+			false,
+		},
+		{
+			"trim fraction too large",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Aggregation: &AggregationParams{TrimFraction: sdk.MustNewDecFromStr("0.5"), MaxDeviation: sdk.ZeroDec()},
+			},
+			false,
+		},
+		{
+			"unset aggregation decimals",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Aggregation: &AggregationParams{},
+			},
+			false,
+		},
+		{
+			"negative max deviation",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Aggregation: &AggregationParams{TrimFraction: sdk.ZeroDec(), MaxDeviation: sdk.NewDec(-1)},
+			},
+			false,
+		},
+		{
+			"valid circuit breaker params",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				CircuitBreaker: &CircuitBreakerParams{MaxBlockChange: sdk.MustNewDecFromStr("0.1"), MaxHourlyChange: sdk.ZeroDec(), RecoveryBlocks: 10},
+			},
+			true,
+		},
+		{
+			"unset circuit breaker decimals",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				CircuitBreaker: &CircuitBreakerParams{},
+			},
+			false,
+		},
+		{
+			"negative max hourly change",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				CircuitBreaker: &CircuitBreakerParams{MaxBlockChange: sdk.ZeroDec(), MaxHourlyChange: sdk.NewDec(-1)},
+			},
+			false,
+		},
+		{
+			"valid oracle performance params",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				OraclePerformance: &OraclePerformanceParams{MissWindow: time.Hour, MaxConsecutiveMisses: 24},
+			},
+			true,
+		},
+		{
+			"derived market with oracles",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{addr},
+				PriceExpression: "xrp:usd / bnb:usd",
+			},
+			false,
+		},
+		{
+			"zero miss window",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				OraclePerformance: &OraclePerformanceParams{MaxConsecutiveMisses: 24},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -14,13 +14,21 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
+
+	// MaxPriceEntries defines the max number of prices posted in a single MsgPostPrices
+	MaxPriceEntries = 100
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+)
 
 // NewMsgPostPrice returns a new MsgPostPrice
 func NewMsgPostPrice(from string, marketID string, price sdk.Dec, expiry time.Time) *MsgPostPrice {
@@ -69,3 +77,88 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgPostPrices returns a new MsgPostPrices
+func NewMsgPostPrices(from string, prices PriceEntries) *MsgPostPrices {
+	return &MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errorsmod.Wrap(ErrEmptyInput, "prices cannot be empty")
+	}
+	if len(msg.Prices) > MaxPriceEntries {
+		return fmt.Errorf("cannot post more than %d prices, got %d", MaxPriceEntries, len(msg.Prices))
+	}
+	return msg.Prices.Validate()
+}
+
+// NewPriceEntry returns a new PriceEntry
+func NewPriceEntry(marketID string, price sdk.Dec, expiry time.Time) PriceEntry {
+	return PriceEntry{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic validation of the price entry
+func (e PriceEntry) Validate() error {
+	if strings.TrimSpace(e.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if e.Price.IsNil() || e.Price.IsNegative() {
+		return fmt.Errorf("price cannot be nil or negative: %s", e.Price)
+	}
+	if e.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
+
+// PriceEntries is a slice of PriceEntry
+type PriceEntries []PriceEntry
+
+// Validate checks if all the entries are valid and there are no duplicated
+// markets.
+func (es PriceEntries) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, e := range es {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[e.MarketID] {
+			return fmt.Errorf("duplicated price for market %s", e.MarketID)
+		}
+		seenMarkets[e.MarketID] = true
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -36,3 +37,38 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price, _ := sdk.NewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+	negativePrice, _ := sdk.NewDecFromStr("-3.05")
+
+	tooManyEntries := make(PriceEntries, MaxPriceEntries+1)
+	for i := range tooManyEntries {
+		tooManyEntries[i] = NewPriceEntry(fmt.Sprintf("market%d", i), price, expiry)
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", MsgPostPrices{addr.String(), PriceEntries{{"xrp", price, expiry}, {"bnb", price, expiry}}}, true},
+		{"emptyAddr", MsgPostPrices{"", PriceEntries{{"xrp", price, expiry}}}, false},
+		{"noPrices", MsgPostPrices{addr.String(), PriceEntries{}}, false},
+		{"tooManyPrices", MsgPostPrices{addr.String(), tooManyEntries}, false},
+		{"emptyAsset", MsgPostPrices{addr.String(), PriceEntries{{"", price, expiry}}}, false},
+		{"negativePrice", MsgPostPrices{addr.String(), PriceEntries{{"xrp", negativePrice, expiry}}}, false},
+		{"duplicatedMarket", MsgPostPrices{addr.String(), PriceEntries{{"xrp", price, expiry}, {"xrp", price, expiry}}}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
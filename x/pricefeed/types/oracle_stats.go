@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMissWindow is the miss window of markets without oracle performance params.
+const DefaultMissWindow = time.Hour
+
+// NewOraclePerformanceParams returns a new OraclePerformanceParams
+func NewOraclePerformanceParams(missWindow time.Duration, maxConsecutiveMisses uint64) OraclePerformanceParams {
+	return OraclePerformanceParams{
+		MissWindow:           missWindow,
+		MaxConsecutiveMisses: maxConsecutiveMisses,
+	}
+}
+
+// DefaultOraclePerformanceParams returns the oracle performance params used by
+// markets that do not define their own: misses are counted but never penalized.
+func DefaultOraclePerformanceParams() OraclePerformanceParams {
+	return NewOraclePerformanceParams(DefaultMissWindow, 0)
+}
+
+// Validate performs a basic validation of the oracle performance params
+func (p OraclePerformanceParams) Validate() error {
+	if p.MissWindow <= 0 {
+		return fmt.Errorf("miss window must be positive, got %s", p.MissWindow)
+	}
+	return nil
+}
+
+// NewOracleStats returns a new OracleStats with empty counters
+func NewOracleStats(marketID string, oracle sdk.AccAddress, windowStart time.Time) OracleStats {
+	return OracleStats{
+		MarketID:         marketID,
+		OracleAddress:    oracle,
+		AverageDeviation: sdk.ZeroDec(),
+		WindowStart:      windowStart,
+	}
+}
+
+// Validate performs a basic validation of the oracle stats
+func (s OracleStats) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(s.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if s.AverageDeviation.IsNil() || s.AverageDeviation.IsNegative() {
+		return fmt.Errorf("average deviation cannot be nil or negative %s", s.AverageDeviation)
+	}
+	if s.ConsecutiveMisses > s.MissedWindows {
+		return fmt.Errorf("consecutive misses %d cannot exceed missed windows %d", s.ConsecutiveMisses, s.MissedWindows)
+	}
+	return nil
+}
+
+// RecordPost updates the counters with a price posted at the given time. The
+// deviation is only sampled when the market has a current price.
+func (s *OracleStats) RecordPost(price, currentPrice sdk.Dec, postTime time.Time) {
+	s.TotalPosts++
+	s.LastPostTime = postTime
+
+	if currentPrice.IsNil() || !currentPrice.IsPositive() {
+		return
+	}
+	deviation := price.Sub(currentPrice).Abs().Quo(currentPrice)
+	s.DeviationSamples++
+	s.AverageDeviation = s.AverageDeviation.Add(
+		deviation.Sub(s.AverageDeviation).QuoInt64(int64(s.DeviationSamples)),
+	)
+}
+
+// ToOracleStatsResponse returns a new OracleStatsResponse from an OracleStats
+func (s OracleStats) ToOracleStatsResponse(active bool) OracleStatsResponse {
+	return OracleStatsResponse{
+		MarketID:          s.MarketID,
+		OracleAddress:     s.OracleAddress.String(),
+		TotalPosts:        s.TotalPosts,
+		MissedWindows:     s.MissedWindows,
+		ConsecutiveMisses: s.ConsecutiveMisses,
+		AverageDeviation:  s.AverageDeviation,
+		LastPostTime:      s.LastPostTime,
+		Active:            active,
+	}
+}
+
+// OracleStatsList is a slice of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the stats are valid and there are no duplicated
+// entries.
+func (ss OracleStatsList) Validate() error {
+	seenStats := make(map[string]bool)
+	for _, s := range ss {
+		if seenStats[s.MarketID+s.OracleAddress.String()] {
+			return fmt.Errorf("duplicated oracle stats for market id %s and oracle address %s", s.MarketID, s.OracleAddress)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seenStats[s.MarketID+s.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// OracleStatsResponses is a slice of OracleStatsResponse
+type OracleStatsResponses []OracleStatsResponse
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+	fmt "fmt"
+	"strings"
+
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypePricefeedResetMarketHalt defines the type for a PricefeedResetMarketHaltProposal
+	ProposalTypePricefeedResetMarketHalt = "PricefeedResetMarketHalt"
+)
+
+// Assert PricefeedResetMarketHaltProposal implements govtypes.Content at compile-time
+var _ govv1beta1.Content = &PricefeedResetMarketHaltProposal{}
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypePricefeedResetMarketHalt)
+	govcodec.ModuleCdc.Amino.RegisterConcrete(&PricefeedResetMarketHaltProposal{}, "kava/PricefeedResetMarketHaltProposal", nil)
+}
+
+// NewPricefeedResetMarketHaltProposal creates a new market halt reset proposal.
+func NewPricefeedResetMarketHaltProposal(title, description, marketID string) *PricefeedResetMarketHaltProposal {
+	return &PricefeedResetMarketHaltProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *PricefeedResetMarketHaltProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *PricefeedResetMarketHaltProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p *PricefeedResetMarketHaltProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p *PricefeedResetMarketHaltProposal) ProposalType() string {
+	return ProposalTypePricefeedResetMarketHalt
+}
+
+// String implements fmt.Stringer
+func (p *PricefeedResetMarketHaltProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Pricefeed Reset Market Halt Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s
+`, p.Title, p.Description, p.MarketID))
+	return b.String()
+}
+
+// ValidateBasic stateless validation of the proposal.
+func (p *PricefeedResetMarketHaltProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	return nil
+}
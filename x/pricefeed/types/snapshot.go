@@ -0,0 +1,146 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSnapshotsPerMarket is the maximum number of snapshots a market can retain.
+const MaxSnapshotsPerMarket = 1000
+
+// NewSnapshotParams returns a new SnapshotParams
+func NewSnapshotParams(granularity, retention, liquidationTwapWindow time.Duration) SnapshotParams {
+	return SnapshotParams{
+		Granularity:           granularity,
+		Retention:             retention,
+		LiquidationTwapWindow: liquidationTwapWindow,
+	}
+}
+
+// Validate performs a basic validation of the snapshot params
+func (p SnapshotParams) Validate() error {
+	if p.Granularity <= 0 {
+		return fmt.Errorf("snapshot granularity must be positive, got %s", p.Granularity)
+	}
+	if p.Retention < p.Granularity {
+		return fmt.Errorf("snapshot retention %s cannot be shorter than granularity %s", p.Retention, p.Granularity)
+	}
+	if p.Capacity() > MaxSnapshotsPerMarket {
+		return fmt.Errorf("snapshot retention %s keeps more than %d snapshots of %s", p.Retention, MaxSnapshotsPerMarket, p.Granularity)
+	}
+	if p.LiquidationTwapWindow < 0 || p.LiquidationTwapWindow > p.Retention {
+		return fmt.Errorf("liquidation twap window %s must be between zero and the snapshot retention %s", p.LiquidationTwapWindow, p.Retention)
+	}
+	return nil
+}
+
+// Capacity returns the number of snapshots retained, which is the size of the market's ring buffer.
+func (p SnapshotParams) Capacity() uint64 {
+	return uint64(p.Retention / p.Granularity)
+}
+
+// Period returns the index of the granularity period containing t.
+func (p SnapshotParams) Period(t time.Time) uint64 {
+	return uint64(t.UnixNano() / int64(p.Granularity))
+}
+
+// Slot returns the position in the ring buffer of the snapshot recorded at t.
+func (p SnapshotParams) Slot(t time.Time) uint64 {
+	return p.Period(t) % p.Capacity()
+}
+
+// NewPriceSnapshot returns a new PriceSnapshot
+func NewPriceSnapshot(marketID string, price sdk.Dec, t time.Time) PriceSnapshot {
+	return PriceSnapshot{
+		MarketID: marketID,
+		Price:    price,
+		Time:     t,
+	}
+}
+
+// Validate performs a basic validation of the price snapshot
+func (s PriceSnapshot) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if s.Price.IsNil() || !s.Price.IsPositive() {
+		return fmt.Errorf("snapshot price must be positive %s", s.Price)
+	}
+	if s.Time.Unix() <= 0 {
+		return errors.New("snapshot time cannot be zero")
+	}
+	return nil
+}
+
+// PriceSnapshots is a slice of PriceSnapshot
+type PriceSnapshots []PriceSnapshot
+
+// Validate checks if all the snapshots are valid and there are no duplicated
+// entries.
+func (ss PriceSnapshots) Validate() error {
+	seenSnapshots := make(map[string]bool)
+	for _, s := range ss {
+		key := s.MarketID + s.Time.String()
+		if seenSnapshots[key] {
+			return fmt.Errorf("duplicated price snapshot for market id %s at %s", s.MarketID, s.Time)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seenSnapshots[key] = true
+	}
+	return nil
+}
+
+// SortByTime sorts the snapshots from oldest to newest.
+func (ss PriceSnapshots) SortByTime() {
+	sort.Slice(ss, func(i, j int) bool { return ss[i].Time.Before(ss[j].Time) })
+}
+
+// PriceAt returns the latest snapshot recorded at or before t. The snapshots must
+// be sorted by time.
+func (ss PriceSnapshots) PriceAt(t time.Time) (PriceSnapshot, bool) {
+	i := sort.Search(len(ss), func(i int) bool { return ss[i].Time.After(t) })
+	if i == 0 {
+		return PriceSnapshot{}, false
+	}
+	return ss[i-1], true
+}
+
+// Twap returns the time-weighted average price between start and end, where each
+// snapshot's price holds until the next snapshot. The snapshots must be sorted by
+// time and include a snapshot at or before start.
+func (ss PriceSnapshots) Twap(start, end time.Time) (sdk.Dec, error) {
+	if end.Before(start) {
+		return sdk.Dec{}, errorsmod.Wrapf(ErrInvalidTimeRange, "end %s is before start %s", end, start)
+	}
+	first, found := ss.PriceAt(start)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(ErrNoPriceSnapshot, "no snapshot at or before %s", start)
+	}
+	if end.Equal(start) {
+		return first.Price, nil
+	}
+
+	weightedSum := sdk.ZeroDec()
+	price, from := first.Price, start
+	for _, s := range ss {
+		if !s.Time.After(start) {
+			continue
+		}
+		if !s.Time.Before(end) {
+			break
+		}
+		weightedSum = weightedSum.Add(price.MulInt64(int64(s.Time.Sub(from))))
+		price, from = s.Price, s.Time
+	}
+	weightedSum = weightedSum.Add(price.MulInt64(int64(end.Sub(from))))
+
+	return weightedSum.QuoInt64(int64(end.Sub(start))), nil
+}
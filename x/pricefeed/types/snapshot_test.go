@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSnapshotParamsValidate(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		params  SnapshotParams
+		expPass bool
+	}{
+		{"valid", NewSnapshotParams(time.Minute, time.Hour, 0), true},
+		{"retention equal to granularity", NewSnapshotParams(time.Minute, time.Minute, 0), true},
+		{"zero granularity", NewSnapshotParams(0, time.Hour, 0), false},
+		{"retention shorter than granularity", NewSnapshotParams(time.Hour, time.Minute, 0), false},
+		{"too many snapshots", NewSnapshotParams(time.Second, time.Hour, 0), false},
+		{"liquidation twap window", NewSnapshotParams(time.Minute, time.Hour, time.Hour), true},
+		{"liquidation twap window longer than retention", NewSnapshotParams(time.Minute, time.Hour, 2*time.Hour), false},
+		{"negative liquidation twap window", NewSnapshotParams(time.Minute, time.Hour, -time.Minute), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceSnapshotsTwap(t *testing.T) {
+	start := time.Date(2022, 7, 20, 0, 0, 0, 0, time.UTC)
+	snapshots := PriceSnapshots{
+		NewPriceSnapshot("market", sdk.MustNewDecFromStr("10.0"), start),
+		NewPriceSnapshot("market", sdk.MustNewDecFromStr("20.0"), start.Add(10*time.Minute)),
+		NewPriceSnapshot("market", sdk.MustNewDecFromStr("40.0"), start.Add(30*time.Minute)),
+	}
+
+	testCases := []struct {
+		msg      string
+		start    time.Time
+		end      time.Time
+		expPrice sdk.Dec
+		expErr   error
+	}{
+		{"single snapshot", start, start.Add(5 * time.Minute), sdk.MustNewDecFromStr("10.0"), nil},
+		{"across snapshots", start, start.Add(40 * time.Minute), sdk.MustNewDecFromStr("22.5"), nil},
+		{"start between snapshots", start.Add(5 * time.Minute), start.Add(15 * time.Minute), sdk.MustNewDecFromStr("15.0"), nil},
+		{"instant", start.Add(10 * time.Minute), start.Add(10 * time.Minute), sdk.MustNewDecFromStr("20.0"), nil},
+		{"start before history", start.Add(-time.Minute), start.Add(time.Minute), sdk.Dec{}, ErrNoPriceSnapshot},
+		{"end before start", start.Add(time.Minute), start, sdk.Dec{}, ErrInvalidTimeRange},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			price, err := snapshots.Twap(tc.start, tc.end)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPrice, price)
+		})
+	}
+}
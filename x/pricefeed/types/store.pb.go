@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +31,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMode enumerates the methods used to combine posted prices.
+type AggregationMode int32
+
+const (
+	// AGGREGATION_MODE_UNSPECIFIED falls back to the median of all valid posts.
+	AGGREGATION_MODE_UNSPECIFIED AggregationMode = 0
+	// AGGREGATION_MODE_MEDIAN uses the median of all valid posts.
+	AGGREGATION_MODE_MEDIAN AggregationMode = 1
+	// AGGREGATION_MODE_WEIGHTED_MEDIAN uses the median of all valid posts where
+	// each post counts with the weight of its oracle.
+	AGGREGATION_MODE_WEIGHTED_MEDIAN AggregationMode = 2
+	// AGGREGATION_MODE_TRIMMED_MEAN uses the mean of all valid posts after
+	// discarding the highest and lowest prices.
+	AGGREGATION_MODE_TRIMMED_MEAN AggregationMode = 3
+)
+
+var AggregationMode_name = map[int32]string{
+	0: "AGGREGATION_MODE_UNSPECIFIED",
+	1: "AGGREGATION_MODE_MEDIAN",
+	2: "AGGREGATION_MODE_WEIGHTED_MEDIAN",
+	3: "AGGREGATION_MODE_TRIMMED_MEAN",
+}
+
+var AggregationMode_value = map[string]int32{
+	"AGGREGATION_MODE_UNSPECIFIED":     0,
+	"AGGREGATION_MODE_MEDIAN":          1,
+	"AGGREGATION_MODE_WEIGHTED_MEDIAN": 2,
+	"AGGREGATION_MODE_TRIMMED_MEAN":    3,
+}
+
+func (x AggregationMode) String() string {
+	return proto.EnumName(AggregationMode_name, int32(x))
+}
+
+func (AggregationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{0}
+}
+
 // Params defines the parameters for the pricefeed module.
 type Params struct {
 	Markets Markets `protobuf:"bytes,1,rep,name=markets,proto3,castrepeated=Markets" json:"markets"`
@@ -82,6 +121,24 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// aggregation defines how the valid posted prices of the market are combined
+	// into its current price. Markets without aggregation params use the median.
+	Aggregation *AggregationParams `protobuf:"bytes,6,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// circuit_breaker defines the price change limits after which the market is
+	// halted. Markets without circuit breaker params are never halted.
+	CircuitBreaker *CircuitBreakerParams `protobuf:"bytes,7,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// oracle_performance defines how oracle misses are measured and penalized.
+	// Markets without oracle performance params use a one hour miss window and
+	// never remove oracles.
+	OraclePerformance *OraclePerformanceParams `protobuf:"bytes,8,opt,name=oracle_performance,json=oraclePerformance,proto3" json:"oracle_performance,omitempty"`
+	// snapshots defines how often the current price is recorded and for how long
+	// the records are kept. Markets without snapshot params keep no history.
+	Snapshots *SnapshotParams `protobuf:"bytes,9,opt,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// price_expression makes the market a derived market, whose price is computed
+	// from the current prices of other markets instead of oracle posts. It is a
+	// product of market IDs and their inverses, for example
+	// "atom:btc * btc:usd" or "1 / kava:usd". Empty for oracle markets.
+	PriceExpression string `protobuf:"bytes,10,opt,name=price_expression,json=priceExpression,proto3" json:"price_expression,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,26 +209,70 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
-// PostedPrice defines a price for market posted by a specific oracle.
-type PostedPrice struct {
-	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
-	Price         github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Expiry        time.Time                                     `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
+func (m *Market) GetAggregation() *AggregationParams {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
 }
 
-func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
-func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
-func (*PostedPrice) ProtoMessage()    {}
-func (*PostedPrice) Descriptor() ([]byte, []int) {
+func (m *Market) GetCircuitBreaker() *CircuitBreakerParams {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+func (m *Market) GetOraclePerformance() *OraclePerformanceParams {
+	if m != nil {
+		return m.OraclePerformance
+	}
+	return nil
+}
+
+func (m *Market) GetSnapshots() *SnapshotParams {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *Market) GetPriceExpression() string {
+	if m != nil {
+		return m.PriceExpression
+	}
+	return ""
+}
+
+// AggregationParams defines how the posted prices of a market are aggregated.
+type AggregationParams struct {
+	Mode AggregationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=kava.pricefeed.v1beta1.AggregationMode" json:"mode,omitempty"`
+	// oracle_weights are the weights used by the weighted median. Oracles without
+	// a weight count with a weight of one.
+	OracleWeights OracleWeights `protobuf:"bytes,2,rep,name=oracle_weights,json=oracleWeights,proto3,castrepeated=OracleWeights" json:"oracle_weights"`
+	// trim_fraction is the fraction of posts discarded from each end of the
+	// sorted prices by the trimmed mean.
+	TrimFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=trim_fraction,json=trimFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_fraction"`
+	// max_deviation rejects posts that differ from the last current price by more
+	// than this fraction. Zero disables the check.
+	MaxDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation"`
+	// min_valid_posts is the number of accepted posts required before a price is
+	// accepted. Zero is treated as one.
+	MinValidPosts uint32 `protobuf:"varint,5,opt,name=min_valid_posts,json=minValidPosts,proto3" json:"min_valid_posts,omitempty"`
+}
+
+func (m *AggregationParams) Reset()         { *m = AggregationParams{} }
+func (m *AggregationParams) String() string { return proto.CompactTextString(m) }
+func (*AggregationParams) ProtoMessage()    {}
+func (*AggregationParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40639f5e16f9a, []int{2}
 }
-func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
+func (m *AggregationParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PostedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AggregationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PostedPrice.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AggregationParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -181,58 +282,57 @@ func (m *PostedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PostedPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostedPrice.Merge(m, src)
+func (m *AggregationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationParams.Merge(m, src)
 }
-func (m *PostedPrice) XXX_Size() int {
+func (m *AggregationParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *PostedPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostedPrice.DiscardUnknown(m)
+func (m *AggregationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostedPrice proto.InternalMessageInfo
+var xxx_messageInfo_AggregationParams proto.InternalMessageInfo
 
-func (m *PostedPrice) GetMarketID() string {
+func (m *AggregationParams) GetMode() AggregationMode {
 	if m != nil {
-		return m.MarketID
+		return m.Mode
 	}
-	return ""
+	return AGGREGATION_MODE_UNSPECIFIED
 }
 
-func (m *PostedPrice) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *AggregationParams) GetOracleWeights() OracleWeights {
 	if m != nil {
-		return m.OracleAddress
+		return m.OracleWeights
 	}
 	return nil
 }
 
-func (m *PostedPrice) GetExpiry() time.Time {
+func (m *AggregationParams) GetMinValidPosts() uint32 {
 	if m != nil {
-		return m.Expiry
+		return m.MinValidPosts
 	}
-	return time.Time{}
+	return 0
 }
 
-// CurrentPrice defines a current price for a particular market in the pricefeed
-// module.
-type CurrentPrice struct {
-	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+// OracleWeight defines the weight of an oracle in a weighted median.
+type OracleWeight struct {
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	Weight        github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 }
 
-func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
-func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
-func (*CurrentPrice) ProtoMessage()    {}
-func (*CurrentPrice) Descriptor() ([]byte, []int) {
+func (m *OracleWeight) Reset()         { *m = OracleWeight{} }
+func (m *OracleWeight) String() string { return proto.CompactTextString(m) }
+func (*OracleWeight) ProtoMessage()    {}
+func (*OracleWeight) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40639f5e16f9a, []int{3}
 }
-func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
+func (m *OracleWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CurrentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OracleWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CurrentPrice.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OracleWeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -242,681 +342,3921 @@ func (m *CurrentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CurrentPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrentPrice.Merge(m, src)
+func (m *OracleWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleWeight.Merge(m, src)
 }
-func (m *CurrentPrice) XXX_Size() int {
+func (m *OracleWeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *CurrentPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrentPrice.DiscardUnknown(m)
+func (m *OracleWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleWeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrentPrice proto.InternalMessageInfo
+var xxx_messageInfo_OracleWeight proto.InternalMessageInfo
 
-func (m *CurrentPrice) GetMarketID() string {
+func (m *OracleWeight) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.MarketID
+		return m.OracleAddress
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
-	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
-	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
-	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
+// CircuitBreakerParams defines the price change limits of a market.
+type CircuitBreakerParams struct {
+	// max_block_change is the maximum fractional change of the current price
+	// between two consecutive updates. Zero disables the check.
+	MaxBlockChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_block_change,json=maxBlockChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_block_change"`
+	// max_hourly_change is the maximum fractional change of the current price
+	// within an hour. Zero disables the check.
+	MaxHourlyChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_hourly_change,json=maxHourlyChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_hourly_change"`
+	// recovery_blocks is the number of consecutive price updates within
+	// max_block_change after which a halted market resumes. Zero means a halted
+	// market can only be reset by governance.
+	RecoveryBlocks uint64 `protobuf:"varint,3,opt,name=recovery_blocks,json=recoveryBlocks,proto3" json:"recovery_blocks,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("kava/pricefeed/v1beta1/store.proto", fileDescriptor_9df40639f5e16f9a)
+func (m *CircuitBreakerParams) Reset()         { *m = CircuitBreakerParams{} }
+func (m *CircuitBreakerParams) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerParams) ProtoMessage()    {}
+func (*CircuitBreakerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{4}
 }
-
-var fileDescriptor_9df40639f5e16f9a = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0x6d, 0xfe, 0x5c, 0x02, 0x48, 0x06, 0x55, 0x26, 0x12, 0x76, 0x94, 0x01, 0x19,
-	0xa1, 0x9c, 0xd5, 0xb2, 0xb2, 0xc4, 0x64, 0x20, 0x43, 0xa5, 0xc8, 0x30, 0xb1, 0x44, 0x67, 0xfb,
-	0xd5, 0x58, 0x89, 0x39, 0x73, 0x77, 0x89, 0x9a, 0x89, 0xaf, 0xd0, 0x8f, 0x81, 0x90, 0xd8, 0xf8,
-	0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x90, 0x16, 0xe7, 0x03, 0xb0, 0x33, 0x21, 0xdf, 0xd9, 0x55, 0x07,
-	0x06, 0x2a, 0x98, 0xee, 0xde, 0xef, 0xfd, 0xde, 0xbf, 0xdf, 0xbd, 0xc3, 0xc3, 0x05, 0x5d, 0x53,
-	0x37, 0xe3, 0x49, 0x08, 0x27, 0x00, 0x91, 0xbb, 0x3e, 0x0c, 0x40, 0xd2, 0x43, 0x57, 0x48, 0xc6,
-	0x81, 0x64, 0x9c, 0x49, 0x66, 0x1c, 0x14, 0x1c, 0x72, 0xcd, 0x21, 0x25, 0xa7, 0xff, 0x30, 0x64,
-	0x22, 0x65, 0x62, 0xae, 0x58, 0xae, 0x36, 0x74, 0x48, 0xff, 0x41, 0xcc, 0x62, 0xa6, 0xf1, 0xe2,
-	0x56, 0xa2, 0x76, 0xcc, 0x58, 0xbc, 0x04, 0x57, 0x59, 0xc1, 0xea, 0xc4, 0x95, 0x49, 0x0a, 0x42,
-	0xd2, 0x34, 0xd3, 0x84, 0xe1, 0x2b, 0xdc, 0x9c, 0x51, 0x4e, 0x53, 0x61, 0x4c, 0x71, 0x2b, 0xa5,
-	0x7c, 0x01, 0x52, 0x98, 0x68, 0xd0, 0x70, 0xba, 0x47, 0x16, 0xf9, 0x73, 0x17, 0xe4, 0x58, 0xd1,
-	0xbc, 0x7b, 0xe7, 0x5b, 0xbb, 0xf6, 0xe9, 0xd2, 0x6e, 0x69, 0x5b, 0xf8, 0x55, 0xfc, 0xf0, 0x27,
-	0xc2, 0x4d, 0x0d, 0x1a, 0x4f, 0x70, 0x47, 0xa3, 0xf3, 0x24, 0x32, 0xd1, 0x00, 0x39, 0x1d, 0xaf,
-	0x97, 0x6f, 0xed, 0xb6, 0x76, 0x4f, 0x27, 0x7e, 0x5b, 0xbb, 0xa7, 0x91, 0xf1, 0x08, 0xe3, 0x80,
-	0x0a, 0x98, 0x53, 0x21, 0x40, 0x9a, 0xf5, 0x82, 0xeb, 0x77, 0x0a, 0x64, 0x5c, 0x00, 0x86, 0x8d,
-	0xbb, 0xef, 0x57, 0x4c, 0x56, 0xfe, 0x86, 0xf2, 0x63, 0x05, 0x69, 0x42, 0x80, 0x5b, 0x8c, 0xd3,
-	0x70, 0x09, 0xc2, 0xdc, 0x1b, 0x34, 0x9c, 0x9e, 0xf7, 0xf2, 0xd7, 0xd6, 0x1e, 0xc5, 0x89, 0x7c,
-	0xbb, 0x0a, 0x48, 0xc8, 0xd2, 0x52, 0xaf, 0xf2, 0x18, 0x89, 0x68, 0xe1, 0xca, 0x4d, 0x06, 0x82,
-	0x8c, 0xc3, 0x70, 0x1c, 0x45, 0x1c, 0x84, 0xf8, 0xfa, 0x65, 0x74, 0xbf, 0x54, 0xb5, 0x44, 0xbc,
-	0x8d, 0x04, 0xe1, 0x57, 0x89, 0x8d, 0x03, 0xdc, 0xa4, 0xa1, 0x4c, 0xd6, 0x60, 0xee, 0x0f, 0x90,
-	0xd3, 0xf6, 0x4b, 0x6b, 0xf8, 0xb9, 0x8e, 0xbb, 0x33, 0x26, 0x24, 0x44, 0xb3, 0x42, 0xae, 0xdb,
-	0x8c, 0xcd, 0xf0, 0x5d, 0x9d, 0x7d, 0x4e, 0x75, 0x49, 0x35, 0xfa, 0xff, 0xec, 0xfe, 0x8e, 0xce,
-	0x5f, 0x62, 0xc6, 0x04, 0xef, 0xab, 0x37, 0xd5, 0x12, 0x7a, 0xa4, 0x78, 0xc6, 0xef, 0x5b, 0xfb,
-	0xf1, 0x5f, 0xd4, 0x9a, 0x40, 0xe8, 0xeb, 0x60, 0xe3, 0x39, 0x6e, 0xc2, 0x69, 0x96, 0xf0, 0x8d,
-	0xb9, 0x37, 0x40, 0x4e, 0xf7, 0xa8, 0x4f, 0xf4, 0xaa, 0x91, 0x6a, 0xd5, 0xc8, 0xeb, 0x6a, 0xd5,
-	0xbc, 0x76, 0x51, 0xe2, 0xec, 0xd2, 0x46, 0x7e, 0x19, 0x33, 0xfc, 0x80, 0x7b, 0x2f, 0x56, 0x9c,
-	0xc3, 0x3b, 0x79, 0x6b, 0xbd, 0xae, 0xdb, 0xaf, 0xff, 0x43, 0xfb, 0xde, 0xf1, 0xd5, 0x0f, 0x0b,
-	0x7d, 0xcc, 0x2d, 0x74, 0x9e, 0x5b, 0xe8, 0x22, 0xb7, 0xd0, 0x55, 0x6e, 0xa1, 0xb3, 0x9d, 0x55,
-	0xbb, 0xd8, 0x59, 0xb5, 0x6f, 0x3b, 0xab, 0xf6, 0xe6, 0xe9, 0x8d, 0x84, 0xc5, 0x47, 0x18, 0x2d,
-	0x69, 0x20, 0xd4, 0xcd, 0x3d, 0xbd, 0xf1, 0x7d, 0x55, 0xe6, 0xa0, 0xa9, 0xa6, 0x7e, 0xf6, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xb5, 0x5b, 0xc1, 0xdd, 0x03, 0x00, 0x00,
+func (m *CircuitBreakerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *Params) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *CircuitBreakerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *CircuitBreakerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerParams.Merge(m, src)
+}
+func (m *CircuitBreakerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerParams.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Params")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *Params but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Params but is not nil && this == nil")
-	}
-	if len(this.Markets) != len(that1.Markets) {
-		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
+var xxx_messageInfo_CircuitBreakerParams proto.InternalMessageInfo
+
+func (m *CircuitBreakerParams) GetRecoveryBlocks() uint64 {
+	if m != nil {
+		return m.RecoveryBlocks
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
+	return 0
+}
+
+// CircuitBreakerState defines the circuit breaker state of a market.
+type CircuitBreakerState struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// reference_price is the current price at the start of the hourly window.
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price"`
+	// reference_time is the start of the hourly window.
+	ReferenceTime time.Time `protobuf:"bytes,3,opt,name=reference_time,json=referenceTime,proto3,stdtime" json:"reference_time"`
+	// halted is true when the market has tripped its circuit breaker.
+	Halted bool `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+	// stable_blocks is the number of consecutive stable price updates since the
+	// market was halted.
+	StableBlocks uint64 `protobuf:"varint,5,opt,name=stable_blocks,json=stableBlocks,proto3" json:"stable_blocks,omitempty"`
+}
+
+func (m *CircuitBreakerState) Reset()         { *m = CircuitBreakerState{} }
+func (m *CircuitBreakerState) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerState) ProtoMessage()    {}
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{5}
+}
+func (m *CircuitBreakerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return nil
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CircuitBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerState.Merge(m, src)
+}
+func (m *CircuitBreakerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerState proto.InternalMessageInfo
+
+func (m *CircuitBreakerState) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *CircuitBreakerState) GetReferenceTime() time.Time {
+	if m != nil {
+		return m.ReferenceTime
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return time.Time{}
+}
+
+func (m *CircuitBreakerState) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	if len(this.Markets) != len(that1.Markets) {
-		return false
+	return false
+}
+
+func (m *CircuitBreakerState) GetStableBlocks() uint64 {
+	if m != nil {
+		return m.StableBlocks
 	}
-	for i := range this.Markets {
-		if !this.Markets[i].Equal(&that1.Markets[i]) {
-			return false
+	return 0
+}
+
+// OraclePerformanceParams defines how the oracles of a market are monitored.
+type OraclePerformanceParams struct {
+	// miss_window is the period an oracle must post within to avoid a miss.
+	MissWindow time.Duration `protobuf:"bytes,1,opt,name=miss_window,json=missWindow,proto3,stdduration" json:"miss_window"`
+	// max_consecutive_misses is the number of consecutive missed windows after
+	// which an oracle is removed from the market. Zero disables removal.
+	MaxConsecutiveMisses uint64 `protobuf:"varint,2,opt,name=max_consecutive_misses,json=maxConsecutiveMisses,proto3" json:"max_consecutive_misses,omitempty"`
+}
+
+func (m *OraclePerformanceParams) Reset()         { *m = OraclePerformanceParams{} }
+func (m *OraclePerformanceParams) String() string { return proto.CompactTextString(m) }
+func (*OraclePerformanceParams) ProtoMessage()    {}
+func (*OraclePerformanceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{6}
+}
+func (m *OraclePerformanceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePerformanceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePerformanceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Market) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+func (m *OraclePerformanceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePerformanceParams.Merge(m, src)
+}
+func (m *OraclePerformanceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePerformanceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePerformanceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePerformanceParams proto.InternalMessageInfo
+
+func (m *OraclePerformanceParams) GetMissWindow() time.Duration {
+	if m != nil {
+		return m.MissWindow
 	}
+	return 0
+}
 
-	that1, ok := that.(*Market)
-	if !ok {
-		that2, ok := that.(Market)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Market")
-		}
+func (m *OraclePerformanceParams) GetMaxConsecutiveMisses() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveMisses
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return 0
+}
+
+// OracleStats defines the performance counters of an oracle for a market.
+type OracleStats struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// total_posts is the number of prices posted by the oracle.
+	TotalPosts uint64 `protobuf:"varint,3,opt,name=total_posts,json=totalPosts,proto3" json:"total_posts,omitempty"`
+	// missed_windows is the number of miss windows without a post.
+	MissedWindows uint64 `protobuf:"varint,4,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	// consecutive_misses is the number of miss windows without a post since the
+	// oracle last posted in time.
+	ConsecutiveMisses uint64 `protobuf:"varint,5,opt,name=consecutive_misses,json=consecutiveMisses,proto3" json:"consecutive_misses,omitempty"`
+	// average_deviation is the mean fractional deviation of the posted prices
+	// from the current price at the time of posting.
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation"`
+	// deviation_samples is the number of posts included in average_deviation.
+	DeviationSamples uint64    `protobuf:"varint,7,opt,name=deviation_samples,json=deviationSamples,proto3" json:"deviation_samples,omitempty"`
+	LastPostTime     time.Time `protobuf:"bytes,8,opt,name=last_post_time,json=lastPostTime,proto3,stdtime" json:"last_post_time"`
+	// window_start is the start of the current miss window.
+	WindowStart time.Time `protobuf:"bytes,9,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+}
+
+func (m *OracleStats) Reset()         { *m = OracleStats{} }
+func (m *OracleStats) String() string { return proto.CompactTextString(m) }
+func (*OracleStats) ProtoMessage()    {}
+func (*OracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{7}
+}
+func (m *OracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *Market but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Market but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+		return b[:n], nil
 	}
-	if this.BaseAsset != that1.BaseAsset {
-		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
-	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
-	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
-	}
-	for i := range this.Oracles {
-		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
-			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
-		}
-	}
-	if this.Active != that1.Active {
-		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
-	}
-	return nil
 }
-func (this *Market) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStats.Merge(m, src)
+}
+func (m *OracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStats.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Market)
-	if !ok {
-		that2, ok := that.(Market)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MarketID != that1.MarketID {
-		return false
-	}
-	if this.BaseAsset != that1.BaseAsset {
-		return false
-	}
-	if this.QuoteAsset != that1.QuoteAsset {
-		return false
-	}
-	if len(this.Oracles) != len(that1.Oracles) {
-		return false
-	}
-	for i := range this.Oracles {
-		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
-			return false
-		}
-	}
-	if this.Active != that1.Active {
-		return false
+var xxx_messageInfo_OracleStats proto.InternalMessageInfo
+
+func (m *OracleStats) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	return true
+	return ""
 }
-func (this *PostedPrice) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*PostedPrice)
-	if !ok {
-		that2, ok := that.(PostedPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *PostedPrice")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
-	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+func (m *OracleStats) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
 	}
 	return nil
 }
-func (this *PostedPrice) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PostedPrice)
-	if !ok {
-		that2, ok := that.(PostedPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MarketID != that1.MarketID {
-		return false
-	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return false
-	}
-	if !this.Price.Equal(that1.Price) {
-		return false
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return false
+func (m *OracleStats) GetTotalPosts() uint64 {
+	if m != nil {
+		return m.TotalPosts
 	}
-	return true
+	return 0
 }
-func (this *CurrentPrice) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*CurrentPrice)
-	if !ok {
-		that2, ok := that.(CurrentPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *CurrentPrice")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
-	}
-	if this.MarketID != that1.MarketID {
-		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
-	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+func (m *OracleStats) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
 	}
-	return nil
+	return 0
 }
-func (this *CurrentPrice) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CurrentPrice)
-	if !ok {
-		that2, ok := that.(CurrentPrice)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *OracleStats) GetConsecutiveMisses() uint64 {
+	if m != nil {
+		return m.ConsecutiveMisses
 	}
-	if this.MarketID != that1.MarketID {
-		return false
+	return 0
+}
+
+func (m *OracleStats) GetDeviationSamples() uint64 {
+	if m != nil {
+		return m.DeviationSamples
 	}
-	if !this.Price.Equal(that1.Price) {
-		return false
+	return 0
+}
+
+func (m *OracleStats) GetLastPostTime() time.Time {
+	if m != nil {
+		return m.LastPostTime
 	}
-	return true
+	return time.Time{}
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *OracleStats) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SnapshotParams defines the price history kept for a market.
+type SnapshotParams struct {
+	// granularity is the period each snapshot covers. At most one snapshot is
+	// recorded per period.
+	Granularity time.Duration `protobuf:"bytes,1,opt,name=granularity,proto3,stdduration" json:"granularity"`
+	// retention is how long snapshots are kept.
+	Retention time.Duration `protobuf:"bytes,2,opt,name=retention,proto3,stdduration" json:"retention"`
+	// liquidation_twap_window makes x/cdp and x/hard price liquidations of the
+	// market at its time-weighted average price over the window, instead of its
+	// current price. Zero uses the current price. It can't be longer than the
+	// retention.
+	LiquidationTwapWindow time.Duration `protobuf:"bytes,3,opt,name=liquidation_twap_window,json=liquidationTwapWindow,proto3,stdduration" json:"liquidation_twap_window"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *SnapshotParams) Reset()         { *m = SnapshotParams{} }
+func (m *SnapshotParams) String() string { return proto.CompactTextString(m) }
+func (*SnapshotParams) ProtoMessage()    {}
+func (*SnapshotParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{8}
+}
+func (m *SnapshotParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SnapshotParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotParams.Merge(m, src)
+}
+func (m *SnapshotParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotParams.DiscardUnknown(m)
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotParams proto.InternalMessageInfo
+
+func (m *SnapshotParams) GetGranularity() time.Duration {
+	if m != nil {
+		return m.Granularity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SnapshotParams) GetRetention() time.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+func (m *SnapshotParams) GetLiquidationTwapWindow() time.Duration {
+	if m != nil {
+		return m.LiquidationTwapWindow
 	}
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// PriceSnapshot defines the current price of a market at a point in time.
+type PriceSnapshot struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Time     time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{9}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PostedPrice defines a price for market posted by a specific oracle.
+type PostedPrice struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	Price         github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Expiry        time.Time                                     `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
 }
 
-func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
+func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
+func (*PostedPrice) ProtoMessage()    {}
+func (*PostedPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{10}
+}
+func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostedPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *PostedPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostedPrice.Merge(m, src)
+}
+func (m *PostedPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostedPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostedPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostedPrice proto.InternalMessageInfo
+
+func (m *PostedPrice) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PostedPrice) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PostedPrice) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CurrentPrice defines a current price for a particular market in the pricefeed
+// module.
+type CurrentPrice struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 }
 
-func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
+func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
+func (*CurrentPrice) ProtoMessage()    {}
+func (*CurrentPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{11}
+}
+func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CurrentPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentPrice.Merge(m, src)
+}
+func (m *CurrentPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentPrice.DiscardUnknown(m)
 }
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CurrentPrice proto.InternalMessageInfo
+
+func (m *CurrentPrice) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
-		}
-	}
-	return n
+
+func init() {
+	proto.RegisterEnum("kava.pricefeed.v1beta1.AggregationMode", AggregationMode_name, AggregationMode_value)
+	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
+	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
+	proto.RegisterType((*AggregationParams)(nil), "kava.pricefeed.v1beta1.AggregationParams")
+	proto.RegisterType((*OracleWeight)(nil), "kava.pricefeed.v1beta1.OracleWeight")
+	proto.RegisterType((*CircuitBreakerParams)(nil), "kava.pricefeed.v1beta1.CircuitBreakerParams")
+	proto.RegisterType((*CircuitBreakerState)(nil), "kava.pricefeed.v1beta1.CircuitBreakerState")
+	proto.RegisterType((*OraclePerformanceParams)(nil), "kava.pricefeed.v1beta1.OraclePerformanceParams")
+	proto.RegisterType((*OracleStats)(nil), "kava.pricefeed.v1beta1.OracleStats")
+	proto.RegisterType((*SnapshotParams)(nil), "kava.pricefeed.v1beta1.SnapshotParams")
+	proto.RegisterType((*PriceSnapshot)(nil), "kava.pricefeed.v1beta1.PriceSnapshot")
+	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
+	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
 }
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+func init() {
+	proto.RegisterFile("kava/pricefeed/v1beta1/store.proto", fileDescriptor_9df40639f5e16f9a)
+}
+
+var fileDescriptor_9df40639f5e16f9a = []byte{
+	// 1357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x5b, 0xc5,
+	0x16, 0xcf, 0x75, 0x5c, 0x27, 0x39, 0xfe, 0xcc, 0xf4, 0xeb, 0x36, 0xef, 0xd5, 0xf6, 0xf3, 0xeb,
+	0x6b, 0xd3, 0x57, 0x62, 0xab, 0x81, 0x05, 0x12, 0x6c, 0xec, 0xd8, 0x4d, 0x4d, 0xe5, 0x34, 0xba,
+	0x4e, 0x09, 0x6a, 0x25, 0xae, 0xc6, 0xf7, 0x4e, 0x9c, 0xab, 0xf8, 0x7a, 0xdc, 0x99, 0xb1, 0xe3,
+	0xac, 0xd8, 0x21, 0x96, 0xdd, 0x20, 0xb1, 0x63, 0xd1, 0x0d, 0x42, 0x62, 0xc7, 0x8a, 0x0d, 0x4b,
+	0xba, 0xac, 0x58, 0x21, 0x16, 0x69, 0x49, 0xf9, 0x17, 0x10, 0x12, 0x2b, 0x34, 0x33, 0xd7, 0x1f,
+	0x4d, 0x1a, 0x14, 0xa7, 0x45, 0x62, 0x15, 0xcf, 0xef, 0x9c, 0xf3, 0xf3, 0x9c, 0xdf, 0xf9, 0x18,
+	0x07, 0x72, 0x3b, 0xb8, 0x87, 0x0b, 0x1d, 0xe6, 0x39, 0x64, 0x8b, 0x10, 0xb7, 0xd0, 0xbb, 0xd9,
+	0x20, 0x02, 0xdf, 0x2c, 0x70, 0x41, 0x19, 0xc9, 0x77, 0x18, 0x15, 0x14, 0x5d, 0x90, 0x3e, 0xf9,
+	0xa1, 0x4f, 0x3e, 0xf0, 0x59, 0xb8, 0xe4, 0x50, 0xee, 0x53, 0x6e, 0x2b, 0xaf, 0x82, 0x3e, 0xe8,
+	0x90, 0x85, 0x73, 0x4d, 0xda, 0xa4, 0x1a, 0x97, 0x9f, 0x02, 0x34, 0xdd, 0xa4, 0xb4, 0xd9, 0x22,
+	0x05, 0x75, 0x6a, 0x74, 0xb7, 0x0a, 0x6e, 0x97, 0x61, 0xe1, 0xd1, 0x76, 0x60, 0xcf, 0x1c, 0xb6,
+	0x0b, 0xcf, 0x27, 0x5c, 0x60, 0xbf, 0xa3, 0x1d, 0x72, 0x75, 0x88, 0xac, 0x63, 0x86, 0x7d, 0x8e,
+	0xaa, 0x30, 0xe3, 0x63, 0xb6, 0x43, 0x04, 0x37, 0x8d, 0xec, 0xf4, 0x62, 0x74, 0x39, 0x9d, 0x7f,
+	0xf5, 0x2d, 0xf3, 0x35, 0xe5, 0x56, 0x4a, 0x3e, 0xd9, 0xcf, 0x4c, 0x7d, 0xfd, 0x2c, 0x33, 0xa3,
+	0xcf, 0xdc, 0x1a, 0xc4, 0xe7, 0x7e, 0x0d, 0x43, 0x44, 0x83, 0xe8, 0x3a, 0xcc, 0x69, 0xd4, 0xf6,
+	0x5c, 0xd3, 0xc8, 0x1a, 0x8b, 0x73, 0xa5, 0xd8, 0xc1, 0x7e, 0x66, 0x56, 0x9b, 0xab, 0x65, 0x6b,
+	0x56, 0x9b, 0xab, 0x2e, 0xba, 0x0c, 0xd0, 0xc0, 0x9c, 0xd8, 0x98, 0x73, 0x22, 0xcc, 0x90, 0xf4,
+	0xb5, 0xe6, 0x24, 0x52, 0x94, 0x00, 0xca, 0x40, 0xf4, 0x61, 0x97, 0x8a, 0x81, 0x7d, 0x5a, 0xd9,
+	0x41, 0x41, 0xda, 0xa1, 0x01, 0x33, 0x94, 0x61, 0xa7, 0x45, 0xb8, 0x19, 0xce, 0x4e, 0x2f, 0xc6,
+	0x4a, 0xb7, 0xff, 0xd8, 0xcf, 0x2c, 0x35, 0x3d, 0xb1, 0xdd, 0x6d, 0xe4, 0x1d, 0xea, 0x07, 0x7a,
+	0x06, 0x7f, 0x96, 0xb8, 0xbb, 0x53, 0x10, 0x7b, 0x1d, 0xc2, 0xf3, 0x45, 0xc7, 0x29, 0xba, 0x2e,
+	0x23, 0x9c, 0xff, 0xf8, 0xed, 0xd2, 0xd9, 0x40, 0xf5, 0x00, 0x29, 0xed, 0x09, 0xc2, 0xad, 0x01,
+	0x31, 0xba, 0x00, 0x11, 0xec, 0x08, 0xaf, 0x47, 0xcc, 0x33, 0x59, 0x63, 0x71, 0xd6, 0x0a, 0x4e,
+	0xe8, 0x0e, 0x44, 0x71, 0xb3, 0xc9, 0x48, 0x53, 0x89, 0x6f, 0x46, 0xb2, 0xc6, 0x62, 0x74, 0xf9,
+	0xfa, 0x71, 0x02, 0x16, 0x47, 0xae, 0x5a, 0x7c, 0x6b, 0x3c, 0x1a, 0xdd, 0x83, 0xa4, 0xe3, 0x31,
+	0xa7, 0xeb, 0x09, 0xbb, 0xc1, 0x08, 0xde, 0x21, 0xcc, 0x9c, 0x51, 0x84, 0x6f, 0x1d, 0x47, 0xb8,
+	0xa2, 0xdd, 0x4b, 0xda, 0x3b, 0xe0, 0x4c, 0x38, 0x2f, 0xa1, 0xe8, 0x63, 0x40, 0x3a, 0x0d, 0xbb,
+	0x43, 0xd8, 0x16, 0x65, 0x3e, 0x6e, 0x3b, 0xc4, 0x9c, 0x55, 0xcc, 0x85, 0xe3, 0x98, 0xef, 0xaa,
+	0x88, 0xf5, 0x51, 0x40, 0x40, 0x3e, 0x4f, 0x0f, 0x1b, 0x50, 0x19, 0xe6, 0x78, 0x1b, 0x77, 0xf8,
+	0x36, 0x15, 0xdc, 0x9c, 0x53, 0xb4, 0x57, 0x8f, 0xa3, 0xad, 0x07, 0x8e, 0x01, 0xdb, 0x28, 0x10,
+	0x5d, 0x87, 0x94, 0x72, 0xb7, 0x49, 0xbf, 0x23, 0x0b, 0x20, 0xe5, 0x04, 0x55, 0xeb, 0xa4, 0xc2,
+	0x2b, 0x43, 0x38, 0xf7, 0xe9, 0x34, 0xcc, 0x1f, 0x91, 0x12, 0xbd, 0x07, 0x61, 0x9f, 0xba, 0x44,
+	0x35, 0x5b, 0x62, 0xf9, 0xda, 0x09, 0x6a, 0x50, 0xa3, 0x2e, 0xb1, 0x54, 0x10, 0x6a, 0x40, 0x22,
+	0xd0, 0x68, 0x97, 0x78, 0xcd, 0x6d, 0xc1, 0xcd, 0x90, 0x9a, 0x85, 0x2b, 0x7f, 0xad, 0xcf, 0xa6,
+	0x72, 0x2e, 0x9d, 0x0f, 0x26, 0x22, 0x3e, 0x8e, 0x72, 0x2b, 0x4e, 0xc7, 0x8f, 0xa8, 0x0e, 0x71,
+	0xc1, 0x3c, 0xdf, 0xde, 0x62, 0xb2, 0x79, 0x68, 0x5b, 0xb7, 0x72, 0x29, 0x2f, 0x83, 0x7f, 0xde,
+	0xcf, 0x5c, 0x3d, 0x41, 0xc7, 0x96, 0x89, 0x63, 0xc5, 0x24, 0xc9, 0xad, 0x80, 0x43, 0x92, 0xfa,
+	0xb8, 0x6f, 0xbb, 0xa4, 0xe7, 0xe9, 0x16, 0x0c, 0x9f, 0x8e, 0xd4, 0xc7, 0xfd, 0xf2, 0x80, 0x03,
+	0x5d, 0x85, 0xa4, 0xef, 0xb5, 0xed, 0x1e, 0x6e, 0x79, 0xae, 0xdd, 0xa1, 0x5c, 0x70, 0xd5, 0xf6,
+	0x71, 0x2b, 0xee, 0x7b, 0xed, 0x0f, 0x25, 0xba, 0x2e, 0xc1, 0xdc, 0x0f, 0x06, 0xc4, 0xc6, 0x53,
+	0x46, 0x74, 0x28, 0x23, 0xd6, 0x63, 0xa4, 0xaa, 0xf1, 0x26, 0x27, 0x32, 0xd0, 0x34, 0xc0, 0xd0,
+	0x2d, 0x88, 0xe8, 0x82, 0x99, 0xa1, 0x53, 0xe5, 0x1d, 0x44, 0xe7, 0x7e, 0x33, 0xe0, 0xdc, 0xab,
+	0x86, 0x09, 0x7d, 0x04, 0x29, 0xa9, 0x6f, 0xa3, 0x45, 0x9d, 0x1d, 0xdb, 0xd9, 0xc6, 0xed, 0x26,
+	0x31, 0x8d, 0x53, 0x7d, 0x55, 0xc2, 0xc7, 0xfd, 0x92, 0xa4, 0x59, 0x51, 0x2c, 0xe8, 0x3e, 0xcc,
+	0x4b, 0xe6, 0x6d, 0xda, 0x65, 0xad, 0xbd, 0x01, 0xf5, 0xe9, 0xb2, 0x48, 0xfa, 0xb8, 0x7f, 0x5b,
+	0xf1, 0x04, 0xdc, 0xd7, 0x20, 0xc9, 0x88, 0x43, 0x7b, 0x84, 0xed, 0xe9, 0xab, 0x73, 0xd5, 0x6c,
+	0x61, 0x2b, 0x31, 0x80, 0xd5, 0x4d, 0x78, 0xee, 0x71, 0x08, 0xce, 0xbe, 0x9c, 0x77, 0x5d, 0x60,
+	0x41, 0x26, 0x59, 0xdf, 0x9b, 0xf2, 0xbb, 0xb6, 0x08, 0x23, 0x6d, 0x87, 0xd8, 0x6a, 0x50, 0x4e,
+	0x99, 0x45, 0x62, 0x48, 0xb3, 0x2e, 0x59, 0xd0, 0x1d, 0x18, 0x21, 0xb6, 0x7c, 0xbf, 0x54, 0x0e,
+	0xd1, 0xe5, 0x85, 0xbc, 0x7e, 0xdc, 0xf2, 0x83, 0xc7, 0x2d, 0xbf, 0x31, 0x78, 0xdc, 0x4a, 0xb3,
+	0xf2, 0x3b, 0x1f, 0x3d, 0xcb, 0x18, 0x56, 0x7c, 0x18, 0x2b, 0xad, 0x72, 0x81, 0x6f, 0xe3, 0x96,
+	0x20, 0xae, 0x1a, 0x90, 0x59, 0x2b, 0x38, 0xa1, 0xff, 0x42, 0x9c, 0x0b, 0xdc, 0x68, 0x91, 0x81,
+	0x4e, 0x67, 0x94, 0x4e, 0x31, 0x0d, 0x06, 0x2a, 0x7d, 0x6e, 0xc0, 0xc5, 0x63, 0x16, 0x22, 0x2a,
+	0x43, 0xd4, 0xf7, 0x38, 0xb7, 0x77, 0xbd, 0xb6, 0x4b, 0x77, 0x95, 0x56, 0xd1, 0xe5, 0x4b, 0x47,
+	0xae, 0x58, 0x0e, 0xde, 0x67, 0x7d, 0xc3, 0x2f, 0xe4, 0x0d, 0x41, 0xc6, 0x6d, 0xaa, 0x30, 0xf4,
+	0x0e, 0x5c, 0x90, 0xcd, 0xe0, 0xd0, 0x36, 0x27, 0x4e, 0x57, 0x3e, 0x2d, 0xb6, 0xb4, 0x12, 0xae,
+	0xb4, 0x0c, 0x5b, 0xe7, 0x7c, 0xdc, 0x5f, 0x19, 0x19, 0x6b, 0xca, 0x96, 0xfb, 0x3e, 0x0c, 0x51,
+	0x7d, 0x2f, 0x59, 0x35, 0x3e, 0x49, 0xd5, 0x8e, 0x4e, 0x6a, 0xe8, 0xef, 0x9d, 0xd4, 0x0c, 0x44,
+	0x05, 0x15, 0xb8, 0x15, 0xec, 0x13, 0xdd, 0x8e, 0xa0, 0x20, 0xb5, 0x4c, 0xd0, 0xff, 0x20, 0xa1,
+	0x52, 0x76, 0x03, 0x29, 0xb9, 0xaa, 0x54, 0xd8, 0x8a, 0x6b, 0x54, 0x0b, 0xc5, 0xd1, 0x12, 0xa0,
+	0x57, 0xa8, 0xa4, 0xab, 0x36, 0xef, 0x1c, 0x96, 0x08, 0x3d, 0x80, 0x79, 0xdc, 0x23, 0x0c, 0x37,
+	0xc9, 0xd8, 0x8e, 0x8c, 0x9c, 0xaa, 0x3f, 0x53, 0x01, 0xd1, 0x68, 0x4f, 0xde, 0x80, 0xf9, 0x21,
+	0xa9, 0xcd, 0xb1, 0xdf, 0x91, 0xbf, 0x41, 0x66, 0xd4, 0x55, 0x52, 0x43, 0x43, 0x5d, 0xe3, 0xe8,
+	0x03, 0x48, 0xb4, 0x30, 0x17, 0x2a, 0x7f, 0xdd, 0xce, 0xb3, 0x13, 0xb4, 0x73, 0x4c, 0xc6, 0x4a,
+	0xa1, 0x54, 0x37, 0xaf, 0x42, 0x4c, 0x8b, 0x64, 0x73, 0x81, 0x99, 0x30, 0xe7, 0x26, 0x60, 0x8a,
+	0xea, 0xc8, 0xba, 0x0c, 0xcc, 0xfd, 0x6e, 0x40, 0xe2, 0xe5, 0x37, 0x19, 0x55, 0x20, 0xda, 0x64,
+	0xb8, 0xdd, 0x6d, 0x61, 0xe6, 0x89, 0xbd, 0x49, 0x1a, 0x7a, 0x3c, 0x0e, 0x15, 0x61, 0x8e, 0x11,
+	0x41, 0xda, 0x4a, 0xf0, 0xd0, 0xc9, 0x49, 0x46, 0x51, 0xe8, 0x01, 0x5c, 0x6c, 0x79, 0x0f, 0xbb,
+	0x9e, 0xab, 0x05, 0x16, 0xbb, 0xb8, 0x33, 0x18, 0xb3, 0xe9, 0x93, 0x13, 0x9e, 0x1f, 0xe3, 0xd8,
+	0xd8, 0xc5, 0x1d, 0xdd, 0x48, 0xb9, 0xef, 0x0c, 0x88, 0xab, 0x3d, 0x33, 0x48, 0x7f, 0x92, 0xe9,
+	0x29, 0xc3, 0x99, 0xd7, 0xd9, 0x74, 0x3a, 0x18, 0xbd, 0x0b, 0xe1, 0x89, 0xd7, 0x9a, 0x8a, 0xc8,
+	0x7d, 0x13, 0x82, 0xa8, 0x6c, 0x06, 0xe2, 0xea, 0x55, 0xf9, 0x4f, 0x1e, 0xfc, 0xa1, 0x56, 0xd3,
+	0xaf, 0xa3, 0xd5, 0xfb, 0x10, 0x21, 0xfd, 0x8e, 0xc7, 0xf6, 0xcc, 0xf0, 0x04, 0x6a, 0x05, 0x31,
+	0xb9, 0x4f, 0x20, 0xb6, 0xd2, 0x65, 0x8c, 0xb4, 0xc5, 0xc4, 0x7a, 0xbd, 0x91, 0x52, 0xff, 0xff,
+	0x4b, 0x03, 0x92, 0x87, 0x7e, 0x79, 0xa2, 0x2c, 0xfc, 0xbb, 0xb8, 0xba, 0x6a, 0x55, 0x56, 0x8b,
+	0x1b, 0xd5, 0xbb, 0x6b, 0x76, 0xed, 0x6e, 0xb9, 0x62, 0xdf, 0x5b, 0xab, 0xaf, 0x57, 0x56, 0xaa,
+	0xb7, 0xaa, 0x95, 0x72, 0x6a, 0x0a, 0xfd, 0x0b, 0x2e, 0x1e, 0xf1, 0xa8, 0x55, 0xca, 0xd5, 0xe2,
+	0x5a, 0xca, 0x40, 0x57, 0x20, 0x7b, 0xc4, 0xb8, 0x59, 0xa9, 0xae, 0xde, 0xde, 0xa8, 0x94, 0x07,
+	0x5e, 0x21, 0xf4, 0x1f, 0xb8, 0x7c, 0xc4, 0x6b, 0xc3, 0xaa, 0xd6, 0x6a, 0xca, 0xa9, 0xb8, 0x96,
+	0x9a, 0x5e, 0x08, 0x7f, 0xf6, 0x38, 0x3d, 0x55, 0xaa, 0x3d, 0xff, 0x25, 0x6d, 0x7c, 0x75, 0x90,
+	0x36, 0x9e, 0x1c, 0xa4, 0x8d, 0xa7, 0x07, 0x69, 0xe3, 0xf9, 0x41, 0xda, 0x78, 0xf4, 0x22, 0x3d,
+	0xf5, 0xf4, 0x45, 0x7a, 0xea, 0xa7, 0x17, 0xe9, 0xa9, 0xfb, 0x37, 0xc6, 0x52, 0x96, 0xbf, 0x8a,
+	0x97, 0x5a, 0xb8, 0xc1, 0xd5, 0xa7, 0x42, 0x7f, 0xec, 0xff, 0x5e, 0x95, 0x7b, 0x23, 0xa2, 0xea,
+	0xf2, 0xf6, 0x9f, 0x03, 0x00, 0xa7, 0x39, 0x8c, 0xa1, 0x16, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Params")
+		}
 	}
-	if len(m.Oracles) > 0 {
-		for _, b := range m.Oracles {
-			l = len(b)
-			n += 1 + l + sovStore(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *Params but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Params but is not nil && this == nil")
 	}
-	if m.Active {
-		n += 2
+	if len(this.Markets) != len(that1.Markets) {
+		return fmt.Errorf("Markets this(%v) Not Equal that(%v)", len(this.Markets), len(that1.Markets))
 	}
-	return n
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
+		}
+	}
+	return nil
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostedPrice) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.OracleAddress)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if len(this.Markets) != len(that1.Markets) {
+		return false
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	for i := range this.Markets {
+		if !this.Markets[i].Equal(&that1.Markets[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *Market) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *CurrentPrice) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Market)
+	if !ok {
+		that2, ok := that.(Market)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Market")
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Market but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Market but is not nil && this == nil")
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.BaseAsset != that1.BaseAsset {
+		return fmt.Errorf("BaseAsset this(%v) Not Equal that(%v)", this.BaseAsset, that1.BaseAsset)
+	}
+	if this.QuoteAsset != that1.QuoteAsset {
+		return fmt.Errorf("QuoteAsset this(%v) Not Equal that(%v)", this.QuoteAsset, that1.QuoteAsset)
+	}
+	if len(this.Oracles) != len(that1.Oracles) {
+		return fmt.Errorf("Oracles this(%v) Not Equal that(%v)", len(this.Oracles), len(that1.Oracles))
+	}
+	for i := range this.Oracles {
+		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
+			return fmt.Errorf("Oracles this[%v](%v) Not Equal that[%v](%v)", i, this.Oracles[i], i, that1.Oracles[i])
+		}
+	}
+	if this.Active != that1.Active {
+		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	}
+	if !this.Aggregation.Equal(that1.Aggregation) {
+		return fmt.Errorf("Aggregation this(%v) Not Equal that(%v)", this.Aggregation, that1.Aggregation)
+	}
+	if !this.CircuitBreaker.Equal(that1.CircuitBreaker) {
+		return fmt.Errorf("CircuitBreaker this(%v) Not Equal that(%v)", this.CircuitBreaker, that1.CircuitBreaker)
+	}
+	if !this.OraclePerformance.Equal(that1.OraclePerformance) {
+		return fmt.Errorf("OraclePerformance this(%v) Not Equal that(%v)", this.OraclePerformance, that1.OraclePerformance)
+	}
+	if !this.Snapshots.Equal(that1.Snapshots) {
+		return fmt.Errorf("Snapshots this(%v) Not Equal that(%v)", this.Snapshots, that1.Snapshots)
+	}
+	if this.PriceExpression != that1.PriceExpression {
+		return fmt.Errorf("PriceExpression this(%v) Not Equal that(%v)", this.PriceExpression, that1.PriceExpression)
+	}
+	return nil
 }
+func (this *Market) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	that1, ok := that.(*Market)
+	if !ok {
+		that2, ok := that.(Market)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.BaseAsset != that1.BaseAsset {
+		return false
+	}
+	if this.QuoteAsset != that1.QuoteAsset {
+		return false
+	}
+	if len(this.Oracles) != len(that1.Oracles) {
+		return false
+	}
+	for i := range this.Oracles {
+		if !bytes.Equal(this.Oracles[i], that1.Oracles[i]) {
+			return false
+		}
+	}
+	if this.Active != that1.Active {
+		return false
+	}
+	if !this.Aggregation.Equal(that1.Aggregation) {
+		return false
+	}
+	if !this.CircuitBreaker.Equal(that1.CircuitBreaker) {
+		return false
+	}
+	if !this.OraclePerformance.Equal(that1.OraclePerformance) {
+		return false
+	}
+	if !this.Snapshots.Equal(that1.Snapshots) {
+		return false
+	}
+	if this.PriceExpression != that1.PriceExpression {
+		return false
+	}
+	return true
 }
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (this *AggregationParams) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*AggregationParams)
+	if !ok {
+		that2, ok := that.(AggregationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *AggregationParams")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AggregationParams but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AggregationParams but is not nil && this == nil")
+	}
+	if this.Mode != that1.Mode {
+		return fmt.Errorf("Mode this(%v) Not Equal that(%v)", this.Mode, that1.Mode)
+	}
+	if len(this.OracleWeights) != len(that1.OracleWeights) {
+		return fmt.Errorf("OracleWeights this(%v) Not Equal that(%v)", len(this.OracleWeights), len(that1.OracleWeights))
+	}
+	for i := range this.OracleWeights {
+		if !this.OracleWeights[i].Equal(&that1.OracleWeights[i]) {
+			return fmt.Errorf("OracleWeights this[%v](%v) Not Equal that[%v](%v)", i, this.OracleWeights[i], i, that1.OracleWeights[i])
+		}
+	}
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return fmt.Errorf("TrimFraction this(%v) Not Equal that(%v)", this.TrimFraction, that1.TrimFraction)
+	}
+	if !this.MaxDeviation.Equal(that1.MaxDeviation) {
+		return fmt.Errorf("MaxDeviation this(%v) Not Equal that(%v)", this.MaxDeviation, that1.MaxDeviation)
+	}
+	if this.MinValidPosts != that1.MinValidPosts {
+		return fmt.Errorf("MinValidPosts this(%v) Not Equal that(%v)", this.MinValidPosts, that1.MinValidPosts)
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStore
+func (this *AggregationParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregationParams)
+	if !ok {
+		that2, ok := that.(AggregationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if len(this.OracleWeights) != len(that1.OracleWeights) {
+		return false
+	}
+	for i := range this.OracleWeights {
+		if !this.OracleWeights[i].Equal(&that1.OracleWeights[i]) {
+			return false
+		}
+	}
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return false
+	}
+	if !this.MaxDeviation.Equal(that1.MaxDeviation) {
+		return false
+	}
+	if this.MinValidPosts != that1.MinValidPosts {
+		return false
+	}
+	return true
+}
+func (this *OracleWeight) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleWeight)
+	if !ok {
+		that2, ok := that.(OracleWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleWeight")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleWeight but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleWeight but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return fmt.Errorf("Weight this(%v) Not Equal that(%v)", this.Weight, that1.Weight)
+	}
+	return nil
+}
+func (this *OracleWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleWeight)
+	if !ok {
+		that2, ok := that.(OracleWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreakerParams) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CircuitBreakerParams)
+	if !ok {
+		that2, ok := that.(CircuitBreakerParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CircuitBreakerParams")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CircuitBreakerParams but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CircuitBreakerParams but is not nil && this == nil")
+	}
+	if !this.MaxBlockChange.Equal(that1.MaxBlockChange) {
+		return fmt.Errorf("MaxBlockChange this(%v) Not Equal that(%v)", this.MaxBlockChange, that1.MaxBlockChange)
+	}
+	if !this.MaxHourlyChange.Equal(that1.MaxHourlyChange) {
+		return fmt.Errorf("MaxHourlyChange this(%v) Not Equal that(%v)", this.MaxHourlyChange, that1.MaxHourlyChange)
+	}
+	if this.RecoveryBlocks != that1.RecoveryBlocks {
+		return fmt.Errorf("RecoveryBlocks this(%v) Not Equal that(%v)", this.RecoveryBlocks, that1.RecoveryBlocks)
+	}
+	return nil
+}
+func (this *CircuitBreakerParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreakerParams)
+	if !ok {
+		that2, ok := that.(CircuitBreakerParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxBlockChange.Equal(that1.MaxBlockChange) {
+		return false
+	}
+	if !this.MaxHourlyChange.Equal(that1.MaxHourlyChange) {
+		return false
+	}
+	if this.RecoveryBlocks != that1.RecoveryBlocks {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreakerState) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CircuitBreakerState)
+	if !ok {
+		that2, ok := that.(CircuitBreakerState)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CircuitBreakerState")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CircuitBreakerState but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CircuitBreakerState but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.ReferencePrice.Equal(that1.ReferencePrice) {
+		return fmt.Errorf("ReferencePrice this(%v) Not Equal that(%v)", this.ReferencePrice, that1.ReferencePrice)
+	}
+	if !this.ReferenceTime.Equal(that1.ReferenceTime) {
+		return fmt.Errorf("ReferenceTime this(%v) Not Equal that(%v)", this.ReferenceTime, that1.ReferenceTime)
+	}
+	if this.Halted != that1.Halted {
+		return fmt.Errorf("Halted this(%v) Not Equal that(%v)", this.Halted, that1.Halted)
+	}
+	if this.StableBlocks != that1.StableBlocks {
+		return fmt.Errorf("StableBlocks this(%v) Not Equal that(%v)", this.StableBlocks, that1.StableBlocks)
+	}
+	return nil
+}
+func (this *CircuitBreakerState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreakerState)
+	if !ok {
+		that2, ok := that.(CircuitBreakerState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.ReferencePrice.Equal(that1.ReferencePrice) {
+		return false
+	}
+	if !this.ReferenceTime.Equal(that1.ReferenceTime) {
+		return false
+	}
+	if this.Halted != that1.Halted {
+		return false
+	}
+	if this.StableBlocks != that1.StableBlocks {
+		return false
+	}
+	return true
+}
+func (this *OraclePerformanceParams) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OraclePerformanceParams)
+	if !ok {
+		that2, ok := that.(OraclePerformanceParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OraclePerformanceParams")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OraclePerformanceParams but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OraclePerformanceParams but is not nil && this == nil")
+	}
+	if this.MissWindow != that1.MissWindow {
+		return fmt.Errorf("MissWindow this(%v) Not Equal that(%v)", this.MissWindow, that1.MissWindow)
+	}
+	if this.MaxConsecutiveMisses != that1.MaxConsecutiveMisses {
+		return fmt.Errorf("MaxConsecutiveMisses this(%v) Not Equal that(%v)", this.MaxConsecutiveMisses, that1.MaxConsecutiveMisses)
+	}
+	return nil
+}
+func (this *OraclePerformanceParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OraclePerformanceParams)
+	if !ok {
+		that2, ok := that.(OraclePerformanceParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MissWindow != that1.MissWindow {
+		return false
+	}
+	if this.MaxConsecutiveMisses != that1.MaxConsecutiveMisses {
+		return false
+	}
+	return true
+}
+func (this *OracleStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStats")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStats but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.TotalPosts != that1.TotalPosts {
+		return fmt.Errorf("TotalPosts this(%v) Not Equal that(%v)", this.TotalPosts, that1.TotalPosts)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.ConsecutiveMisses != that1.ConsecutiveMisses {
+		return fmt.Errorf("ConsecutiveMisses this(%v) Not Equal that(%v)", this.ConsecutiveMisses, that1.ConsecutiveMisses)
+	}
+	if !this.AverageDeviation.Equal(that1.AverageDeviation) {
+		return fmt.Errorf("AverageDeviation this(%v) Not Equal that(%v)", this.AverageDeviation, that1.AverageDeviation)
+	}
+	if this.DeviationSamples != that1.DeviationSamples {
+		return fmt.Errorf("DeviationSamples this(%v) Not Equal that(%v)", this.DeviationSamples, that1.DeviationSamples)
+	}
+	if !this.LastPostTime.Equal(that1.LastPostTime) {
+		return fmt.Errorf("LastPostTime this(%v) Not Equal that(%v)", this.LastPostTime, that1.LastPostTime)
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return fmt.Errorf("WindowStart this(%v) Not Equal that(%v)", this.WindowStart, that1.WindowStart)
+	}
+	return nil
+}
+func (this *OracleStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.TotalPosts != that1.TotalPosts {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.ConsecutiveMisses != that1.ConsecutiveMisses {
+		return false
+	}
+	if !this.AverageDeviation.Equal(that1.AverageDeviation) {
+		return false
+	}
+	if this.DeviationSamples != that1.DeviationSamples {
+		return false
+	}
+	if !this.LastPostTime.Equal(that1.LastPostTime) {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	return true
+}
+func (this *SnapshotParams) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SnapshotParams)
+	if !ok {
+		that2, ok := that.(SnapshotParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SnapshotParams")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SnapshotParams but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SnapshotParams but is not nil && this == nil")
+	}
+	if this.Granularity != that1.Granularity {
+		return fmt.Errorf("Granularity this(%v) Not Equal that(%v)", this.Granularity, that1.Granularity)
+	}
+	if this.Retention != that1.Retention {
+		return fmt.Errorf("Retention this(%v) Not Equal that(%v)", this.Retention, that1.Retention)
+	}
+	if this.LiquidationTwapWindow != that1.LiquidationTwapWindow {
+		return fmt.Errorf("LiquidationTwapWindow this(%v) Not Equal that(%v)", this.LiquidationTwapWindow, that1.LiquidationTwapWindow)
+	}
+	return nil
+}
+func (this *SnapshotParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotParams)
+	if !ok {
+		that2, ok := that.(SnapshotParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Granularity != that1.Granularity {
+		return false
+	}
+	if this.Retention != that1.Retention {
+		return false
+	}
+	if this.LiquidationTwapWindow != that1.LiquidationTwapWindow {
+		return false
+	}
+	return true
+}
+func (this *PriceSnapshot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceSnapshot")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceSnapshot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceSnapshot but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Time.Equal(that1.Time) {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	return nil
+}
+func (this *PriceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *PostedPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PostedPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	return nil
+}
+func (this *PostedPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CurrentPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	return nil
+}
+func (this *CurrentPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceExpression) > 0 {
+		i -= len(m.PriceExpression)
+		copy(dAtA[i:], m.PriceExpression)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.PriceExpression)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Snapshots != nil {
+		{
+			size, err := m.Snapshots.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OraclePerformance != nil {
+		{
+			size, err := m.OraclePerformance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Aggregation != nil {
+		{
+			size, err := m.Aggregation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinValidPosts != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinValidPosts))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TrimFraction.Size()
+		i -= size
+		if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleWeights) > 0 {
+		for iNdEx := len(m.OracleWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryBlocks != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.RecoveryBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxHourlyChange.Size()
+		i -= size
+		if _, err := m.MaxHourlyChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxBlockChange.Size()
+		i -= size
+		if _, err := m.MaxBlockChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StableBlocks != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.StableBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReferenceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReferenceTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStore(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OraclePerformanceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePerformanceParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePerformanceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxConsecutiveMisses != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxConsecutiveMisses))
+		i--
+		dAtA[i] = 0x10
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MissWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MissWindow):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStore(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStore(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x4a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastPostTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastPostTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStore(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x42
+	if m.DeviationSamples != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.DeviationSamples))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ConsecutiveMisses != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ConsecutiveMisses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPosts != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.TotalPosts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LiquidationTwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LiquidationTwapWindow):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStore(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Retention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Retention):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStore(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Granularity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Granularity):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStore(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStore(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStore(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, b := range m.Oracles {
+			l = len(b)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Aggregation != nil {
+		l = m.Aggregation.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.OraclePerformance != nil {
+		l = m.OraclePerformance.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Snapshots != nil {
+		l = m.Snapshots.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.PriceExpression)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovStore(uint64(m.Mode))
+	}
+	if len(m.OracleWeights) > 0 {
+		for _, e := range m.OracleWeights {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	l = m.TrimFraction.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.MinValidPosts != 0 {
+		n += 1 + sovStore(uint64(m.MinValidPosts))
+	}
+	return n
+}
+
+func (m *OracleWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxBlockChange.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.MaxHourlyChange.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.RecoveryBlocks != 0 {
+		n += 1 + sovStore(uint64(m.RecoveryBlocks))
+	}
+	return n
+}
+
+func (m *CircuitBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReferenceTime)
+	n += 1 + l + sovStore(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	if m.StableBlocks != 0 {
+		n += 1 + sovStore(uint64(m.StableBlocks))
+	}
+	return n
+}
+
+func (m *OraclePerformanceParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MissWindow)
+	n += 1 + l + sovStore(uint64(l))
+	if m.MaxConsecutiveMisses != 0 {
+		n += 1 + sovStore(uint64(m.MaxConsecutiveMisses))
+	}
+	return n
+}
+
+func (m *OracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.TotalPosts != 0 {
+		n += 1 + sovStore(uint64(m.TotalPosts))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MissedWindows))
+	}
+	if m.ConsecutiveMisses != 0 {
+		n += 1 + sovStore(uint64(m.ConsecutiveMisses))
+	}
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.DeviationSamples != 0 {
+		n += 1 + sovStore(uint64(m.DeviationSamples))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastPostTime)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *SnapshotParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Granularity)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Retention)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LiquidationTwapWindow)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PostedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CurrentPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregation == nil {
+				m.Aggregation = &AggregationParams{}
+			}
+			if err := m.Aggregation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreakerParams{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePerformance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OraclePerformance == nil {
+				m.OraclePerformance = &OraclePerformanceParams{}
+			}
+			if err := m.OraclePerformance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshots == nil {
+				m.Snapshots = &SnapshotParams{}
+			}
+			if err := m.Snapshots.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AggregationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleWeights = append(m.OracleWeights, OracleWeight{})
+			if err := m.OracleWeights[len(m.OracleWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPosts", wireType)
+			}
+			m.MinValidPosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidPosts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHourlyChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxHourlyChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryBlocks", wireType)
+			}
+			m.RecoveryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReferenceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableBlocks", wireType)
+			}
+			m.StableBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePerformanceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePerformanceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePerformanceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MissWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveMisses", wireType)
+			}
+			m.MaxConsecutiveMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveMisses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPosts", wireType)
+			}
+			m.TotalPosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPosts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
+			}
+			m.MissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMisses", wireType)
+			}
+			m.ConsecutiveMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMisses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationSamples", wireType)
+			}
+			m.DeviationSamples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationSamples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPostTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -943,8 +4283,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastPostTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -969,7 +4341,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *SnapshotParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,17 +4364,17 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1012,29 +4384,30 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Granularity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1044,27 +4417,111 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LiquidationTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1092,13 +4549,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1108,29 +4565,31 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1140,12 +4599,25 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -312,7 +312,7 @@ func (suite *Suite) CreateVault(
 	allowedVaults := suite.EarnKeeper.GetAllowedVaults(suite.Ctx)
 	allowedVaults = append(allowedVaults, vault)
 
-	params := earntypes.NewParams(allowedVaults)
+	params := earntypes.NewParams(allowedVaults, earntypes.DefaultRebalanceThreshold)
 
 	suite.EarnKeeper.SetParams(
 		suite.Ctx,
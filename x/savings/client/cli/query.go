@@ -33,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		GetCmdQueryParams(),
 		queryDepositsCmd(),
+		queryDepositLocksCmd(),
 		GetCmdTotalSupply(),
 	}
 
@@ -132,6 +133,60 @@ func queryDepositsCmd() *cobra.Command {
 	return cmd
 }
 
+func queryDepositLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-locks",
+		Short: "query savings module deposit locks with optional filters",
+		Long:  "query for all savings module deposit locks or the locks of an owner using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s deposit-locks
+%[1]s q %[2]s deposit-locks --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositLocksRequest{
+				Pagination: pageReq,
+			}
+
+			if len(ownerBech) != 0 {
+				lockOwner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				req.Owner = lockOwner.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositLocks(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "deposit-locks")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for deposit locks by owner address")
+
+	return cmd
+}
+
 // GetCmdTotalSupply returns the command that queries total supply locked into savings module
 func GetCmdTotalSupply() *cobra.Command {
 	return &cobra.Command{
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +15,11 @@ import (
 	"github.com/kava-labs/kava/x/savings/types"
 )
 
+// flags for cli transactions
+const (
+	flagBeneficiary = "beneficiary"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	savingsTxCmd := &cobra.Command{
@@ -27,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdDepositLocked(),
 	}
 
 	for _, cmd := range cmds {
@@ -39,11 +46,12 @@ func GetTxCmd() *cobra.Command {
 }
 
 func getCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [amount]",
 		Short: "deposit coins to savings",
 		Example: fmt.Sprintf(
-			`%s tx %s deposit 10000000ukava,100000000usdx --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s deposit 10000000ukava,100000000usdx --from <key>
+%[1]s tx %[2]s deposit 10000000ukava --beneficiary kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`, version.AppName, types.ModuleName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -56,20 +64,31 @@ func getCmdDeposit() *cobra.Command {
 				return err
 			}
 			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), amount)
+			beneficiary, err := cmd.Flags().GetString(flagBeneficiary)
+			if err != nil {
+				return err
+			}
+			msg.Beneficiary = beneficiary
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagBeneficiary, "", "(optional) address credited with the deposit, defaults to the sender")
+
+	return cmd
 }
 
 func getCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [amount]",
 		Short: "withdraw coins from savings",
 		Example: fmt.Sprintf(
-			`%s tx %s withdraw 10000000ukava,100000000usdx --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s withdraw 10000000ukava,100000000usdx --from <key>
+%[1]s tx %[2]s withdraw 10000000ukava --beneficiary kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`, version.AppName, types.ModuleName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -82,6 +101,47 @@ func getCmdWithdraw() *cobra.Command {
 				return err
 			}
 			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), amount)
+			beneficiary, err := cmd.Flags().GetString(flagBeneficiary)
+			if err != nil {
+				return err
+			}
+			msg.Beneficiary = beneficiary
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagBeneficiary, "", "(optional) address receiving the withdrawn coins, defaults to the sender")
+
+	return cmd
+}
+
+func getCmdDepositLocked() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-locked [amount] [lock-duration]",
+		Short: "deposit coins to savings and lock them for a duration",
+		Example: fmt.Sprintf(
+			`%s tx %s deposit-locked 10000000ukava 720h --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			lockDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositLocked(clientCtx.GetFromAddress(), amount, lockDuration)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
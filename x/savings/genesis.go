@@ -21,6 +21,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 		k.SetDeposit(ctx, deposit)
 	}
 
+	for _, lock := range gs.DepositLocks {
+		k.SetDepositLock(ctx, lock)
+	}
+
+	if gs.NextDepositLockID > 0 {
+		k.SetNextDepositLockID(ctx, gs.NextDepositLockID)
+	}
+
 	// check if the module account exists
 	SavingsModuleAccount := ak.GetModuleAccount(ctx, types.ModuleAccountName)
 	if SavingsModuleAccount == nil {
@@ -32,5 +40,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	deposits := k.GetAllDeposits(ctx)
-	return types.NewGenesisState(params, deposits)
+
+	gs := types.NewGenesisState(params, deposits)
+	gs.DepositLocks = k.GetAllDepositLocks(ctx)
+	gs.NextDepositLockID = k.GetNextDepositLockID(ctx)
+	return gs
 }
@@ -74,6 +74,62 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	suite.Equal(expectedGenesis, exportedGenesis)
 }
 
+func (suite *GenesisTestSuite) TestInitExportGenesis_DepositLocks() {
+	params := types.NewParams([]string{"ukava"})
+
+	depositAmt := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e8)))
+	savingsGenesis := types.NewGenesisState(params, types.Deposits{types.NewDeposit(suite.addrs[0], depositAmt)})
+	savingsGenesis.DepositLocks = types.DepositLocks{
+		types.NewDepositLock(3, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(4e7))), suite.genTime.Add(time.Hour)),
+		types.NewDepositLock(5, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(6e7))), suite.genTime.Add(2*time.Hour)),
+	}
+	savingsGenesis.NextDepositLockID = 6
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(types.ModuleAccountName, depositAmt)
+
+	cdc := suite.app.AppCodec()
+	suite.NotPanics(
+		func() {
+			suite.app.InitializeFromGenesisStatesWithTime(
+				suite.genTime,
+				authBuilder.BuildMarshalled(cdc),
+				app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&savingsGenesis)},
+			)
+		},
+	)
+
+	suite.Equal(savingsGenesis, savings.ExportGenesis(suite.ctx, suite.keeper))
+}
+
+func (suite *GenesisTestSuite) TestGenesisValidate_DepositLocks() {
+	depositAmt := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100)))
+	lockAmt := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(60)))
+
+	gs := types.NewGenesisState(types.NewParams([]string{"ukava"}), types.Deposits{types.NewDeposit(suite.addrs[0], depositAmt)})
+	gs.DepositLocks = types.DepositLocks{types.NewDepositLock(1, suite.addrs[0], lockAmt, suite.genTime)}
+
+	// ids must be below the next id
+	suite.Error(gs.Validate())
+	gs.NextDepositLockID = 3
+	suite.NoError(gs.Validate())
+
+	// locks can't exceed the deposit
+	gs.DepositLocks = append(gs.DepositLocks, types.NewDepositLock(2, suite.addrs[0], lockAmt, suite.genTime))
+	suite.Error(gs.Validate())
+
+	// locks need a deposit
+	gs.DepositLocks = types.DepositLocks{types.NewDepositLock(1, suite.addrs[1], lockAmt, suite.genTime)}
+	suite.Error(gs.Validate())
+
+	// ids are unique
+	gs.DepositLocks = types.DepositLocks{
+		types.NewDepositLock(1, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10))), suite.genTime),
+		types.NewDepositLock(1, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10))), suite.genTime),
+	}
+	suite.Error(gs.Validate())
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
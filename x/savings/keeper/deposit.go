@@ -10,6 +10,12 @@ import (
 
 // Deposit deposit
 func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	return k.DepositFor(ctx, depositor, depositor, coins)
+}
+
+// DepositFor deposits coins from the depositor to the deposit of the
+// beneficiary
+func (k Keeper) DepositFor(ctx sdk.Context, depositor, beneficiary sdk.AccAddress, coins sdk.Coins) error {
 	err := k.ValidateDeposit(ctx, coins)
 	if err != nil {
 		return err
@@ -20,13 +26,12 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
-	currDeposit, foundDeposit := k.GetDeposit(ctx, depositor)
+	currDeposit, foundDeposit := k.GetDeposit(ctx, beneficiary)
 
-	deposit := types.NewDeposit(depositor, coins)
+	deposit := types.NewDeposit(beneficiary, coins)
 	if foundDeposit {
+		k.BeforeSavingsDepositModified(ctx, currDeposit, setDifference(getDenoms(coins), getDenoms(currDeposit.Amount)))
 		deposit.Amount = deposit.Amount.Add(currDeposit.Amount...)
-		k.BeforeSavingsDepositModified(ctx, deposit, setDifference(getDenoms(coins), getDenoms(deposit.Amount)))
-
 	}
 
 	k.SetDeposit(ctx, deposit)
@@ -13,6 +13,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/savings/keeper"
 	"github.com/kava-labs/kava/x/savings/types"
 )
 
@@ -210,5 +211,82 @@ func (suite *KeeperTestSuite) TestDeposit() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDepositFor() {
+	depositor := suite.setupFundedDepositor()
+	beneficiary := sdk.AccAddress("beneficiary_________")
+
+	err := suite.keeper.DepositFor(suite.ctx, depositor, beneficiary, cs(c("ukava", 100)))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, beneficiary)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewDeposit(beneficiary, cs(c("ukava", 100))), deposit)
+
+	bankKeeper := suite.app.GetBankKeeper()
+	suite.Require().Equal(cs(c("bnb", 1000), c("ukava", 900)), bankKeeper.GetAllBalances(suite.ctx, depositor))
+	suite.Require().True(bankKeeper.GetAllBalances(suite.ctx, beneficiary).IsZero())
+
+	// The beneficiary owns the deposit
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 100)))
+	suite.Require().ErrorIs(err, types.ErrNoDepositFound)
+
+	err = suite.keeper.Withdraw(suite.ctx, beneficiary, cs(c("ukava", 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("ukava", 100)), bankKeeper.GetAllBalances(suite.ctx, beneficiary))
+}
+
+func (suite *KeeperTestSuite) TestDeposit_Hooks() {
+	depositor := suite.setupFundedDepositor()
+	hooks := &recordingSavingsHooks{}
+	suite.keeper = suite.keeperWithHooks(hooks)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, cs(c("ukava", 100))))
+	suite.Require().Equal([]types.Deposit{types.NewDeposit(depositor, cs(c("ukava", 100)))}, hooks.created)
+	suite.Require().Empty(hooks.modified)
+
+	// The hook runs on the deposit before the new coins are added
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, cs(c("ukava", 50), c("bnb", 10))))
+	suite.Require().Equal([]types.Deposit{types.NewDeposit(depositor, cs(c("ukava", 100)))}, hooks.modified)
+	suite.Require().Equal([][]string{{"bnb"}}, hooks.incomingDenoms)
+}
+
+// keeperWithHooks returns a savings keeper on the app's store that only runs the given hooks
+func (suite *KeeperTestSuite) keeperWithHooks(hooks types.SavingsHooks) keeper.Keeper {
+	subspace, _ := suite.app.GetParamsKeeper().GetSubspace(types.ModuleName)
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(),
+		suite.app.GetKVStoreKey(types.StoreKey),
+		subspace,
+		suite.app.GetAccountKeeper(),
+		suite.app.GetBankKeeper(),
+		suite.app.GetLiquidKeeper(),
+	)
+	k.SetHooks(types.NewMultiSavingsHooks(hooks))
+	return k
+}
+
+// recordingSavingsHooks records the deposits passed to the savings hooks
+type recordingSavingsHooks struct {
+	created        []types.Deposit
+	modified       []types.Deposit
+	incomingDenoms [][]string
+}
+
+var _ types.SavingsHooks = &recordingSavingsHooks{}
+
+func (h *recordingSavingsHooks) AfterSavingsDepositCreated(_ sdk.Context, deposit types.Deposit) {
+	h.created = append(h.created, deposit)
+}
+
+func (h *recordingSavingsHooks) BeforeSavingsDepositModified(_ sdk.Context, deposit types.Deposit, incomingDenoms []string) {
+	h.modified = append(h.modified, deposit)
+	h.incomingDenoms = append(h.incomingDenoms, incomingDenoms)
+}
+
+func (h *recordingSavingsHooks) AfterSavingsDepositLocked(sdk.Context, types.DepositLock) {}
+
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
@@ -106,6 +106,43 @@ func (s queryServer) Deposits(ctx context.Context, req *types.QueryDepositsReque
 	}, nil
 }
 
+// DepositLocks implements the gRPC service handler for querying x/savings deposit locks.
+func (s queryServer) DepositLocks(ctx context.Context, req *types.QueryDepositLocksRequest) (*types.QueryDepositLocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var locks types.DepositLocks
+	if len(req.Owner) > 0 {
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		locks = s.keeper.GetDepositLocks(sdkCtx, owner)
+	} else {
+		locks = s.keeper.GetAllDepositLocks(sdkCtx)
+	}
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(locks), page, limit, 100)
+	if start < 0 || end < 0 {
+		locks = types.DepositLocks{}
+	} else {
+		locks = locks[start:end]
+	}
+
+	return &types.QueryDepositLocksResponse{
+		DepositLocks: locks,
+		Pagination:   nil,
+	}, nil
+}
+
 func (s queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	totalSupply := sdk.NewCoins()
@@ -22,3 +22,10 @@ func (k Keeper) BeforeSavingsDepositModified(ctx sdk.Context, deposit types.Depo
 		k.hooks.BeforeSavingsDepositModified(ctx, deposit, incomingDenoms)
 	}
 }
+
+// AfterSavingsDepositLocked - call hook if registered
+func (k Keeper) AfterSavingsDepositLocked(ctx sdk.Context, lock types.DepositLock) {
+	if k.hooks != nil {
+		k.hooks.AfterSavingsDepositLocked(ctx, lock)
+	}
+}
@@ -10,6 +10,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "deposits", DepositsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "solvency", SolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposit-locks", DepositLocksInvariant(k))
 }
 
 // AllInvariants runs all invariants of the savings module
@@ -19,7 +20,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := SolvencyInvariant(k)(ctx)
+		if res, stop := SolvencyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := DepositLocksInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -65,3 +70,39 @@ func SolvencyInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// DepositLocksInvariant iterates all deposit locks and asserts that they are
+// valid and don't lock more than the deposit of the depositor
+func DepositLocksInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "validate deposit locks broken", "deposit lock invalid or exceeds deposit")
+
+	return func(ctx sdk.Context) (string, bool) {
+		locked := make(map[string]sdk.Coins)
+		broken := false
+
+		k.IterateDepositLocks(ctx, func(lock types.DepositLock) bool {
+			if err := lock.Validate(); err != nil {
+				broken = true
+				return true
+			}
+			depositor := lock.Depositor.String()
+			locked[depositor] = locked[depositor].Add(lock.Amount...)
+			return false
+		})
+
+		for depositor, amount := range locked {
+			addr, err := sdk.AccAddressFromBech32(depositor)
+			if err != nil {
+				broken = true
+				break
+			}
+			deposit, found := k.GetDeposit(ctx, addr)
+			if !found || !deposit.Amount.IsAllGTE(amount) {
+				broken = true
+				break
+			}
+		}
+
+		return message, broken
+	}
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
@@ -50,6 +51,31 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.app.GetStakingKeeper().SetParams(suite.ctx, stakingParams)
 }
 
+// setupFundedDepositor initializes the app with a depositor holding ukava and
+// bnb, which are both supported savings denoms
+func (suite *KeeperTestSuite) setupFundedDepositor() sdk.AccAddress {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	depositor := sdk.AccAddress("depositor___________")
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{cs(c("ukava", 1000), c("bnb", 1000))},
+		[]sdk.AccAddress{depositor},
+	)
+	savingsGS := types.NewGenesisState(types.NewParams([]string{"ukava", "bnb"}), types.Deposits{})
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&savingsGS)},
+	)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetSavingsKeeper()
+
+	return depositor
+}
+
 func (suite *KeeperTestSuite) TestGetSetDeleteDeposit() {
 	dep := types.NewDeposit(sdk.AccAddress("test"), sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100))))
 
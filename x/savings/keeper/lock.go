@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// DepositLocked deposits coins and locks them until the lock duration has
+// passed. Locked coins can't be withdrawn.
+func (k Keeper) DepositLocked(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	coins sdk.Coins,
+	lockDuration time.Duration,
+) (uint64, error) {
+	if lockDuration <= 0 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidLockDuration, "%s", lockDuration)
+	}
+
+	if err := k.Deposit(ctx, depositor, coins); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextDepositLockID(ctx)
+	lock := types.NewDepositLock(id, depositor, coins, ctx.BlockTime().Add(lockDuration))
+
+	k.SetDepositLock(ctx, lock)
+	k.SetNextDepositLockID(ctx, id+1)
+	k.AfterSavingsDepositLocked(ctx, lock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsLock,
+			sdk.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, lock.EndTime.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// UnlockDeposits removes all deposit locks that ended at or before the
+// current block time.
+func (k Keeper) UnlockDeposits(ctx sdk.Context) {
+	var expired types.DepositLocks
+	k.IterateDepositLockQueue(ctx, ctx.BlockTime(), func(lock types.DepositLock) (stop bool) {
+		expired = append(expired, lock)
+		return false
+	})
+
+	for _, lock := range expired {
+		// Sync rewards while the lock still applies to the deposit
+		if deposit, found := k.GetDeposit(ctx, lock.Depositor); found {
+			k.BeforeSavingsDepositModified(ctx, deposit, nil)
+		}
+
+		k.DeleteDepositLock(ctx, lock)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSavingsUnlock,
+				sdk.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(lock.ID, 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, lock.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDepositor, lock.Depositor.String()),
+			),
+		)
+	}
+}
+
+// GetLockedAmount returns the sum of all locked coins of a depositor
+func (k Keeper) GetLockedAmount(ctx sdk.Context, depositor sdk.AccAddress) sdk.Coins {
+	return k.GetDepositLocks(ctx, depositor).TotalAmount()
+}
+
+// GetDepositLock returns a deposit lock from the store
+func (k Keeper) GetDepositLock(ctx sdk.Context, depositor sdk.AccAddress, id uint64) (types.DepositLock, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	bz := store.Get(types.DepositLockKey(depositor, id))
+	if len(bz) == 0 {
+		return types.DepositLock{}, false
+	}
+	var lock types.DepositLock
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetDepositLock sets the deposit lock in the store and adds it to the queue
+// of locks to be removed once they end
+func (k Keeper) SetDepositLock(ctx sdk.Context, lock types.DepositLock) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(types.DepositLockKey(lock.Depositor, lock.ID), bz)
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.DepositLockQueueKeyPrefix)
+	queueStore.Set(types.DepositLockQueueKey(lock.EndTime, lock.Depositor, lock.ID), types.DepositLockKey(lock.Depositor, lock.ID))
+}
+
+// DeleteDepositLock deletes a deposit lock and its queue entry from the store
+func (k Keeper) DeleteDepositLock(ctx sdk.Context, lock types.DepositLock) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	store.Delete(types.DepositLockKey(lock.Depositor, lock.ID))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.DepositLockQueueKeyPrefix)
+	queueStore.Delete(types.DepositLockQueueKey(lock.EndTime, lock.Depositor, lock.ID))
+}
+
+// IterateDepositLocks iterates over all deposit locks in the store and performs a callback function
+func (k Keeper) IterateDepositLocks(ctx sdk.Context, cb func(lock types.DepositLock) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.DepositLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// IterateDepositLockQueue iterates over the deposit locks that end at or
+// before the given time, in order of end time
+func (k Keeper) IterateDepositLockQueue(ctx sdk.Context, endTime time.Time, cb func(lock types.DepositLock) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLockQueueKeyPrefix)
+	lockStore := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(endTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.DepositLock
+		k.cdc.MustUnmarshal(lockStore.Get(iterator.Value()), &lock)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// IterateDepositLockQueueRange iterates over the deposit locks that end after
+// the start time and at or before the end time, in order of end time
+func (k Keeper) IterateDepositLockQueueRange(ctx sdk.Context, start, end time.Time, cb func(lock types.DepositLock) (stop bool)) {
+	if !start.Before(end) {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLockQueueKeyPrefix)
+	lockStore := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	iterator := store.Iterator(
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(start)),
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(end)),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.DepositLock
+		k.cdc.MustUnmarshal(lockStore.Get(iterator.Value()), &lock)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// GetDepositLocks returns all deposit locks of a depositor
+func (k Keeper) GetDepositLocks(ctx sdk.Context, depositor sdk.AccAddress) (locks types.DepositLocks) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositLocksKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositLocksKey(depositor))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.DepositLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		locks = append(locks, lock)
+	}
+	return
+}
+
+// GetAllDepositLocks returns all deposit locks from the store
+func (k Keeper) GetAllDepositLocks(ctx sdk.Context) (locks types.DepositLocks) {
+	k.IterateDepositLocks(ctx, func(lock types.DepositLock) bool {
+		locks = append(locks, lock)
+		return false
+	})
+	return
+}
+
+// GetNextDepositLockID returns the id of the next deposit lock
+func (k Keeper) GetNextDepositLockID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextDepositLockIDKey)
+	if bz == nil {
+		return types.DefaultNextDepositLockID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextDepositLockID sets the id of the next deposit lock
+func (k Keeper) SetNextDepositLockID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextDepositLockIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/x/savings"
+	"github.com/kava-labs/kava/x/savings/keeper"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+const lockDuration = 30 * 24 * time.Hour
+
+func (suite *KeeperTestSuite) TestDepositLocked() {
+	depositor := suite.setupFundedDepositor()
+
+	id, err := suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 300)), lockDuration)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultNextDepositLockID, id)
+	suite.Require().Equal(id+1, suite.keeper.GetNextDepositLockID(suite.ctx))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("ukava", 300)), deposit.Amount)
+
+	lock, found := suite.keeper.GetDepositLock(suite.ctx, depositor, id)
+	suite.Require().True(found)
+	suite.Require().Equal(
+		types.NewDepositLock(id, depositor, cs(c("ukava", 300)), suite.ctx.BlockTime().Add(lockDuration)),
+		lock,
+	)
+
+	_, err = suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 100)), 0)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	_, err = suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("btcb", 100)), lockDuration)
+	suite.Require().ErrorIs(err, types.ErrInvalidDepositDenom)
+	suite.Require().Len(suite.keeper.GetDepositLocks(suite.ctx, depositor), 1)
+}
+
+func (suite *KeeperTestSuite) TestWithdraw_RespectsLocks() {
+	depositor := suite.setupFundedDepositor()
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, cs(c("ukava", 200)))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 300), c("bnb", 100)), lockDuration)
+	suite.Require().NoError(err)
+
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().Equal(cs(c("ukava", 200)), suite.keeper.GetWithdrawableAmount(suite.ctx, deposit))
+
+	// Fully locked denoms can't be withdrawn
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("bnb", 100)))
+	suite.Require().ErrorIs(err, types.ErrDepositLocked)
+
+	// Requests are capped at the unlocked amount
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 1000)))
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("ukava", 300), c("bnb", 100)), deposit.Amount)
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 1)))
+	suite.Require().ErrorIs(err, types.ErrDepositLocked)
+
+	// Denoms that were never deposited keep their error
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("btcb", 1)))
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawDenom)
+}
+
+func (suite *KeeperTestSuite) TestUnlockDeposits() {
+	depositor := suite.setupFundedDepositor()
+
+	shortID, err := suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 100)), lockDuration)
+	suite.Require().NoError(err)
+	longID, err := suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 200)), 2*lockDuration)
+	suite.Require().NoError(err)
+
+	// Locks are kept until their end time
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(lockDuration - time.Second))
+	savings.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Len(suite.keeper.GetDepositLocks(suite.ctx, depositor), 2)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	savings.EndBlocker(suite.ctx, suite.keeper)
+
+	_, found := suite.keeper.GetDepositLock(suite.ctx, depositor, shortID)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetDepositLock(suite.ctx, depositor, longID)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("ukava", 200)), suite.keeper.GetLockedAmount(suite.ctx, depositor))
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 100)))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(lockDuration))
+	savings.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Empty(suite.keeper.GetAllDepositLocks(suite.ctx))
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 200)))
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+
+	message, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken, message)
+}
+
+func (suite *KeeperTestSuite) TestIterateDepositLockQueueRange() {
+	depositor := suite.setupFundedDepositor()
+	start := suite.ctx.BlockTime()
+
+	shortID, err := suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 100)), lockDuration)
+	suite.Require().NoError(err)
+	longID, err := suite.keeper.DepositLocked(suite.ctx, depositor, cs(c("ukava", 200)), 2*lockDuration)
+	suite.Require().NoError(err)
+
+	rangeIDs := func(from, to time.Time) []uint64 {
+		var ids []uint64
+		suite.keeper.IterateDepositLockQueueRange(suite.ctx, from, to, func(lock types.DepositLock) bool {
+			ids = append(ids, lock.ID)
+			return false
+		})
+		return ids
+	}
+
+	// The range excludes the start time and includes the end time
+	suite.Equal([]uint64{shortID}, rangeIDs(start, start.Add(lockDuration)))
+	suite.Empty(rangeIDs(start.Add(lockDuration), start.Add(2*lockDuration-time.Second)))
+	suite.Equal([]uint64{longID}, rangeIDs(start.Add(lockDuration), start.Add(2*lockDuration)))
+	suite.Equal([]uint64{shortID, longID}, rangeIDs(start, start.Add(2*lockDuration)))
+	suite.Empty(rangeIDs(start.Add(2*lockDuration), start))
+}
@@ -28,7 +28,12 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	beneficiary, err := getBeneficiary(depositor, msg.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DepositFor(ctx, depositor, beneficiary, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +56,12 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.Withdraw(ctx, depositor, msg.Amount)
+	recipient, err := getBeneficiary(depositor, msg.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.WithdrawTo(ctx, depositor, recipient, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -65,3 +75,35 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 	)
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+func (k msgServer) DepositLocked(goCtx context.Context, msg *types.MsgDepositLocked) (*types.MsgDepositLockedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	lockID, err := k.keeper.DepositLocked(ctx, depositor, msg.Amount, msg.LockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgDepositLockedResponse{LockID: lockID}, nil
+}
+
+// getBeneficiary returns the optional beneficiary of a message, defaulting to
+// the signer
+func getBeneficiary(signer sdk.AccAddress, beneficiary string) (sdk.AccAddress, error) {
+	if len(beneficiary) == 0 {
+		return signer, nil
+	}
+	return sdk.AccAddressFromBech32(beneficiary)
+}
@@ -11,21 +11,32 @@ import (
 
 // Withdraw returns some or all of a deposit back to original depositor
 func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	return k.WithdrawTo(ctx, depositor, depositor, coins)
+}
+
+// WithdrawTo sends some or all of a deposit to the recipient
+func (k Keeper) WithdrawTo(ctx sdk.Context, depositor, recipient sdk.AccAddress, coins sdk.Coins) error {
 	deposit, found := k.GetDeposit(ctx, depositor)
 	if !found {
 		return errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
 	}
 
-	amount, err := k.CalculateWithdrawAmount(deposit.Amount, coins)
+	withdrawable := k.GetWithdrawableAmount(ctx, deposit)
+	amount, err := k.CalculateWithdrawAmount(withdrawable, coins)
 	if err != nil {
+		if coins.DenomsSubsetOf(deposit.Amount) {
+			return errorsmod.Wrapf(types.ErrDepositLocked, "withdrawable amount %s", withdrawable)
+		}
 		return err
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, recipient, amount)
 	if err != nil {
 		return err
 	}
 
+	k.BeforeSavingsDepositModified(ctx, deposit, nil)
+
 	deposit.Amount = deposit.Amount.Sub(amount...)
 	if deposit.Amount.Empty() {
 		k.DeleteDeposit(ctx, deposit)
@@ -38,11 +49,26 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 			types.EventTypeSavingsWithdrawal,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
 		),
 	)
 	return nil
 }
 
+// GetWithdrawableAmount returns the deposited coins that are not locked
+func (k Keeper) GetWithdrawableAmount(ctx sdk.Context, deposit types.Deposit) sdk.Coins {
+	locked := k.GetLockedAmount(ctx, deposit.Depositor)
+
+	withdrawable := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		amount := coin.Amount.Sub(locked.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			withdrawable = withdrawable.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return withdrawable
+}
+
 // CalculateWithdrawAmount enables full withdraw of deposited coins by adjusting withdraw amount
 // to equal total deposit amount if the requested withdraw amount > current deposit amount
 func (k Keeper) CalculateWithdrawAmount(available sdk.Coins, request sdk.Coins) (sdk.Coins, error) {
@@ -200,3 +200,42 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestWithdrawTo() {
+	depositor := suite.setupFundedDepositor()
+	recipient := sdk.AccAddress("recipient___________")
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, cs(c("ukava", 100)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.WithdrawTo(suite.ctx, depositor, recipient, cs(c("ukava", 40)))
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("ukava", 60)), deposit.Amount)
+
+	bankKeeper := suite.app.GetBankKeeper()
+	suite.Require().Equal(cs(c("bnb", 1000), c("ukava", 900)), bankKeeper.GetAllBalances(suite.ctx, depositor))
+	suite.Require().Equal(cs(c("ukava", 40)), bankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	// The recipient has no deposit to withdraw
+	err = suite.keeper.WithdrawTo(suite.ctx, recipient, depositor, cs(c("ukava", 40)))
+	suite.Require().ErrorIs(err, types.ErrNoDepositFound)
+}
+
+func (suite *KeeperTestSuite) TestWithdraw_Hooks() {
+	depositor := suite.setupFundedDepositor()
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, cs(c("ukava", 100))))
+
+	hooks := &recordingSavingsHooks{}
+	suite.keeper = suite.keeperWithHooks(hooks)
+
+	// The hook runs on the deposit before the coins are withdrawn
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 40))))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, cs(c("ukava", 60))))
+	suite.Require().Equal([]types.Deposit{
+		types.NewDeposit(depositor, cs(c("ukava", 100))),
+		types.NewDeposit(depositor, cs(c("ukava", 60))),
+	}, hooks.modified)
+}
@@ -13,4 +13,8 @@ var (
 	ErrInvalidDepositDenom = errorsmod.Register(ModuleName, 4, "invalid deposit denom")
 	// ErrInvalidWithdrawDenom error for invalid withdraw denoms
 	ErrInvalidWithdrawDenom = errorsmod.Register(ModuleName, 5, "invalid withdraw denom")
+	// ErrInvalidLockDuration error for non-positive lock durations
+	ErrInvalidLockDuration = errorsmod.Register(ModuleName, 6, "invalid lock duration")
+	// ErrDepositLocked error when withdrawing locked deposit coins
+	ErrDepositLocked = errorsmod.Register(ModuleName, 7, "deposit is locked")
 )
@@ -3,8 +3,13 @@ package types
 const (
 	EventTypeSavingsDeposit    = "deposit_savings"
 	EventTypeSavingsWithdrawal = "withdraw_savings"
+	EventTypeSavingsLock       = "lock_savings"
+	EventTypeSavingsUnlock     = "unlock_savings"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyAmount     = "amount"
 	AttributeKeyDepositor  = "depositor"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyLockID     = "lock_id"
+	AttributeKeyEndTime    = "end_time"
 )
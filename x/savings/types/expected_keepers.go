@@ -30,6 +30,7 @@ type AccountKeeper interface {
 type SavingsHooks interface {
 	AfterSavingsDepositCreated(ctx sdk.Context, deposit Deposit)
 	BeforeSavingsDepositModified(ctx sdk.Context, deposit Deposit, incomingDenoms []string)
+	AfterSavingsDepositLocked(ctx sdk.Context, lock DepositLock)
 }
 
 type LiquidKeeper interface {
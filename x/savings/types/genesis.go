@@ -1,10 +1,17 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state for the savings module
 func NewGenesisState(p Params, deposits Deposits) GenesisState {
 	return GenesisState{
-		Params:   p,
-		Deposits: deposits,
+		Params:            p,
+		Deposits:          deposits,
+		NextDepositLockID: DefaultNextDepositLockID,
 	}
 }
 
@@ -23,5 +30,31 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Deposits.Validate()
+	if err := gs.Deposits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.DepositLocks.Validate(); err != nil {
+		return err
+	}
+
+	deposits := make(map[string]sdk.Coins)
+	for _, deposit := range gs.Deposits {
+		deposits[deposit.Depositor.String()] = deposit.Amount
+	}
+
+	locked := make(map[string]sdk.Coins)
+	for _, lock := range gs.DepositLocks {
+		if lock.ID >= gs.NextDepositLockID {
+			return fmt.Errorf("deposit lock id %d must be less than the next deposit lock id %d", lock.ID, gs.NextDepositLockID)
+		}
+
+		depositor := lock.Depositor.String()
+		locked[depositor] = locked[depositor].Add(lock.Amount...)
+		if !deposits[depositor].IsAllGTE(locked[depositor]) {
+			return fmt.Errorf("locked amount %s exceeds deposit of %s", locked[depositor], depositor)
+		}
+	}
+
+	return nil
 }
@@ -26,8 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the savings module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Deposits Deposits `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Params            Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Deposits          Deposits     `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	DepositLocks      DepositLocks `protobuf:"bytes,3,rep,name=deposit_locks,json=depositLocks,proto3,castrepeated=DepositLocks" json:"deposit_locks"`
+	NextDepositLockID uint64       `protobuf:"varint,4,opt,name=next_deposit_lock_id,json=nextDepositLockId,proto3" json:"next_deposit_lock_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +79,20 @@ func (m *GenesisState) GetDeposits() Deposits {
 	return nil
 }
 
+func (m *GenesisState) GetDepositLocks() DepositLocks {
+	if m != nil {
+		return m.DepositLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextDepositLockID() uint64 {
+	if m != nil {
+		return m.NextDepositLockID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.savings.v1beta1.GenesisState")
 }
@@ -86,23 +102,28 @@ func init() {
 }
 
 var fileDescriptor_f5dcde4d417fcec8 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x4e, 0x2c, 0xcb, 0xcc, 0x4b, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xa9, 0xd1, 0x83, 0xaa, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x57, 0x5c, 0x92, 0x5f, 0x94, 0x0a, 0x51,
-	0xa1, 0x34, 0x9d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7e, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c,
-	0x1e, 0x36, 0xfb, 0xf4, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea,
-	0x10, 0xf2, 0xe6, 0xe2, 0x48, 0x49, 0x2d, 0xc8, 0x2f, 0xce, 0x2c, 0x29, 0x96, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x92, 0xc5, 0xae, 0xdb, 0x05, 0xa2, 0xca, 0x49, 0x00, 0xa4, 0x7d, 0xd5, 0x7d,
-	0x79, 0x0e, 0xa8, 0x40, 0x71, 0x10, 0xdc, 0x00, 0x27, 0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x07, 0x19, 0xaf, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57, 0xc0, 0x3d, 0x5b, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa5, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x68, 0xf5,
-	0x36, 0x59, 0x01, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x93, 0xb6, 0x94, 0x32, 0xed, 0x85, 0xdb, 0x10, 0x21, 0x14, 0x9d, 0xc6, 0xae, 0xea,
+	0xc2, 0x19, 0x5a, 0x77, 0x2e, 0x63, 0x51, 0x44, 0x11, 0x89, 0x3b, 0x11, 0xca, 0xa4, 0x19, 0x62,
+	0x68, 0x9b, 0x09, 0x3d, 0x63, 0xa9, 0x6f, 0xe1, 0x73, 0xf8, 0x0e, 0xee, 0xbb, 0xec, 0xd2, 0x55,
+	0x95, 0xf4, 0x45, 0x24, 0xc9, 0x50, 0x82, 0x04, 0x77, 0x67, 0x7e, 0xbe, 0xff, 0x3b, 0x03, 0x07,
+	0xf5, 0xa6, 0x6c, 0xc9, 0x28, 0xb0, 0x65, 0x18, 0x05, 0x40, 0x97, 0x03, 0x8f, 0x4b, 0x36, 0xa0,
+	0x01, 0x8f, 0x38, 0x84, 0x40, 0xe2, 0x85, 0x90, 0xc2, 0x30, 0x53, 0x86, 0x28, 0x86, 0x28, 0xa6,
+	0x63, 0x06, 0x22, 0x10, 0x19, 0x40, 0xd3, 0x29, 0x67, 0x3b, 0x76, 0xa9, 0x0f, 0xa4, 0x58, 0xf0,
+	0x9c, 0xe8, 0x7d, 0x54, 0x50, 0xeb, 0x2a, 0xf7, 0x3f, 0x48, 0x26, 0xb9, 0x71, 0x8e, 0xea, 0x31,
+	0x5b, 0xb0, 0x39, 0x58, 0xba, 0xad, 0xf7, 0x9b, 0xc3, 0x43, 0x52, 0xb6, 0x8f, 0xdc, 0x67, 0x8c,
+	0x53, 0x5b, 0x6f, 0xbb, 0x9a, 0xab, 0x1a, 0xc6, 0x0d, 0x6a, 0xf8, 0x3c, 0x16, 0x10, 0x4a, 0xb0,
+	0x2a, 0x76, 0xb5, 0xdf, 0x1c, 0x1e, 0x95, 0xb7, 0x47, 0x39, 0xe5, 0xfc, 0x4f, 0xeb, 0xef, 0x5f,
+	0xdd, 0x86, 0x0a, 0xc0, 0xdd, 0x0b, 0x8c, 0x27, 0xf4, 0x4f, 0xcd, 0xe3, 0x99, 0x98, 0x4c, 0xc1,
+	0xaa, 0x66, 0xc6, 0xe3, 0x3f, 0x8d, 0xb7, 0x62, 0x32, 0x75, 0x4c, 0x65, 0x6d, 0x15, 0x42, 0x70,
+	0x5b, 0x7e, 0xe1, 0x65, 0x5c, 0x22, 0x33, 0xe2, 0x2b, 0x39, 0x2e, 0xae, 0x18, 0x87, 0xbe, 0x55,
+	0xb3, 0xf5, 0x7e, 0xcd, 0x39, 0x48, 0xb6, 0xdd, 0xf6, 0x1d, 0x5f, 0xc9, 0x82, 0xe1, 0x7a, 0xe4,
+	0xb6, 0xa3, 0x5f, 0x91, 0xef, 0x5c, 0xac, 0x13, 0xac, 0x6f, 0x12, 0xac, 0x7f, 0x27, 0x58, 0x7f,
+	0xdb, 0x61, 0x6d, 0xb3, 0xc3, 0xda, 0xe7, 0x0e, 0x6b, 0x8f, 0x27, 0x41, 0x28, 0x9f, 0x5f, 0x3c,
+	0x32, 0x11, 0x73, 0x9a, 0x7e, 0xf9, 0x74, 0xc6, 0x3c, 0xc8, 0x26, 0xba, 0xda, 0x9f, 0x44, 0xbe,
+	0xc6, 0x1c, 0xbc, 0x7a, 0x76, 0x8b, 0xb3, 0x9f, 0x01, 0x00, 0x4f, 0x67, 0xb4, 0x07, 0xff, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +146,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextDepositLockID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDepositLockID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DepositLocks) > 0 {
+		for iNdEx := len(m.DepositLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Deposits) > 0 {
 		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +217,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DepositLocks) > 0 {
+		for _, e := range m.DepositLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextDepositLockID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextDepositLockID))
+	}
 	return n
 }
 
@@ -282,6 +331,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositLocks = append(m.DepositLocks, DepositLock{})
+			if err := m.DepositLocks[len(m.DepositLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDepositLockID", wireType)
+			}
+			m.NextDepositLockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDepositLockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,3 +23,10 @@ func (s MultiSavingsHooks) BeforeSavingsDepositModified(ctx sdk.Context, deposit
 		s[i].BeforeSavingsDepositModified(ctx, deposit, incomingDenoms)
 	}
 }
+
+// AfterSavingsDepositLocked runs after a deposit lock is created
+func (s MultiSavingsHooks) AfterSavingsDepositLocked(ctx sdk.Context, lock DepositLock) {
+	for i := range s {
+		s[i].AfterSavingsDepositLocked(ctx, lock)
+	}
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "savings"
@@ -17,4 +24,25 @@ const (
 	ModuleAccountName = ModuleName
 )
 
-var DepositsKeyPrefix = []byte{0x01}
+var (
+	DepositsKeyPrefix         = []byte{0x01}
+	DepositLocksKeyPrefix     = []byte{0x02} // depositor | id -> DepositLock
+	DepositLockQueueKeyPrefix = []byte{0x03} // end time | depositor | id -> nil
+	NextDepositLockIDKey      = []byte{0x04}
+)
+
+// DepositLocksKey returns the key prefix of the deposit locks of a depositor
+func DepositLocksKey(depositor sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(depositor)
+}
+
+// DepositLockKey returns the key of a deposit lock
+func DepositLockKey(depositor sdk.AccAddress, id uint64) []byte {
+	return append(DepositLocksKey(depositor), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DepositLockQueueKey returns the key of a deposit lock in the queue of locks
+// ordered by end time
+func DepositLockQueueKey(endTime time.Time, depositor sdk.AccAddress, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(endTime), DepositLockKey(depositor, id)...)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextDepositLockID is the id of the first deposit lock
+const DefaultNextDepositLockID = uint64(1)
+
+// NewDepositLock returns a new deposit lock
+func NewDepositLock(id uint64, depositor sdk.AccAddress, amount sdk.Coins, endTime time.Time) DepositLock {
+	return DepositLock{
+		ID:        id,
+		Depositor: depositor,
+		Amount:    amount,
+		EndTime:   endTime,
+	}
+}
+
+// Validate deposit lock validation
+func (l DepositLock) Validate() error {
+	if l.ID == 0 {
+		return fmt.Errorf("deposit lock id cannot be zero")
+	}
+	if l.Depositor.Empty() {
+		return fmt.Errorf("depositor cannot be empty")
+	}
+	if !l.Amount.IsValid() || l.Amount.IsZero() {
+		return fmt.Errorf("invalid deposit lock coins: %s", l.Amount)
+	}
+
+	return nil
+}
+
+// DepositLocks is a slice of DepositLock
+type DepositLocks []DepositLock
+
+// Validate validates DepositLocks
+func (ls DepositLocks) Validate() error {
+	ids := make(map[uint64]bool)
+	for _, l := range ls {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		if ids[l.ID] {
+			return fmt.Errorf("duplicate deposit lock id: %d", l.ID)
+		}
+		ids[l.ID] = true
+	}
+	return nil
+}
+
+// TotalAmount returns the sum of the locked amounts
+func (ls DepositLocks) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, l := range ls {
+		total = total.Add(l.Amount...)
+	}
+	return total
+}
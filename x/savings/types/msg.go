@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,6 +12,7 @@ import (
 var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgDepositLocked{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -33,6 +36,12 @@ func (msg MsgDeposit) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
+	if len(msg.Beneficiary) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Amount)
 	}
@@ -75,6 +84,12 @@ func (msg MsgWithdraw) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
+	if len(msg.Beneficiary) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", msg.Amount)
 	}
@@ -95,3 +110,50 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{depositor}
 }
+
+// NewMsgDepositLocked returns a new MsgDepositLocked
+func NewMsgDepositLocked(depositor sdk.AccAddress, amount sdk.Coins, lockDuration time.Duration) MsgDepositLocked {
+	return MsgDepositLocked{
+		Depositor:    depositor.String(),
+		Amount:       amount,
+		LockDuration: lockDuration,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositLocked) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositLocked) Type() string { return "savings_deposit_locked" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositLocked) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Amount)
+	}
+
+	if msg.LockDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLockDuration, "%s", msg.LockDuration)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositLocked) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositLocked) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
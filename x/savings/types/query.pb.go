@@ -318,6 +318,114 @@ func (m *QueryTotalSupplyResponse) GetResult() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryDepositLocksRequest defines the request type for querying x/savings
+// deposit locks.
+type QueryDepositLocksRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositLocksRequest) Reset()         { *m = QueryDepositLocksRequest{} }
+func (m *QueryDepositLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositLocksRequest) ProtoMessage()    {}
+func (*QueryDepositLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{6}
+}
+func (m *QueryDepositLocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositLocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositLocksRequest.Merge(m, src)
+}
+func (m *QueryDepositLocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositLocksRequest proto.InternalMessageInfo
+
+func (m *QueryDepositLocksRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryDepositLocksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDepositLocksResponse defines the response type for querying x/savings
+// deposit locks.
+type QueryDepositLocksResponse struct {
+	DepositLocks DepositLocks        `protobuf:"bytes,1,rep,name=deposit_locks,json=depositLocks,proto3,castrepeated=DepositLocks" json:"deposit_locks"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositLocksResponse) Reset()         { *m = QueryDepositLocksResponse{} }
+func (m *QueryDepositLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositLocksResponse) ProtoMessage()    {}
+func (*QueryDepositLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{7}
+}
+func (m *QueryDepositLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositLocksResponse.Merge(m, src)
+}
+func (m *QueryDepositLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositLocksResponse proto.InternalMessageInfo
+
+func (m *QueryDepositLocksResponse) GetDepositLocks() DepositLocks {
+	if m != nil {
+		return m.DepositLocks
+	}
+	return nil
+}
+
+func (m *QueryDepositLocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.savings.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.savings.v1beta1.QueryParamsResponse")
@@ -325,51 +433,59 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "kava.savings.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "kava.savings.v1beta1.QueryTotalSupplyRequest")
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "kava.savings.v1beta1.QueryTotalSupplyResponse")
+	proto.RegisterType((*QueryDepositLocksRequest)(nil), "kava.savings.v1beta1.QueryDepositLocksRequest")
+	proto.RegisterType((*QueryDepositLocksResponse)(nil), "kava.savings.v1beta1.QueryDepositLocksResponse")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/query.proto", fileDescriptor_f78c91efc5db144f) }
 
 var fileDescriptor_f78c91efc5db144f = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xb1, 0x8f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x38, 0xc8, 0x39, 0x34, 0x66, 0x5c, 0x75, 0x21, 0xb8, 0x90, 0xcd, 0xe5, 0xe4,
-	0x30, 0xec, 0x7a, 0xd8, 0x5d, 0x27, 0x67, 0xb4, 0xb0, 0xd1, 0x3d, 0x13, 0x13, 0x9b, 0xcb, 0x00,
-	0x93, 0x65, 0x73, 0xb0, 0xb3, 0xb7, 0x33, 0x8b, 0xd2, 0x6a, 0x63, 0x62, 0x63, 0x62, 0xa1, 0xa5,
-	0x85, 0x95, 0x89, 0x9d, 0xf1, 0x6f, 0xb8, 0xf2, 0xa2, 0x8d, 0x95, 0x1a, 0xf0, 0x0f, 0x31, 0x3b,
-	0x33, 0xbb, 0x07, 0xc7, 0xe6, 0xa4, 0x82, 0x79, 0xf3, 0x7d, 0xdf, 0xfc, 0xe6, 0xcd, 0x03, 0xd8,
-	0x38, 0xc2, 0x13, 0x6c, 0x33, 0x3c, 0xf1, 0x7c, 0x97, 0xd9, 0x93, 0xdd, 0x1e, 0xe1, 0x78, 0xd7,
-	0x3e, 0x8e, 0x48, 0x38, 0xb5, 0x82, 0x90, 0x72, 0x8a, 0xb4, 0x58, 0x61, 0x29, 0x85, 0xa5, 0x14,
-	0xd5, 0x56, 0x9f, 0xb2, 0x31, 0x65, 0x76, 0x0f, 0x33, 0x22, 0xe5, 0xa9, 0x39, 0xc0, 0xae, 0xe7,
-	0x63, 0xee, 0x51, 0x5f, 0x26, 0x54, 0x8d, 0x45, 0x6d, 0xa2, 0xea, 0x53, 0x2f, 0xd9, 0xaf, 0xc8,
-	0xfd, 0x43, 0xb1, 0xb2, 0xe5, 0x42, 0x6d, 0x69, 0x2e, 0x75, 0xa9, 0xac, 0xc7, 0xdf, 0x54, 0xb5,
-	0xe6, 0x52, 0xea, 0x8e, 0x88, 0x8d, 0x03, 0xcf, 0xc6, 0xbe, 0x4f, 0xb9, 0x38, 0x2d, 0xf1, 0x64,
-	0x5f, 0x89, 0x71, 0x1a, 0x12, 0xa9, 0x30, 0x35, 0x88, 0x1e, 0xc7, 0xc8, 0x8f, 0x70, 0x88, 0xc7,
-	0xcc, 0x21, 0xc7, 0x11, 0x61, 0xdc, 0x7c, 0x0a, 0xaf, 0x2c, 0x55, 0x59, 0x40, 0x7d, 0x46, 0xd0,
-	0x1e, 0x2c, 0x05, 0xa2, 0xa2, 0x83, 0x06, 0x68, 0x96, 0x3b, 0x35, 0x2b, 0xab, 0x21, 0x96, 0x74,
-	0x75, 0x37, 0x4e, 0x7e, 0xd5, 0x73, 0x8e, 0x72, 0xec, 0x6d, 0xbc, 0xfe, 0x58, 0xcf, 0x99, 0x9f,
-	0x00, 0xd4, 0x44, 0xf2, 0x3d, 0x12, 0x50, 0xe6, 0xf1, 0xe4, 0x44, 0xa4, 0xc1, 0xe2, 0x80, 0xf8,
-	0x74, 0x2c, 0x92, 0x2f, 0x39, 0x72, 0x81, 0x2c, 0x58, 0xa4, 0xcf, 0x7d, 0x12, 0xea, 0xf9, 0xb8,
-	0xda, 0xd5, 0xbf, 0x7f, 0x6d, 0x6b, 0xaa, 0x29, 0x77, 0x07, 0x83, 0x90, 0x30, 0x76, 0xc0, 0x43,
-	0xcf, 0x77, 0x1d, 0x29, 0x43, 0xf7, 0x21, 0x3c, 0x6b, 0xb9, 0x5e, 0x10, 0x90, 0xdb, 0x96, 0x72,
-	0xc4, 0x3d, 0xb7, 0xe4, 0x73, 0x9e, 0x91, 0xba, 0x44, 0x11, 0x38, 0x0b, 0x4e, 0xf3, 0x0b, 0x80,
-	0x57, 0xcf, 0x61, 0xaa, 0x16, 0x3c, 0x84, 0x9b, 0x03, 0x55, 0xd3, 0x41, 0xa3, 0xd0, 0x2c, 0x77,
-	0x6e, 0x64, 0x37, 0x41, 0x39, 0xbb, 0x97, 0xe3, 0x2e, 0x7c, 0xfe, 0x5d, 0xdf, 0x4c, 0xa3, 0xd2,
-	0x00, 0xf4, 0x60, 0x09, 0x37, 0x2f, 0x70, 0x6f, 0xfe, 0x17, 0x57, 0x92, 0x2c, 0xf1, 0x56, 0xe0,
-	0x75, 0x81, 0xfb, 0x84, 0x72, 0x3c, 0x3a, 0x88, 0x82, 0x60, 0x34, 0x4d, 0x9e, 0xf2, 0x3d, 0x80,
-	0xfa, 0xea, 0x9e, 0xba, 0xcd, 0x35, 0x58, 0x1a, 0x12, 0xcf, 0x1d, 0x72, 0xd1, 0xf6, 0x82, 0xa3,
-	0x56, 0xa8, 0x0f, 0x4b, 0x21, 0x61, 0xd1, 0x88, 0xeb, 0x79, 0x71, 0xc7, 0xca, 0x12, 0x54, 0x82,
-	0xb3, 0x4f, 0x3d, 0xbf, 0x7b, 0x5b, 0xdd, 0xaf, 0xe9, 0x7a, 0x7c, 0x18, 0xf5, 0xac, 0x3e, 0x1d,
-	0xab, 0xb9, 0x55, 0x1f, 0x6d, 0x36, 0x38, 0xb2, 0xf9, 0x34, 0x20, 0x4c, 0x18, 0x98, 0xa3, 0xa2,
-	0x3b, 0xdf, 0x0a, 0xb0, 0x28, 0xc8, 0xd0, 0x2b, 0x00, 0x4b, 0x72, 0x68, 0x50, 0x33, 0xbb, 0x9b,
-	0xab, 0x33, 0x5a, 0xdd, 0x59, 0x43, 0x29, 0xaf, 0x69, 0x6e, 0xbd, 0xfc, 0xf1, 0xf7, 0x5d, 0xde,
-	0x40, 0x35, 0x3b, 0xf3, 0xf7, 0x20, 0x27, 0x14, 0xbd, 0x01, 0x30, 0x7d, 0x24, 0xd4, 0xba, 0x20,
-	0xfd, 0xdc, 0xec, 0x56, 0x6f, 0xad, 0xa5, 0x55, 0x2c, 0xdb, 0x82, 0xa5, 0x81, 0x8c, 0x6c, 0x96,
-	0x74, 0x36, 0x3e, 0x00, 0x58, 0x5e, 0x78, 0x32, 0xd4, 0xbe, 0xe0, 0x90, 0xd5, 0x67, 0xaf, 0x5a,
-	0xeb, 0xca, 0x15, 0x56, 0x4b, 0x60, 0x6d, 0x21, 0x33, 0x1b, 0x8b, 0xc7, 0x96, 0x43, 0x26, 0x3c,
-	0xdd, 0xfd, 0x93, 0x99, 0x01, 0x4e, 0x67, 0x06, 0xf8, 0x33, 0x33, 0xc0, 0xdb, 0xb9, 0x91, 0x3b,
-	0x9d, 0x1b, 0xb9, 0x9f, 0x73, 0x23, 0xf7, 0x6c, 0x67, 0x61, 0x08, 0xe2, 0x9c, 0xf6, 0x08, 0xf7,
-	0x98, 0x4c, 0x7c, 0x91, 0x66, 0x8a, 0x59, 0xe8, 0x95, 0xc4, 0xff, 0xcf, 0x9d, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x76, 0x1d, 0x1f, 0x76, 0x05, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x05, 0x1a, 0x1c, 0x30, 0x31, 0x63, 0xd5, 0x6d, 0x83, 0x4b, 0x5d, 0x11, 0x0b,
+	0xa4, 0xbb, 0x82, 0x37, 0x6e, 0x16, 0xa3, 0x07, 0x3d, 0xe8, 0x62, 0x62, 0x62, 0x4c, 0xc8, 0xb4,
+	0x9d, 0x2c, 0x1b, 0xda, 0x9d, 0x65, 0x67, 0x8a, 0x72, 0xd5, 0x8b, 0x89, 0x17, 0xa3, 0x07, 0x3d,
+	0x78, 0xf0, 0xe0, 0xc9, 0xc4, 0x9b, 0x9f, 0xc0, 0x13, 0x47, 0xa2, 0x31, 0xf1, 0xa4, 0x06, 0xfc,
+	0x20, 0x66, 0x67, 0xde, 0x2e, 0x2d, 0x6c, 0x4a, 0x43, 0x3c, 0xc1, 0xcc, 0xbc, 0xf7, 0x9f, 0xdf,
+	0xfc, 0xdf, 0x7b, 0x5b, 0x5c, 0xd9, 0xa0, 0x5b, 0xd4, 0x11, 0x74, 0xcb, 0x0f, 0x3c, 0xe1, 0x6c,
+	0x2d, 0x36, 0x98, 0xa4, 0x8b, 0xce, 0x66, 0x97, 0x45, 0xdb, 0x76, 0x18, 0x71, 0xc9, 0x49, 0x31,
+	0x8e, 0xb0, 0x21, 0xc2, 0x86, 0x88, 0xf2, 0x7c, 0x93, 0x8b, 0x0e, 0x17, 0x4e, 0x83, 0x0a, 0xa6,
+	0xc3, 0xd3, 0xe4, 0x90, 0x7a, 0x7e, 0x40, 0xa5, 0xcf, 0x03, 0xad, 0x50, 0x36, 0x7b, 0x63, 0x93,
+	0xa8, 0x26, 0xf7, 0x93, 0xf3, 0x92, 0x3e, 0x5f, 0x53, 0x2b, 0x47, 0x2f, 0xe0, 0xa8, 0xe8, 0x71,
+	0x8f, 0xeb, 0xfd, 0xf8, 0x3f, 0xd8, 0x9d, 0xf2, 0x38, 0xf7, 0xda, 0xcc, 0xa1, 0xa1, 0xef, 0xd0,
+	0x20, 0xe0, 0x52, 0xdd, 0x96, 0xe4, 0x64, 0x3f, 0x49, 0x48, 0x1e, 0x31, 0x1d, 0x61, 0x15, 0x31,
+	0xb9, 0x1f, 0x23, 0xdf, 0xa3, 0x11, 0xed, 0x08, 0x97, 0x6d, 0x76, 0x99, 0x90, 0xd6, 0x43, 0x7c,
+	0xb6, 0x6f, 0x57, 0x84, 0x3c, 0x10, 0x8c, 0x2c, 0xe3, 0x42, 0xa8, 0x76, 0x0c, 0x54, 0x41, 0xd5,
+	0x89, 0xa5, 0x29, 0x3b, 0xcb, 0x10, 0x5b, 0x67, 0xd5, 0x47, 0x77, 0x7e, 0x4d, 0xe7, 0x5c, 0xc8,
+	0x58, 0x1e, 0x7d, 0xf1, 0x61, 0x3a, 0x67, 0x7d, 0x44, 0xb8, 0xa8, 0x94, 0x6f, 0xb2, 0x90, 0x0b,
+	0x5f, 0x26, 0x37, 0x92, 0x22, 0x1e, 0x6b, 0xb1, 0x80, 0x77, 0x94, 0xf2, 0x29, 0x57, 0x2f, 0x88,
+	0x8d, 0xc7, 0xf8, 0x93, 0x80, 0x45, 0x46, 0x3e, 0xde, 0xad, 0x1b, 0xdf, 0xbe, 0xd4, 0x8a, 0x60,
+	0xca, 0x8d, 0x56, 0x2b, 0x62, 0x42, 0xac, 0xca, 0xc8, 0x0f, 0x3c, 0x57, 0x87, 0x91, 0x5b, 0x18,
+	0x1f, 0x58, 0x6e, 0x8c, 0x28, 0xc8, 0x59, 0x1b, 0x32, 0x62, 0xcf, 0x6d, 0x5d, 0xce, 0x03, 0x52,
+	0x8f, 0x01, 0x81, 0xdb, 0x93, 0x69, 0x7d, 0x46, 0xf8, 0xdc, 0x21, 0x4c, 0xb0, 0xe0, 0x0e, 0x1e,
+	0x6f, 0xc1, 0x9e, 0x81, 0x2a, 0x23, 0xd5, 0x89, 0xa5, 0x8b, 0xd9, 0x26, 0x40, 0x66, 0xfd, 0x4c,
+	0xec, 0xc2, 0xa7, 0xdf, 0xd3, 0xe3, 0xa9, 0x54, 0x2a, 0x40, 0x6e, 0xf7, 0xe1, 0xe6, 0x15, 0xee,
+	0xd5, 0x63, 0x71, 0x35, 0x49, 0x1f, 0x6f, 0x09, 0x5f, 0x50, 0xb8, 0x0f, 0xb8, 0xa4, 0xed, 0xd5,
+	0x6e, 0x18, 0xb6, 0xb7, 0x93, 0x52, 0xbe, 0x45, 0xd8, 0x38, 0x7a, 0x06, 0xaf, 0x39, 0x8f, 0x0b,
+	0xeb, 0xcc, 0xf7, 0xd6, 0xa5, 0xb2, 0x7d, 0xc4, 0x85, 0x15, 0x69, 0xe2, 0x42, 0xc4, 0x44, 0xb7,
+	0x2d, 0x8d, 0xbc, 0x7a, 0x63, 0xa9, 0x0f, 0x2a, 0xc1, 0x59, 0xe1, 0x7e, 0x50, 0xbf, 0x06, 0xef,
+	0xab, 0x7a, 0xbe, 0x5c, 0xef, 0x36, 0xec, 0x26, 0xef, 0x40, 0xdf, 0xc2, 0x9f, 0x9a, 0x68, 0x6d,
+	0x38, 0x72, 0x3b, 0x64, 0x42, 0x25, 0x08, 0x17, 0xa4, 0xad, 0xd7, 0x09, 0x19, 0x38, 0x73, 0x97,
+	0x37, 0x37, 0xd2, 0x7e, 0x48, 0x2b, 0x8f, 0x4e, 0x52, 0xf9, 0xfc, 0x89, 0x2b, 0xff, 0x15, 0xe1,
+	0x52, 0x06, 0x14, 0xf8, 0xf5, 0x18, 0x9f, 0x86, 0xe2, 0xad, 0xb5, 0xe3, 0x03, 0x68, 0x81, 0x4b,
+	0x03, 0x5b, 0x20, 0x96, 0xa8, 0x17, 0xc1, 0xa6, 0xc9, 0x3e, 0xdd, 0xc9, 0x56, 0xcf, 0xea, 0xbf,
+	0xb5, 0xc3, 0xd2, 0x8f, 0x51, 0x3c, 0xa6, 0x1e, 0x41, 0x9e, 0x23, 0x5c, 0xd0, 0xe3, 0x48, 0xaa,
+	0xd9, 0x90, 0x47, 0xa7, 0xbf, 0x3c, 0x37, 0x44, 0xa4, 0xbe, 0xd5, 0x9a, 0x79, 0xf6, 0xfd, 0xef,
+	0x9b, 0xbc, 0x49, 0xa6, 0x9c, 0xcc, 0x2f, 0x8d, 0x9e, 0x7d, 0xf2, 0x12, 0xe1, 0xb4, 0xfd, 0xc9,
+	0xfc, 0x00, 0xf5, 0x43, 0x5f, 0x85, 0xf2, 0xc2, 0x50, 0xb1, 0xc0, 0x32, 0xab, 0x58, 0x2a, 0xc4,
+	0xcc, 0x66, 0x49, 0xa7, 0xee, 0x1d, 0xc2, 0x13, 0x3d, 0xc3, 0x40, 0x6a, 0x03, 0x2e, 0x39, 0x3a,
+	0x50, 0x65, 0x7b, 0xd8, 0x70, 0xc0, 0x9a, 0x57, 0x58, 0x33, 0xc4, 0xca, 0xc6, 0x92, 0x71, 0xca,
+	0x9a, 0xd0, 0x28, 0xef, 0x11, 0xee, 0x6b, 0x10, 0x62, 0x1f, 0x6f, 0x40, 0xef, 0xd8, 0x94, 0x9d,
+	0xa1, 0xe3, 0x81, 0x6e, 0x41, 0xd1, 0x5d, 0x21, 0x97, 0x07, 0x9a, 0xa6, 0xbb, 0xbd, 0xbe, 0xb2,
+	0xb3, 0x67, 0xa2, 0xdd, 0x3d, 0x13, 0xfd, 0xd9, 0x33, 0xd1, 0xab, 0x7d, 0x33, 0xb7, 0xbb, 0x6f,
+	0xe6, 0x7e, 0xee, 0x9b, 0xb9, 0x47, 0x73, 0x3d, 0xd3, 0x1f, 0x0b, 0xd5, 0xda, 0xb4, 0x21, 0xb4,
+	0xe4, 0xd3, 0x54, 0x54, 0x7d, 0x04, 0x1a, 0x05, 0xf5, 0xc3, 0x73, 0xfd, 0xdf, 0x00, 0x38, 0x49,
+	0x25, 0x70, 0x6f, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -390,6 +506,8 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// DepositLocks queries the locked amounts of savings deposits.
+	DepositLocks(ctx context.Context, in *QueryDepositLocksRequest, opts ...grpc.CallOption) (*QueryDepositLocksResponse, error)
 }
 
 type queryClient struct {
@@ -427,6 +545,15 @@ func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyReque
 	return out, nil
 }
 
+func (c *queryClient) DepositLocks(ctx context.Context, in *QueryDepositLocksRequest, opts ...grpc.CallOption) (*QueryDepositLocksResponse, error) {
+	out := new(QueryDepositLocksResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Query/DepositLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the savings module.
@@ -435,6 +562,8 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// DepositLocks queries the locked amounts of savings deposits.
+	DepositLocks(context.Context, *QueryDepositLocksRequest) (*QueryDepositLocksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -450,6 +579,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
+func (*UnimplementedQueryServer) DepositLocks(ctx context.Context, req *QueryDepositLocksRequest) (*QueryDepositLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositLocks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -509,6 +641,24 @@ func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DepositLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Query/DepositLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositLocks(ctx, req.(*QueryDepositLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.savings.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -525,6 +675,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
 		},
+		{
+			MethodName: "DepositLocks",
+			Handler:    _Query_DepositLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/savings/v1beta1/query.proto",
@@ -749,6 +903,97 @@ func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDepositLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DepositLocks) > 0 {
+		for iNdEx := len(m.DepositLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -847,6 +1092,42 @@ func (m *QueryTotalSupplyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDepositLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DepositLocks) > 0 {
+		for _, e := range m.DepositLocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1409,6 +1690,244 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDepositLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositLocks = append(m.DepositLocks, DepositLock{})
+			if err := m.DepositLocks[len(m.DepositLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -103,36 +107,87 @@ func (m *Deposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Deposit proto.InternalMessageInfo
 
+// DepositLock defines an amount of a savings deposit that can't be withdrawn
+// until the lock ends.
+type DepositLock struct {
+	ID        uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// end_time is the time the locked amount can be withdrawn from
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *DepositLock) Reset()         { *m = DepositLock{} }
+func (m *DepositLock) String() string { return proto.CompactTextString(m) }
+func (*DepositLock) ProtoMessage()    {}
+func (*DepositLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7110366fa182786, []int{2}
+}
+func (m *DepositLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositLock.Merge(m, src)
+}
+func (m *DepositLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositLock proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.savings.v1beta1.Params")
 	proto.RegisterType((*Deposit)(nil), "kava.savings.v1beta1.Deposit")
+	proto.RegisterType((*DepositLock)(nil), "kava.savings.v1beta1.DepositLock")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/store.proto", fileDescriptor_f7110366fa182786) }
 
 var fileDescriptor_f7110366fa182786 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
-	0x14, 0xec, 0x4a, 0x82, 0xa1, 0x1e, 0x34, 0x95, 0x03, 0x70, 0x58, 0x1a, 0x4e, 0xe5, 0xd0, 0x5d,
-	0x91, 0x2f, 0xa0, 0x92, 0xe8, 0xd1, 0x70, 0xf4, 0x42, 0xb6, 0xed, 0x5a, 0x1b, 0x6c, 0x5f, 0xd3,
-	0xb7, 0x10, 0xf9, 0x0b, 0xbf, 0xc3, 0xb3, 0x1f, 0xc1, 0x91, 0x78, 0x30, 0x9e, 0x50, 0xe1, 0x2f,
-	0x3c, 0x99, 0xb6, 0x2b, 0x7a, 0xf4, 0xb4, 0x6f, 0xe7, 0xcd, 0x4c, 0x26, 0xf3, 0x4c, 0x7b, 0x26,
-	0x16, 0x82, 0xa3, 0x58, 0xc4, 0x69, 0x84, 0x7c, 0x31, 0xf0, 0xa5, 0x12, 0x03, 0x8e, 0x0a, 0x72,
-	0xc9, 0xb2, 0x1c, 0x14, 0x58, 0xcd, 0x82, 0xc1, 0x34, 0x83, 0x69, 0x46, 0x87, 0x06, 0x80, 0x09,
-	0x20, 0xf7, 0x05, 0xca, 0xbd, 0x2c, 0x80, 0x38, 0xad, 0x54, 0x9d, 0x76, 0xb5, 0x9f, 0x96, 0x3f,
-	0x5e, 0x7d, 0xf4, 0xaa, 0x19, 0x41, 0x04, 0x15, 0x5e, 0x4c, 0x15, 0xda, 0x1b, 0x9a, 0xf5, 0x6b,
-	0x91, 0x8b, 0x04, 0xad, 0xbe, 0x79, 0x82, 0xf3, 0x2c, 0x83, 0x5c, 0xc9, 0x70, 0x1a, 0xca, 0x14,
-	0x12, 0x6c, 0x11, 0xbb, 0xe6, 0x34, 0x26, 0xc7, 0x7b, 0x7c, 0x5c, 0xc2, 0xbd, 0x57, 0x62, 0x1e,
-	0x8e, 0x65, 0x06, 0x18, 0x2b, 0xeb, 0xd6, 0x6c, 0x84, 0xd5, 0x08, 0x79, 0x8b, 0xd8, 0xc4, 0x69,
-	0x78, 0x57, 0x5f, 0x9b, 0xae, 0x1b, 0xc5, 0xea, 0x6e, 0xee, 0xb3, 0x00, 0x12, 0x1d, 0x43, 0x3f,
-	0x2e, 0x86, 0x33, 0xae, 0x96, 0x99, 0x44, 0x36, 0x0a, 0x82, 0x51, 0x18, 0xe6, 0x12, 0xf1, 0xe5,
-	0xd9, 0x3d, 0xd5, 0x61, 0x35, 0xe2, 0x2d, 0x95, 0xc4, 0xc9, 0xaf, 0xb5, 0x15, 0x98, 0x75, 0x91,
-	0xc0, 0x3c, 0x55, 0xad, 0x03, 0xbb, 0xe6, 0x1c, 0x9d, 0xb7, 0x99, 0x16, 0x14, 0x55, 0xfc, 0xf4,
-	0xc3, 0x2e, 0x20, 0x4e, 0xbd, 0xb3, 0xd5, 0xa6, 0x6b, 0x3c, 0xbd, 0x77, 0x9d, 0x7f, 0x64, 0x28,
-	0x04, 0x38, 0xd1, 0xd6, 0xde, 0xe5, 0xea, 0x93, 0x1a, 0xab, 0x2d, 0x25, 0xeb, 0x2d, 0x25, 0x1f,
-	0x5b, 0x4a, 0x1e, 0x77, 0xd4, 0x58, 0xef, 0xa8, 0xf1, 0xb6, 0xa3, 0xc6, 0x4d, 0xff, 0x8f, 0x5f,
-	0x71, 0x1d, 0xf7, 0x5e, 0xf8, 0x58, 0x4e, 0xfc, 0x61, 0x7f, 0xcb, 0xd2, 0xd6, 0xaf, 0x97, 0xed,
-	0x0e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x42, 0x46, 0x9e, 0xe8, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0x3a, 0x55, 0xda, 0x6c, 0x90, 0x40, 0xa6, 0x42, 0x69, 0x0e, 0xb6, 0x95, 0x93, 0x7b,
+	0xc8, 0x2e, 0x6d, 0x3f, 0x00, 0xd5, 0x44, 0x02, 0x24, 0x0e, 0xc8, 0xe2, 0xc4, 0x25, 0x5a, 0x7b,
+	0xb7, 0xc6, 0x4a, 0xed, 0xb1, 0x3c, 0x9b, 0x88, 0xfe, 0x45, 0xbf, 0x83, 0x2b, 0x7c, 0x44, 0x8e,
+	0x15, 0x07, 0xc4, 0x29, 0x85, 0xe4, 0x2f, 0x38, 0x21, 0xdb, 0x9b, 0x00, 0xb7, 0x5e, 0x72, 0xf2,
+	0xcc, 0xdb, 0x79, 0xbb, 0xef, 0x8d, 0x9e, 0xa9, 0x3f, 0x13, 0x0b, 0xc1, 0x51, 0x2c, 0xb2, 0x22,
+	0x45, 0xbe, 0x38, 0x8b, 0x95, 0x16, 0x67, 0x1c, 0x35, 0x54, 0x8a, 0x95, 0x15, 0x68, 0x70, 0x8e,
+	0xeb, 0x09, 0x66, 0x26, 0x98, 0x99, 0x18, 0xba, 0x09, 0x60, 0x0e, 0xc8, 0x63, 0x81, 0x6a, 0x47,
+	0x4b, 0x20, 0x2b, 0x5a, 0xd6, 0xf0, 0xa4, 0x3d, 0x9f, 0x36, 0x1d, 0x6f, 0x1b, 0x73, 0x74, 0x9c,
+	0x42, 0x0a, 0x2d, 0x5e, 0x57, 0x06, 0xf5, 0x52, 0x80, 0xf4, 0x5a, 0xf1, 0xa6, 0x8b, 0xe7, 0x57,
+	0x5c, 0x67, 0xb9, 0x42, 0x2d, 0xf2, 0xb2, 0x1d, 0x18, 0x5d, 0xd0, 0xee, 0x3b, 0x51, 0x89, 0x1c,
+	0x9d, 0x53, 0xfa, 0x04, 0xe7, 0x65, 0x09, 0x95, 0x56, 0x72, 0x2a, 0x55, 0x01, 0x39, 0x0e, 0x88,
+	0xdf, 0x09, 0x7a, 0xd1, 0xe3, 0x1d, 0x3e, 0x69, 0xe0, 0xd1, 0x77, 0x42, 0x0f, 0x27, 0xaa, 0x04,
+	0xcc, 0xb4, 0x73, 0x45, 0x7b, 0xb2, 0x2d, 0xa1, 0x1a, 0x10, 0x9f, 0x04, 0xbd, 0xf0, 0xf5, 0xef,
+	0x95, 0x37, 0x4e, 0x33, 0xfd, 0x71, 0x1e, 0xb3, 0x04, 0x72, 0xa3, 0xd3, 0x7c, 0xc6, 0x28, 0x67,
+	0x5c, 0xdf, 0x94, 0x0a, 0xd9, 0x65, 0x92, 0x5c, 0x4a, 0x59, 0x29, 0xc4, 0x6f, 0x5f, 0xc7, 0x4f,
+	0x8d, 0x1b, 0x83, 0x84, 0x37, 0x5a, 0x61, 0xf4, 0xf7, 0x6a, 0x27, 0xa1, 0x5d, 0x91, 0xc3, 0xbc,
+	0xd0, 0x03, 0xdb, 0xef, 0x04, 0xfd, 0xf3, 0x13, 0x66, 0x08, 0xf5, 0xae, 0xb6, 0x0b, 0x64, 0x2f,
+	0x21, 0x2b, 0xc2, 0xe7, 0xcb, 0x95, 0x67, 0x7d, 0xbe, 0xf7, 0x82, 0x07, 0x68, 0xa8, 0x09, 0x18,
+	0x99, 0xab, 0x47, 0x5f, 0x6c, 0xda, 0x37, 0xc6, 0xde, 0x42, 0x32, 0x73, 0x9e, 0x51, 0x3b, 0x93,
+	0x8d, 0xab, 0x83, 0xb0, 0xbb, 0x5e, 0x79, 0xf6, 0x9b, 0x49, 0x64, 0x67, 0xf2, 0x7f, 0xd3, 0xb6,
+	0x4f, 0x82, 0x47, 0xfb, 0x36, 0xdd, 0xd9, 0x9b, 0x69, 0xe7, 0x05, 0x3d, 0x52, 0x85, 0x9c, 0xd6,
+	0xc9, 0x18, 0x1c, 0xf8, 0x24, 0xe8, 0x9f, 0x0f, 0x59, 0x1b, 0x1b, 0xb6, 0x8d, 0x0d, 0x7b, 0xbf,
+	0x8d, 0x4d, 0x78, 0x54, 0xbf, 0x73, 0x7b, 0xef, 0x91, 0xe8, 0x50, 0x15, 0xb2, 0xc6, 0xc3, 0x57,
+	0xcb, 0x5f, 0xae, 0xb5, 0x5c, 0xbb, 0xe4, 0x6e, 0xed, 0x92, 0x9f, 0x6b, 0x97, 0xdc, 0x6e, 0x5c,
+	0xeb, 0x6e, 0xe3, 0x5a, 0x3f, 0x36, 0xae, 0xf5, 0xe1, 0xf4, 0x1f, 0x41, 0x75, 0xe8, 0xc7, 0xd7,
+	0x22, 0xc6, 0xa6, 0xe2, 0x9f, 0x76, 0xbf, 0x48, 0xa3, 0x2b, 0xee, 0x36, 0xef, 0x5d, 0xfc, 0x19,
+	0x00, 0xab, 0x79, 0x23, 0x06, 0x3f, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -211,6 +266,63 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DepositLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStore(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStore(v)
 	base := offset
@@ -256,6 +368,30 @@ func (m *Deposit) Size() (n int) {
 	return n
 }
 
+func (m *DepositLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovStore(uint64(m.ID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
 func sovStore(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -460,6 +596,176 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DepositLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStore(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,37 +11,91 @@ import (
 
 // SwapExactForTokens swaps an exact coin a input for a coin b output
 func (k *Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error {
-	poolID, pool, err := k.loadPool(ctx, exactCoinA.Denom, coinB.Denom)
+	poolID, pool, swapOutput, feePaid, err := k.swapWithExactInput(ctx, exactCoinA, coinB, slippageLimit)
 	if err != nil {
 		return err
 	}
 
-	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
-	if swapOutput.IsZero() {
-		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
+	if err := k.commitSwap(ctx, poolID, pool, requester, exactCoinA, swapOutput, feePaid, "input"); err != nil {
+		return err
 	}
 
-	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
-	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+	return nil
+}
+
+// SwapForExactTokens swaps a coin a input for an exact coin b output
+func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) error {
+	poolID, pool, swapInput, feePaid, err := k.swapWithExactOutput(ctx, coinA, exactCoinB, slippageLimit)
+	if err != nil {
 		return err
 	}
 
-	if err := k.commitSwap(ctx, poolID, pool, requester, exactCoinA, swapOutput, feePaid, "input"); err != nil {
+	if err := k.commitSwap(ctx, poolID, pool, requester, swapInput, exactCoinB, feePaid, "output"); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// SwapForExactTokens swaps a coin a input for an exact coin b output
-func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) error {
+// SwapExactForTokensFromModule swaps an exact coin a input from a module account for a coin b output,
+// returning the output. It allows modules whose accounts are blocked from receiving coins to swap.
+func (k *Keeper) SwapExactForTokensFromModule(ctx sdk.Context, senderModule string, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) (sdk.Coin, error) {
+	poolID, pool, swapOutput, feePaid, err := k.swapWithExactInput(ctx, exactCoinA, coinB, slippageLimit)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.commitModuleSwap(ctx, poolID, pool, senderModule, exactCoinA, swapOutput, feePaid, "input"); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return swapOutput, nil
+}
+
+// SwapForExactTokensFromModule swaps a coin a input from a module account for an exact coin b output,
+// returning the input. It allows modules whose accounts are blocked from receiving coins to swap.
+func (k *Keeper) SwapForExactTokensFromModule(ctx sdk.Context, senderModule string, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) (sdk.Coin, error) {
+	poolID, pool, swapInput, feePaid, err := k.swapWithExactOutput(ctx, coinA, exactCoinB, slippageLimit)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.commitModuleSwap(ctx, poolID, pool, senderModule, swapInput, exactCoinB, feePaid, "output"); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return swapInput, nil
+}
+
+// swapWithExactInput calculates the output of a swap with an exact input, updating the in-memory pool
+func (k Keeper) swapWithExactInput(ctx sdk.Context, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) (string, *types.DenominatedPool, sdk.Coin, sdk.Coin, error) {
+	poolID, pool, err := k.loadPool(ctx, exactCoinA.Denom, coinB.Denom)
+	if err != nil {
+		return "", nil, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
+	if swapOutput.IsZero() {
+		return "", nil, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
+	}
+
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return "", nil, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return poolID, pool, swapOutput, feePaid, nil
+}
+
+// swapWithExactOutput calculates the input of a swap with an exact output, updating the in-memory pool
+func (k Keeper) swapWithExactOutput(ctx sdk.Context, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) (string, *types.DenominatedPool, sdk.Coin, sdk.Coin, error) {
 	poolID, pool, err := k.loadPool(ctx, coinA.Denom, exactCoinB.Denom)
 	if err != nil {
-		return err
+		return "", nil, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	if exactCoinB.Amount.GTE(pool.Reserves().AmountOf(exactCoinB.Denom)) {
-		return errorsmod.Wrapf(
+		return "", nil, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(
 			types.ErrInsufficientLiquidity,
 			"output %s >= pool reserves %s", exactCoinB.Amount.String(), pool.Reserves().AmountOf(exactCoinB.Denom).String(),
 		)
@@ -51,14 +105,10 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 
 	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Sub(feePaid).Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
-		return err
-	}
-
-	if err := k.commitSwap(ctx, poolID, pool, requester, swapInput, exactCoinB, feePaid, "output"); err != nil {
-		return err
+		return "", nil, sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	return nil
+	return poolID, pool, swapInput, feePaid, nil
 }
 
 func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string, *types.DenominatedPool, error) {
@@ -106,6 +156,38 @@ func (k Keeper) commitSwap(
 		panic(err)
 	}
 
+	k.emitSwapTradeEvent(ctx, poolID, requester, swapInput, swapOutput, feePaid, exactDirection)
+
+	return nil
+}
+
+func (k Keeper) commitModuleSwap(
+	ctx sdk.Context,
+	poolID string,
+	pool *types.DenominatedPool,
+	senderModule string,
+	swapInput sdk.Coin,
+	swapOutput sdk.Coin,
+	feePaid sdk.Coin,
+	exactDirection string,
+) error {
+	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, senderModule, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	requester := k.accountKeeper.GetModuleAddress(senderModule)
+	k.emitSwapTradeEvent(ctx, poolID, requester, swapInput, swapOutput, feePaid, exactDirection)
+
+	return nil
+}
+
+func (k Keeper) emitSwapTradeEvent(ctx sdk.Context, poolID string, requester sdk.AccAddress, swapInput, swapOutput, feePaid sdk.Coin, exactDirection string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSwapTrade,
@@ -117,6 +199,4 @@ func (k Keeper) commitSwap(
 			sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
 		),
 	)
-
-	return nil
 }
@@ -9,6 +9,9 @@ import (
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/swap/types"
 )
 
@@ -629,3 +632,76 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwapExactForTokensFromModule() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	// modules that can't receive coins from accounts can still swap
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, hardtypes.ModuleAccountName, balance))
+	moduleAddr := authtypes.NewModuleAddress(hardtypes.ModuleAccountName)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	output, err := suite.Keeper.SwapExactForTokensFromModule(suite.Ctx, hardtypes.ModuleAccountName, coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+	suite.Equal(expectedOutput, output)
+
+	suite.AccountBalanceEqual(moduleAddr, balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, moduleAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+
+	// slippage is checked the same way as for accounts
+	_, err = suite.Keeper.SwapExactForTokensFromModule(suite.Ctx, hardtypes.ModuleAccountName, coinA, sdk.NewCoin("usdx", sdkmath.NewInt(6e6)), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+}
+
+func (suite *keeperTestSuite) TestSwapForExactTokensFromModule() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, hardtypes.ModuleAccountName, balance))
+	moduleAddr := authtypes.NewModuleAddress(hardtypes.ModuleAccountName)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	input, err := suite.Keeper.SwapForExactTokensFromModule(suite.Ctx, hardtypes.ModuleAccountName, coinA, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	expectedInput := sdk.NewCoin("ukava", sdkmath.NewInt(1003511))
+	suite.Equal(expectedInput, input)
+
+	suite.AccountBalanceEqual(moduleAddr, balance.Sub(expectedInput).Add(coinB))
+	suite.ModuleAccountBalanceEqual(reserves.Add(expectedInput).Sub(coinB))
+	suite.PoolLiquidityEqual(reserves.Add(expectedInput).Sub(coinB))
+}